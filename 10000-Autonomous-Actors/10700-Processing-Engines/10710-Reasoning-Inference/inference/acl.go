@@ -0,0 +1,230 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/410-Interaction-Models/410-100-Resonance-Bus"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	policyRoot    = "root"
+	policyDefault = "default"
+)
+
+var validCapabilities = []string{"read", "create", "update", "delete", "list", "sudo", "deny"}
+
+type aclRule struct {
+	Path          string   `json:"path"`
+	Capabilities  []string `json:"capabilities"`
+	RequireReason bool     `json:"require_reason,omitempty"`
+}
+
+type aclPolicy struct {
+	Name   string        `json:"name"`
+	Rules  []aclRule     `json:"rules"`
+	Window *accessWindow `json:"window,omitempty"`
+}
+
+// defaultPolicy lets every token manage itself.
+var defaultPolicy = aclPolicy{
+	Name:  policyDefault,
+	Rules: []aclRule{{Path: "auth/token/self", Capabilities: []string{"read", "update"}}},
+}
+
+func (r aclRule) matches(resource string) bool {
+	if prefix, ok := strings.CutSuffix(r.Path, "*"); ok {
+		return strings.HasPrefix(resource, prefix)
+	}
+	return r.Path == resource
+}
+
+func (s *VaultServer) loadPolicy(tx *bbolt.Tx, name string) (*aclPolicy, error) {
+	if name == policyDefault {
+		if data := tx.Bucket([]byte(bucketPolicies)).Get([]byte(name)); data == nil {
+			p := defaultPolicy
+			return &p, nil
+		}
+	}
+	data := tx.Bucket([]byte(bucketPolicies)).Get([]byte(name))
+	if data == nil {
+		return nil, fmt.Errorf("policy not found: %s", name)
+	}
+	var p aclPolicy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// capabilities returns the union of capabilities the named policies grant
+// on resource. A matching deny rule removes everything. With honourWindows
+// set, policies whose access window is closed grant nothing; if one of them
+// would have matched, its next opening time is returned as blockedUntil
+// (the zero time when it will not reopen).
+func (s *VaultServer) capabilities(policies []string, resource string, honourWindows bool) (caps []string, blocked bool, blockedUntil time.Time, err error) {
+	if slices.Contains(policies, policyRoot) {
+		return []string{"root"}, false, time.Time{}, nil
+	}
+	now := s.now()
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		for _, name := range policies {
+			p, err := s.loadPolicy(tx, name)
+			if err != nil {
+				slog.Warn("Token references unknown policy", "policy", name)
+				continue
+			}
+			matching := slices.ContainsFunc(p.Rules, func(r aclRule) bool { return r.matches(resource) })
+			if honourWindows && p.Window != nil && matching {
+				if open, next := p.Window.check(now); !open {
+					if !blocked || (!next.IsZero() && (blockedUntil.IsZero() || next.Before(blockedUntil))) {
+						blockedUntil = next
+					}
+					blocked = true
+					continue
+				}
+			}
+			for _, r := range p.Rules {
+				if !r.matches(resource) {
+					continue
+				}
+				if slices.Contains(r.Capabilities, "deny") {
+					caps = []string{"deny"}
+					return nil
+				}
+				for _, c := range r.Capabilities {
+					if !slices.Contains(caps, c) {
+						caps = append(caps, c)
+					}
+				}
+			}
+		}
+		return nil
+	})
+	return caps, blocked, blockedUntil, err
+}
+
+// reasonRequired reports whether any rule of the caller's policies that
+// matches resource demands an access reason.
+func (s *VaultServer) reasonRequired(c *Caller, resource string) bool {
+	required := false
+	s.db.View(func(tx *bbolt.Tx) error {
+		for _, name := range c.Policies {
+			p, err := s.loadPolicy(tx, name)
+			if err != nil {
+				continue
+			}
+			for _, r := range p.Rules {
+				if r.RequireReason && r.matches(resource) {
+					required = true
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+	return required
+}
+
+// checkACL verifies the token caller holds capability on resource. Access
+// windows on policies only constrain reads.
+func (s *VaultServer) checkACL(c *Caller, capability, resource string) error {
+	caps, blocked, next, err := s.capabilities(c.Policies, resource, capability == "read")
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if slices.Contains(caps, "root") {
+		return nil
+	}
+	if slices.Contains(caps, capability) {
+		return nil
+	}
+	if blocked {
+		return windowClosedError(resource, next)
+	}
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s lacks %s on %s", c.Identity, capability, resource))
+}
+
+func (s *VaultServer) PutPolicy(ctx context.Context, req *connect.Request[vaultv1.PutPolicyRequest]) (*connect.Response[vaultv1.PutPolicyResponse], error) {
+	p := req.Msg.Policy
+	if p == nil || p.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("policy name is required"))
+	}
+	slog.Info("PutPolicy", "name", p.Name)
+	if err := s.authorizeSudo(ctx, "sys/policy/"+p.Name); err != nil {
+		return nil, err
+	}
+	if p.Name == policyRoot {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("the root policy cannot be modified"))
+	}
+
+	stored := aclPolicy{Name: p.Name, Window: windowFromProto(p.Window)}
+	if stored.Window != nil {
+		if err := stored.Window.validate(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	for _, r := range p.Rules {
+		for _, c := range r.Capabilities {
+			if !slices.Contains(validCapabilities, c) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown capability %q on %s", c, r.Path))
+			}
+		}
+		stored.Rules = append(stored.Rules, aclRule{Path: r.Path, Capabilities: r.Capabilities, RequireReason: r.RequireReason})
+	}
+	data, _ := json.Marshal(stored)
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketPolicies)).Put([]byte(p.Name), data)
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.bus.Emit(resonance.PolicyReloaded, p.Name, "acl")
+	return connect.NewResponse(&vaultv1.PutPolicyResponse{}), nil
+}
+
+func (s *VaultServer) GetPolicy(ctx context.Context, req *connect.Request[vaultv1.GetPolicyRequest]) (*connect.Response[vaultv1.Policy], error) {
+	slog.Info("GetPolicy", "name", req.Msg.Name)
+	if err := s.authorize(ctx, "read", "sys/policy/"+req.Msg.Name); err != nil {
+		return nil, err
+	}
+	if req.Msg.Name == policyRoot {
+		return connect.NewResponse(&vaultv1.Policy{Name: policyRoot, Rules: []*vaultv1.PolicyRule{{Path: "*", Capabilities: []string{"sudo"}}}}), nil
+	}
+
+	var p *aclPolicy
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		p, err = s.loadPolicy(tx, req.Msg.Name)
+		return err
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	res := &vaultv1.Policy{Name: p.Name, Window: p.Window.proto()}
+	for _, r := range p.Rules {
+		res.Rules = append(res.Rules, &vaultv1.PolicyRule{Path: r.Path, Capabilities: r.Capabilities, RequireReason: r.RequireReason})
+	}
+	return connect.NewResponse(res), nil
+}
+
+// authorizeSudo guards administrative endpoints. Unlike secret paths they
+// are never open to anonymous callers.
+func (s *VaultServer) authorizeSudo(ctx context.Context, resource string) error {
+	c := CallerFrom(ctx)
+	if c == nil || c.TokenID == "" {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("a vault token is required for %s", resource))
+	}
+	return s.checkACL(c, "sudo", resource)
+}
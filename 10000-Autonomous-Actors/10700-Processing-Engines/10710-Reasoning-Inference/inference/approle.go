@@ -0,0 +1,314 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+var (
+	errInvalidCredentials = errors.New("invalid role-id or secret-id")
+	errSourceNotAllowed   = errors.New("source address not permitted")
+)
+
+type appRole struct {
+	Name            string   `json:"name"`
+	RoleID          string   `json:"role_id"`
+	TokenPolicies   []string `json:"token_policies"`
+	TokenTTL        int64    `json:"token_ttl"`
+	TokenMaxTTL     int64    `json:"token_max_ttl"`
+	SecretIDNumUses int32    `json:"secret_id_num_uses"`
+	SecretIDTTL     int64    `json:"secret_id_ttl"`
+	BoundCIDRs      []string `json:"secret_id_bound_cidrs"`
+}
+
+// secretIDEntry is keyed by "<role>/<sha256(secret-id)>".
+type secretIDEntry struct {
+	Accessor      string            `json:"accessor"`
+	CreateTime    time.Time         `json:"create_time"`
+	ExpireTime    time.Time         `json:"expire_time"`
+	UsesRemaining int32             `json:"uses_remaining"`
+	Limited       bool              `json:"limited"`
+	CIDRs         []string          `json:"cidrs"`
+	Metadata      map[string]string `json:"metadata"`
+}
+
+func parseCIDRs(cidrs []string) error {
+	for _, c := range cidrs {
+		if _, err := netip.ParsePrefix(c); err != nil {
+			return fmt.Errorf("invalid CIDR %q: %w", c, err)
+		}
+	}
+	return nil
+}
+
+// cidrAllowed reports whether addr ("host:port" or bare IP) falls inside
+// every non-empty CIDR list given.
+func cidrAllowed(addr string, lists ...[]string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	ip, err := netip.ParseAddr(host)
+	for _, list := range lists {
+		if len(list) == 0 {
+			continue
+		}
+		if err != nil {
+			return false
+		}
+		if !slices.ContainsFunc(list, func(c string) bool {
+			p, perr := netip.ParsePrefix(c)
+			return perr == nil && p.Contains(ip.Unmap())
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+func getAppRole(tx *bbolt.Tx, name string) (*appRole, error) {
+	data := tx.Bucket([]byte(bucketAppRoles)).Get([]byte(name))
+	if data == nil {
+		return nil, fmt.Errorf("role not found: %s", name)
+	}
+	var r appRole
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (s *VaultServer) PutAppRole(ctx context.Context, req *connect.Request[vaultv1.PutAppRoleRequest]) (*connect.Response[vaultv1.PutAppRoleResponse], error) {
+	r := req.Msg.Role
+	if r == nil || r.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("role name is required"))
+	}
+	slog.Info("PutAppRole", "role", r.Name, "policies", r.TokenPolicies)
+	if err := s.authorizeSudo(ctx, "auth/approle/role/"+r.Name); err != nil {
+		return nil, err
+	}
+	if err := parseCIDRs(r.SecretIdBoundCidrs); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if slices.Contains(r.TokenPolicies, policyRoot) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("roles cannot issue root tokens"))
+	}
+
+	var roleID string
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		roleID = randomString(18)
+		if existing, err := getAppRole(tx, r.Name); err == nil {
+			roleID = existing.RoleID
+		}
+		role := appRole{
+			Name:            r.Name,
+			RoleID:          roleID,
+			TokenPolicies:   r.TokenPolicies,
+			TokenTTL:        r.TokenTtlSeconds,
+			TokenMaxTTL:     r.TokenMaxTtlSeconds,
+			SecretIDNumUses: r.SecretIdNumUses,
+			SecretIDTTL:     r.SecretIdTtlSeconds,
+			BoundCIDRs:      r.SecretIdBoundCidrs,
+		}
+		data, _ := json.Marshal(role)
+		if err := tx.Bucket([]byte(bucketAppRoles)).Put([]byte(r.Name), data); err != nil {
+			return err
+		}
+		return tx.Bucket([]byte(bucketAppRoleIDs)).Put([]byte(roleID), []byte(r.Name))
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutAppRoleResponse{RoleId: roleID}), nil
+}
+
+func (s *VaultServer) GetAppRoleID(ctx context.Context, req *connect.Request[vaultv1.GetAppRoleIDRequest]) (*connect.Response[vaultv1.GetAppRoleIDResponse], error) {
+	slog.Info("GetAppRoleID", "role", req.Msg.RoleName)
+	if err := s.authorizeSudo(ctx, "auth/approle/role/"+req.Msg.RoleName); err != nil {
+		return nil, err
+	}
+	var roleID string
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		r, err := getAppRole(tx, req.Msg.RoleName)
+		if err != nil {
+			return err
+		}
+		roleID = r.RoleID
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(&vaultv1.GetAppRoleIDResponse{RoleId: roleID}), nil
+}
+
+func (s *VaultServer) GenerateSecretID(ctx context.Context, req *connect.Request[vaultv1.GenerateSecretIDRequest]) (*connect.Response[vaultv1.GenerateSecretIDResponse], error) {
+	slog.Info("GenerateSecretID", "role", req.Msg.RoleName)
+	if err := s.authorizeSudo(ctx, "auth/approle/role/"+req.Msg.RoleName+"/secret-id"); err != nil {
+		return nil, err
+	}
+	if err := parseCIDRs(req.Msg.CidrList); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	secretID := randomString(24)
+	res := &vaultv1.GenerateSecretIDResponse{SecretId: secretID}
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		r, err := getAppRole(tx, req.Msg.RoleName)
+		if err != nil {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		now := s.now()
+		e := secretIDEntry{
+			Accessor:      randomString(18),
+			CreateTime:    now,
+			UsesRemaining: r.SecretIDNumUses,
+			Limited:       r.SecretIDNumUses > 0,
+			CIDRs:         req.Msg.CidrList,
+			Metadata:      req.Msg.Metadata,
+		}
+		if r.SecretIDTTL > 0 {
+			e.ExpireTime = now.Add(time.Duration(r.SecretIDTTL) * time.Second)
+			res.ExpireTime = e.ExpireTime.Unix()
+		}
+		res.SecretIdAccessor = e.Accessor
+		res.NumUses = e.UsesRemaining
+
+		key := r.Name + "/" + hashToken(secretID)
+		data, _ := json.Marshal(e)
+		if err := tx.Bucket([]byte(bucketSecretIDs)).Put([]byte(key), data); err != nil {
+			return err
+		}
+		return tx.Bucket([]byte(bucketSecretIDAccess)).Put([]byte(e.Accessor), []byte(key))
+	})
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *VaultServer) DestroySecretID(ctx context.Context, req *connect.Request[vaultv1.DestroySecretIDRequest]) (*connect.Response[vaultv1.DestroySecretIDResponse], error) {
+	slog.Info("DestroySecretID", "role", req.Msg.RoleName, "accessor", req.Msg.SecretIdAccessor)
+	if err := s.authorizeSudo(ctx, "auth/approle/role/"+req.Msg.RoleName+"/secret-id"); err != nil {
+		return nil, err
+	}
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		acc := tx.Bucket([]byte(bucketSecretIDAccess))
+		key := acc.Get([]byte(req.Msg.SecretIdAccessor))
+		if key == nil || !strings.HasPrefix(string(key), req.Msg.RoleName+"/") {
+			return fmt.Errorf("secret-id accessor not found: %s", req.Msg.SecretIdAccessor)
+		}
+		if err := tx.Bucket([]byte(bucketSecretIDs)).Delete(key); err != nil {
+			return err
+		}
+		return acc.Delete([]byte(req.Msg.SecretIdAccessor))
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(&vaultv1.DestroySecretIDResponse{}), nil
+}
+
+// Login exchanges a role-id and secret-id for a token scoped to the role's
+// policies. It is the one RPC that needs no existing credentials.
+func (s *VaultServer) Login(ctx context.Context, req *connect.Request[vaultv1.LoginRequest]) (*connect.Response[vaultv1.CreateTokenResponse], error) {
+	addr := req.Peer().Addr
+	slog.Info("Login", "method", "approle", "peer", addr)
+
+	var token string
+	var info *vaultv1.TokenInfo
+	var expiredID bool
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		name := tx.Bucket([]byte(bucketAppRoleIDs)).Get([]byte(req.Msg.RoleId))
+		if name == nil {
+			return errInvalidCredentials
+		}
+		r, err := getAppRole(tx, string(name))
+		if err != nil {
+			return errInvalidCredentials
+		}
+
+		secrets := tx.Bucket([]byte(bucketSecretIDs))
+		key := []byte(r.Name + "/" + hashToken(req.Msg.SecretId))
+		data := secrets.Get(key)
+		if data == nil {
+			return errInvalidCredentials
+		}
+		var e secretIDEntry
+		if err := json.Unmarshal(data, &e); err != nil {
+			return err
+		}
+		if !e.ExpireTime.IsZero() && !s.now().Before(e.ExpireTime) {
+			// Commit the cleanup; the login is refused below.
+			expiredID = true
+			if err := secrets.Delete(key); err != nil {
+				return err
+			}
+			return tx.Bucket([]byte(bucketSecretIDAccess)).Delete([]byte(e.Accessor))
+		}
+		if !cidrAllowed(addr, r.BoundCIDRs, e.CIDRs) {
+			return fmt.Errorf("%w: %s for role %s", errSourceNotAllowed, addr, r.Name)
+		}
+
+		if e.Limited {
+			e.UsesRemaining--
+			if e.UsesRemaining <= 0 {
+				secrets.Delete(key)
+				tx.Bucket([]byte(bucketSecretIDAccess)).Delete([]byte(e.Accessor))
+			} else {
+				data, _ := json.Marshal(e)
+				if err := secrets.Put(key, data); err != nil {
+					return err
+				}
+			}
+		}
+
+		ttl := time.Duration(r.TokenTTL) * time.Second
+		if ttl <= 0 {
+			ttl = defaultTokenTTL
+		}
+		maxTTL := time.Duration(r.TokenMaxTTL) * time.Second
+		if maxTTL > 0 {
+			ttl = min(ttl, maxTTL)
+		}
+		policies := append(slices.Clone(r.TokenPolicies), policyDefault)
+		var te *tokenEntry
+		token, te, err = s.issueToken(tx, nil, "approle-"+r.Name, policies, "approle-"+r.Name, min(ttl, maxTokenTTL), maxTTL, true)
+		if err != nil {
+			return err
+		}
+		info = te.info(tx)
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errInvalidCredentials):
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case errors.Is(err, errSourceNotAllowed):
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if expiredID {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errInvalidCredentials)
+	}
+	s.wakeLeases()
+	return connect.NewResponse(&vaultv1.CreateTokenResponse{Token: token, Info: info}), nil
+}
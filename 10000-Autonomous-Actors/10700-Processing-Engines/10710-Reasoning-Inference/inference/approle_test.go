@@ -0,0 +1,95 @@
+package inference
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+func TestAppRoleLogin(t *testing.T) {
+	_, client, root := newTestClient(t, RequireAuth())
+	ctx := context.Background()
+
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name:  "ci-deploy",
+		Rules: []*vaultv1.PolicyRule{{Path: "ci/*", Capabilities: []string{"read", "create", "update"}}},
+	}}, root))
+
+	role, err := client.PutAppRole(ctx, withToken(&vaultv1.PutAppRoleRequest{Role: &vaultv1.AppRole{
+		Name:               "ci",
+		TokenPolicies:      []string{"ci-deploy"},
+		TokenTtlSeconds:    300,
+		SecretIdNumUses:    2,
+		SecretIdBoundCidrs: []string{"127.0.0.0/8"},
+	}}, root))
+	if err != nil {
+		t.Fatalf("PutAppRole failed: %v", err)
+	}
+	if _, err := client.PutAppRole(ctx, connect.NewRequest(&vaultv1.PutAppRoleRequest{Role: &vaultv1.AppRole{Name: "x"}})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("Expected anonymous PutAppRole to be rejected, got %v", err)
+	}
+
+	secret, err := client.GenerateSecretID(ctx, withToken(&vaultv1.GenerateSecretIDRequest{RoleName: "ci"}, root))
+	if err != nil {
+		t.Fatalf("GenerateSecretID failed: %v", err)
+	}
+
+	login, err := client.Login(ctx, connect.NewRequest(&vaultv1.LoginRequest{RoleId: role.Msg.RoleId, SecretId: secret.Msg.SecretId}))
+	if err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	if _, err := client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "ci/registry", Value: "pw"}, login.Msg.Token)); err != nil {
+		t.Errorf("Expected AppRole token to write ci/registry: %v", err)
+	}
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "prod/root"}, login.Msg.Token)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected AppRole token to be scoped to ci/*, got %v", err)
+	}
+
+	// The second use exhausts the secret-id.
+	client.Login(ctx, connect.NewRequest(&vaultv1.LoginRequest{RoleId: role.Msg.RoleId, SecretId: secret.Msg.SecretId}))
+	if _, err := client.Login(ctx, connect.NewRequest(&vaultv1.LoginRequest{RoleId: role.Msg.RoleId, SecretId: secret.Msg.SecretId})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("Expected exhausted secret-id to be rejected, got %v", err)
+	}
+
+	// A secret-id narrowed to another network cannot log in from loopback.
+	remote, _ := client.GenerateSecretID(ctx, withToken(&vaultv1.GenerateSecretIDRequest{RoleName: "ci", CidrList: []string{"10.0.0.0/8"}}, root))
+	if _, err := client.Login(ctx, connect.NewRequest(&vaultv1.LoginRequest{RoleId: role.Msg.RoleId, SecretId: remote.Msg.SecretId})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected CIDR-bound secret-id to be rejected, got %v", err)
+	}
+
+	destroyed, _ := client.GenerateSecretID(ctx, withToken(&vaultv1.GenerateSecretIDRequest{RoleName: "ci"}, root))
+	client.DestroySecretID(ctx, withToken(&vaultv1.DestroySecretIDRequest{RoleName: "ci", SecretIdAccessor: destroyed.Msg.SecretIdAccessor}, root))
+	if _, err := client.Login(ctx, connect.NewRequest(&vaultv1.LoginRequest{RoleId: role.Msg.RoleId, SecretId: destroyed.Msg.SecretId})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("Expected destroyed secret-id to be rejected, got %v", err)
+	}
+}
+
+func TestAppRoleExpiredSecretIDRemoved(t *testing.T) {
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	server, client, root := newTestClient(t, RequireAuth(), WithClock(func() time.Time { return clock }))
+	ctx := context.Background()
+
+	role, err := client.PutAppRole(ctx, withToken(&vaultv1.PutAppRoleRequest{Role: &vaultv1.AppRole{Name: "batch", SecretIdTtlSeconds: 60}}, root))
+	if err != nil {
+		t.Fatalf("PutAppRole failed: %v", err)
+	}
+	secret, _ := client.GenerateSecretID(ctx, withToken(&vaultv1.GenerateSecretIDRequest{RoleName: "batch"}, root))
+
+	clock = clock.Add(time.Minute)
+	if _, err := client.Login(ctx, connect.NewRequest(&vaultv1.LoginRequest{RoleId: role.Msg.RoleId, SecretId: secret.Msg.SecretId})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("Expected expired secret-id to be rejected, got %v", err)
+	}
+	server.db.View(func(tx *bbolt.Tx) error {
+		if n := tx.Bucket([]byte(bucketSecretIDs)).Stats().KeyN; n != 0 {
+			t.Errorf("Expected the expired secret-id to be deleted, %d remain", n)
+		}
+		if tx.Bucket([]byte(bucketSecretIDAccess)).Get([]byte(secret.Msg.SecretIdAccessor)) != nil {
+			t.Error("Expected the expired secret-id accessor to be deleted")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,176 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+// auditEntry is one record in the vault's flight recorder. Entries are
+// append-only and keyed by a big-endian sequence number.
+type auditEntry struct {
+	Time           time.Time `json:"time"`
+	Operation      string    `json:"operation"`
+	Key            string    `json:"key,omitempty"`
+	Version        int32     `json:"version,omitempty"`
+	Identity       string    `json:"identity,omitempty"`
+	Source         string    `json:"source,omitempty"`
+	CPIFingerprint string    `json:"cpi_fingerprint,omitempty"`
+	Reason         string    `json:"reason,omitempty"`
+	Severity       string    `json:"severity,omitempty"`
+	Detail         string    `json:"detail,omitempty"`
+	Outcome        string    `json:"outcome"`
+	Error          string    `json:"error,omitempty"`
+}
+
+type accessReasonKey struct{}
+
+func withAccessReason(ctx context.Context, reason string) context.Context {
+	if reason == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, accessReasonKey{}, reason)
+}
+
+func accessReasonFrom(ctx context.Context) string {
+	r, _ := ctx.Value(accessReasonKey{}).(string)
+	return r
+}
+
+// checkAccessReason attaches the stated reason for a read to ctx so it
+// reaches the audit log, refusing the read when a policy rule covering
+// the key demands a reason and none was given.
+func (s *VaultServer) checkAccessReason(ctx context.Context, key, reason string) (context.Context, error) {
+	ctx = withAccessReason(ctx, strings.TrimSpace(reason))
+	if c := CallerFrom(ctx); c != nil && c.TokenID != "" && accessReasonFrom(ctx) == "" && s.reasonRequired(c, key) {
+		return ctx, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("an access reason is required to read %s", key))
+	}
+	return ctx, nil
+}
+
+// readOperations are the audit operations that disclose secret material.
+var readOperations = map[string]bool{"VaultRead": true, "GetSecretVersion": true}
+
+func (s *VaultServer) GetAccessHistory(ctx context.Context, req *connect.Request[vaultv1.GetAccessHistoryRequest]) (*connect.Response[vaultv1.GetAccessHistoryResponse], error) {
+	slog.Info("GetAccessHistory", "key", req.Msg.Key)
+	if req.Msg.Key == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key is required"))
+	}
+	if err := s.authorize(ctx, "read", "sys/audit/"+req.Msg.Key); err != nil {
+		return nil, err
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+
+	res := &vaultv1.GetAccessHistoryResponse{}
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		idx := tx.Bucket([]byte(bucketAuditIndex)).Bucket([]byte(req.Msg.Key))
+		if idx == nil {
+			return nil
+		}
+		entries := tx.Bucket([]byte(bucketAudit))
+		c := idx.Cursor()
+		for k, _ := c.Last(); k != nil && len(res.Records) < limit; k, _ = c.Prev() {
+			var e auditEntry
+			if err := json.Unmarshal(entries.Get(k), &e); err != nil {
+				return err
+			}
+			res.Records = append(res.Records, &vaultv1.AccessRecord{
+				Time:      e.Time.Unix(),
+				Operation: e.Operation,
+				Version:   e.Version,
+				Identity:  e.Identity,
+				Source:    e.Source,
+				Reason:    e.Reason,
+				Outcome:   e.Outcome,
+				Error:     e.Error,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// indexAuditEntry adds the entry stored under seq to the per-key index
+// GetAccessHistory reads, if it records a read of a secret.
+func indexAuditEntry(tx *bbolt.Tx, seq []byte, e *auditEntry) error {
+	if e.Key == "" || !readOperations[e.Operation] {
+		return nil
+	}
+	b, err := tx.Bucket([]byte(bucketAuditIndex)).CreateBucketIfNotExists([]byte(e.Key))
+	if err != nil {
+		return err
+	}
+	return b.Put(seq, nil)
+}
+
+// indexAudit builds the access history index from an audit log written
+// before the index existed.
+func indexAudit(tx *bbolt.Tx) error {
+	return tx.Bucket([]byte(bucketAudit)).ForEach(func(k, v []byte) error {
+		var e auditEntry
+		if err := json.Unmarshal(v, &e); err != nil {
+			return err
+		}
+		return indexAuditEntry(tx, k, &e)
+	})
+}
+
+func seqKey(n uint64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, n)
+	return k
+}
+
+// audit records an operation together with whatever identity and
+// attestation the request carried. Failures to record are logged, not
+// returned, so the recorder never masks the operation's own error.
+func (s *VaultServer) audit(ctx context.Context, op, key string, version int32, opErr error) {
+	s.record(ctx, auditEntry{Operation: op, Key: key, Version: version}, opErr)
+}
+
+// record fills in the time, caller and outcome of e and appends it.
+func (s *VaultServer) record(ctx context.Context, e auditEntry, opErr error) {
+	e.Time, e.Outcome = s.now().UTC(), "ok"
+	if c := CallerFrom(ctx); c != nil {
+		e.Identity, e.Source = c.Identity, c.Source
+	}
+	if a := attestationFrom(ctx); a != nil {
+		e.CPIFingerprint = a.Fingerprint
+	}
+	e.Reason = accessReasonFrom(ctx)
+	if opErr != nil {
+		e.Outcome, e.Error = "error", opErr.Error()
+	}
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucketAudit))
+		n, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		data, _ := json.Marshal(e)
+		if err := b.Put(seqKey(n), data); err != nil {
+			return err
+		}
+		return indexAuditEntry(tx, seqKey(n), &e)
+	})
+	if err != nil {
+		slog.Error("Failed to write audit entry", "operation", e.Operation, "key", e.Key, "error", err)
+	}
+}
@@ -0,0 +1,76 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const barrierKeyFile = "barrier.key"
+
+// barrier seals key material at rest under a key kept beside the
+// database, so a copy of vault.db alone does not reveal it.
+type barrier struct {
+	aead cipher.AEAD
+}
+
+// openBarrier loads the barrier key from storageDir, generating it the
+// first time the vault is opened.
+func openBarrier(storageDir string) (*barrier, error) {
+	path := filepath.Join(storageDir, barrierKeyFile)
+	data, err := os.ReadFile(path)
+	var key []byte
+	switch {
+	case err == nil:
+		if key, err = hex.DecodeString(strings.TrimSpace(string(data))); err != nil || len(key) != 32 {
+			return nil, fmt.Errorf("barrier key %s is corrupt", path)
+		}
+	case errors.Is(err, os.ErrNotExist):
+		key = make([]byte, 32)
+		rand.Read(key)
+		if err := os.WriteFile(path, []byte(hex.EncodeToString(key)+"\n"), 0600); err != nil {
+			return nil, err
+		}
+		slog.Warn("Generated barrier key", "path", path)
+	default:
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &barrier{aead: aead}, nil
+}
+
+// seal encrypts plaintext bound to path, the bucket/key it is stored at,
+// so sealed values cannot be swapped between entries.
+func (b *barrier) seal(path string, plaintext []byte) []byte {
+	nonce := make([]byte, b.aead.NonceSize(), b.aead.NonceSize()+len(plaintext)+b.aead.Overhead())
+	rand.Read(nonce)
+	return b.aead.Seal(nonce, nonce, plaintext, []byte(path))
+}
+
+func (b *barrier) open(path string, sealed []byte) ([]byte, error) {
+	n := b.aead.NonceSize()
+	if len(sealed) < n {
+		return nil, fmt.Errorf("sealed value at %s is truncated", path)
+	}
+	plaintext, err := b.aead.Open(nil, sealed[:n], sealed[n:], []byte(path))
+	if err != nil {
+		return nil, fmt.Errorf("cannot unseal %s: %w", path, err)
+	}
+	return plaintext, nil
+}
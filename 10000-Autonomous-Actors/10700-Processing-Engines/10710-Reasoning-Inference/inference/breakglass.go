@@ -0,0 +1,219 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	defaultBreakGlassTTL = time.Hour
+	maxBreakGlassTTL     = 4 * time.Hour
+)
+
+// breakGlassSession records an emergency grant. The token it issued
+// expires on its own; the session stays open until someone other than
+// the requester acknowledges it after the incident.
+type breakGlassSession struct {
+	ID             string    `json:"id"`
+	Prefix         string    `json:"prefix"`
+	Requester      string    `json:"requester"`
+	Justification  string    `json:"justification"`
+	TicketID       string    `json:"ticket_id"`
+	TokenAccessor  string    `json:"token_accessor"`
+	CreateTime     time.Time `json:"create_time"`
+	ExpireTime     time.Time `json:"expire_time"`
+	Status         string    `json:"status"`
+	AcknowledgedBy string    `json:"acknowledged_by,omitempty"`
+	Note           string    `json:"acknowledgement,omitempty"`
+}
+
+func (b *breakGlassSession) policyName() string {
+	return "break-glass-" + b.ID
+}
+
+// breakGlassPath is the ACL path a session on prefix grants. A prefix
+// ending in "/" covers everything below it; any other prefix names a
+// single secret, so "payments" does not also open "payments-prod/".
+func breakGlassPath(prefix string) (string, error) {
+	if strings.Trim(prefix, "/ \t") == "" {
+		return "", fmt.Errorf("a prefix is required")
+	}
+	if strings.Contains(prefix, "*") {
+		return "", fmt.Errorf("prefix must not contain wildcards")
+	}
+	if strings.HasSuffix(prefix, "/") {
+		return prefix + "*", nil
+	}
+	return prefix, nil
+}
+
+func (b *breakGlassSession) proto() *vaultv1.BreakGlassSession {
+	return &vaultv1.BreakGlassSession{
+		Id:              b.ID,
+		Prefix:          b.Prefix,
+		Requester:       b.Requester,
+		Justification:   b.Justification,
+		TicketId:        b.TicketID,
+		CreateTime:      b.CreateTime.Unix(),
+		ExpireTime:      b.ExpireTime.Unix(),
+		Status:          b.Status,
+		AcknowledgedBy:  b.AcknowledgedBy,
+		Acknowledgement: b.Note,
+	}
+}
+
+func (s *VaultServer) BreakGlass(ctx context.Context, req *connect.Request[vaultv1.BreakGlassRequest]) (*connect.Response[vaultv1.BreakGlassResponse], error) {
+	m := req.Msg
+	slog.Info("BreakGlass", "prefix", m.Prefix, "ticket_id", m.TicketId)
+	c := CallerFrom(ctx)
+	if c == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("break-glass requires an authenticated identity"))
+	}
+	if strings.TrimSpace(m.Justification) == "" || strings.TrimSpace(m.TicketId) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("justification and ticket_id are required"))
+	}
+	path, err := breakGlassPath(m.Prefix)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := s.authorize(ctx, "create", "sys/break-glass/"+m.Prefix); err != nil {
+		s.audit(ctx, "BreakGlass", m.Prefix, 0, err)
+		return nil, err
+	}
+	ttl := time.Duration(m.TtlSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = defaultBreakGlassTTL
+	}
+	ttl = min(ttl, maxBreakGlassTTL)
+
+	now := s.now()
+	b := &breakGlassSession{
+		ID:            randomString(12),
+		Prefix:        m.Prefix,
+		Requester:     c.principal(),
+		Justification: m.Justification,
+		TicketID:      m.TicketId,
+		CreateTime:    now,
+		ExpireTime:    now.Add(ttl),
+		Status:        "open",
+	}
+	var token string
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		p, _ := json.Marshal(aclPolicy{
+			Name:  b.policyName(),
+			Rules: []aclRule{{Path: path, Capabilities: []string{"read", "list"}}},
+		})
+		if err := tx.Bucket([]byte(bucketPolicies)).Put([]byte(b.policyName()), p); err != nil {
+			return err
+		}
+		var e *tokenEntry
+		var err error
+		token, e, err = s.issueToken(tx, nil, "break-glass-"+c.Identity, []string{b.policyName(), policyDefault}, "break-glass-"+c.Identity, ttl, ttl, false)
+		if err != nil {
+			return err
+		}
+		b.TokenAccessor = e.Accessor
+		data, _ := json.Marshal(b)
+		return tx.Bucket([]byte(bucketBreakGlass)).Put([]byte(b.ID), data)
+	})
+	s.record(ctx, auditEntry{
+		Operation: "BreakGlass",
+		Key:       b.Prefix,
+		Severity:  "high",
+		Detail:    fmt.Sprintf("session=%s ticket=%s justification=%q", b.ID, b.TicketID, b.Justification),
+	}, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.wakeLeases()
+	slog.Warn("BREAK-GLASS access granted", "id", b.ID, "requester", b.Requester, "prefix", b.Prefix, "ticket_id", b.TicketID, "justification", b.Justification, "expires", b.ExpireTime)
+	return connect.NewResponse(&vaultv1.BreakGlassResponse{Session: b.proto(), Token: token}), nil
+}
+
+func (s *VaultServer) AcknowledgeBreakGlass(ctx context.Context, req *connect.Request[vaultv1.AcknowledgeBreakGlassRequest]) (*connect.Response[vaultv1.BreakGlassSession], error) {
+	slog.Info("AcknowledgeBreakGlass", "id", req.Msg.Id)
+	if err := s.authorizeSudo(ctx, "sys/break-glass/acknowledge"); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Msg.Note) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("an acknowledgement note is required"))
+	}
+	c := CallerFrom(ctx)
+
+	var b breakGlassSession
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		sessions := tx.Bucket([]byte(bucketBreakGlass))
+		data := sessions.Get([]byte(req.Msg.Id))
+		if data == nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("break-glass session not found: %s", req.Msg.Id))
+		}
+		if err := json.Unmarshal(data, &b); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		switch {
+		case b.Status != "open":
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("break-glass session %s is already closed", b.ID))
+		case b.Requester == c.principal():
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("break-glass sessions must be acknowledged by someone other than the requester"))
+		}
+
+		// Close the grant early if the incident ended before the token did.
+		if id := tx.Bucket([]byte(bucketTokenAccessors)).Get([]byte(b.TokenAccessor)); id != nil {
+			if _, err := revokeTokenTree(tx, string(id)); err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+		}
+		if err := tx.Bucket([]byte(bucketPolicies)).Delete([]byte(b.policyName())); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		b.Status, b.AcknowledgedBy, b.Note = "closed", c.principal(), req.Msg.Note
+		data, _ = json.Marshal(b)
+		if err := sessions.Put([]byte(b.ID), data); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	})
+	s.record(ctx, auditEntry{
+		Operation: "AcknowledgeBreakGlass",
+		Key:       b.Prefix,
+		Detail:    fmt.Sprintf("session=%s note=%q", req.Msg.Id, req.Msg.Note),
+	}, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(b.proto()), nil
+}
+
+func (s *VaultServer) ListBreakGlass(ctx context.Context, req *connect.Request[vaultv1.ListBreakGlassRequest]) (*connect.Response[vaultv1.ListBreakGlassResponse], error) {
+	slog.Info("ListBreakGlass", "include_closed", req.Msg.IncludeClosed)
+	if err := s.authorize(ctx, "list", "sys/break-glass/"); err != nil {
+		return nil, err
+	}
+	res := &vaultv1.ListBreakGlassResponse{}
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketBreakGlass)).ForEach(func(_, v []byte) error {
+			var b breakGlassSession
+			if err := json.Unmarshal(v, &b); err != nil {
+				return err
+			}
+			if b.Status == "open" || req.Msg.IncludeClosed {
+				res.Sessions = append(res.Sessions, b.proto())
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(res), nil
+}
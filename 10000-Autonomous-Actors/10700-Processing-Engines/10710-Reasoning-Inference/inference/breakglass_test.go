@@ -0,0 +1,122 @@
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+func TestBreakGlass(t *testing.T) {
+	server, client, root := newTestClient(t)
+	ctx := context.Background()
+
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "payments/api", Value: "k"}, root))
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name:  "on-call",
+		Rules: []*vaultv1.PolicyRule{{Path: "sys/break-glass/*", Capabilities: []string{"create"}}},
+	}}, root))
+	oncall, _ := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{Policies: []string{"on-call"}, DisplayName: "oncall"}, root))
+	engineer := oncall.Msg.Token
+
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "payments/api"}, engineer)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("Expected normal read to be denied, got %v", err)
+	}
+	if _, err := client.BreakGlass(ctx, withToken(&vaultv1.BreakGlassRequest{Prefix: "payments/", TicketId: "INC-1"}, engineer)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("Expected missing justification to be rejected, got %v", err)
+	}
+
+	res, err := client.BreakGlass(ctx, withToken(&vaultv1.BreakGlassRequest{Prefix: "payments/", Justification: "outage", TicketId: "INC-1"}, engineer))
+	if err != nil {
+		t.Fatalf("BreakGlass failed: %v", err)
+	}
+	glass := res.Msg.Token
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "payments/api"}, glass)); err != nil {
+		t.Errorf("Expected break-glass read to succeed: %v", err)
+	}
+	if _, err := client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "payments/api", Value: "x"}, glass)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected break-glass token to be read-only, got %v", err)
+	}
+
+	high := false
+	server.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketAudit)).ForEach(func(_, v []byte) error {
+			var e auditEntry
+			json.Unmarshal(v, &e)
+			high = high || (e.Operation == "BreakGlass" && e.Severity == "high" && e.Outcome == "ok")
+			return nil
+		})
+	})
+	if !high {
+		t.Error("Expected a high-severity audit entry")
+	}
+
+	id := res.Msg.Session.Id
+	if _, err := client.AcknowledgeBreakGlass(ctx, withToken(&vaultv1.AcknowledgeBreakGlassRequest{Id: id}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("Expected acknowledgement without a note to be rejected, got %v", err)
+	}
+	list, _ := client.ListBreakGlass(ctx, withToken(&vaultv1.ListBreakGlassRequest{}, root))
+	if len(list.Msg.Sessions) != 1 {
+		t.Fatalf("Expected one open session, got %d", len(list.Msg.Sessions))
+	}
+	ack, err := client.AcknowledgeBreakGlass(ctx, withToken(&vaultv1.AcknowledgeBreakGlassRequest{Id: id, Note: "reviewed in postmortem"}, root))
+	if err != nil || ack.Msg.Status != "closed" {
+		t.Fatalf("Expected session closed, got %v %v", ack, err)
+	}
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "payments/api"}, glass)); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("Expected break-glass token revoked on acknowledgement, got %v", err)
+	}
+	list, _ = client.ListBreakGlass(ctx, withToken(&vaultv1.ListBreakGlassRequest{}, root))
+	if len(list.Msg.Sessions) != 0 {
+		t.Errorf("Expected no open sessions, got %d", len(list.Msg.Sessions))
+	}
+}
+
+func TestBreakGlassScope(t *testing.T) {
+	_, client, root := newTestClient(t)
+	ctx := context.Background()
+
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "payments", Value: "k"}, root))
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "payments-prod/api", Value: "k"}, root))
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "zzz/other", Value: "k"}, root))
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name: "on-call",
+		Rules: []*vaultv1.PolicyRule{
+			{Path: "sys/break-glass/*", Capabilities: []string{"create"}},
+			{Path: "sys/break-glass/acknowledge", Capabilities: []string{"sudo"}},
+		},
+	}}, root))
+	oncall, _ := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{Policies: []string{"on-call"}, DisplayName: "oncall"}, root))
+	engineer := oncall.Msg.Token
+
+	for _, prefix := range []string{"", "  ", "/", "pay*"} {
+		if _, err := client.BreakGlass(ctx, withToken(&vaultv1.BreakGlassRequest{Prefix: prefix, Justification: "outage", TicketId: "INC-2"}, engineer)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("BreakGlass(%q): expected InvalidArgument, got %v", prefix, err)
+		}
+	}
+
+	res, err := client.BreakGlass(ctx, withToken(&vaultv1.BreakGlassRequest{Prefix: "payments", Justification: "outage", TicketId: "INC-2"}, engineer))
+	if err != nil {
+		t.Fatalf("BreakGlass failed: %v", err)
+	}
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "payments"}, res.Msg.Token)); err != nil {
+		t.Errorf("Expected exact-key session to read payments: %v", err)
+	}
+	for _, key := range []string{"payments-prod/api", "zzz/other"} {
+		if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: key}, res.Msg.Token)); connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("Expected session on payments to be denied %s, got %v", key, err)
+		}
+	}
+
+	// Renaming a child token does not make the requester someone else.
+	other, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{DisplayName: "reviewer"}, engineer))
+	if err != nil {
+		t.Fatalf("CreateToken failed: %v", err)
+	}
+	if _, err := client.AcknowledgeBreakGlass(ctx, withToken(&vaultv1.AcknowledgeBreakGlassRequest{Id: res.Msg.Session.Id, Note: "fine"}, other.Msg.Token)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected requester's child to be denied acknowledgement, got %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/410-Interaction-Models/410-100-Resonance-Bus"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+// sealPayload returns the stored form of val: base64 KMS ciphertext bound
+// to the secret's key when kmsKey is set, val itself otherwise. It also
+// returns the CryptoKeyVersion used.
+func (s *VaultServer) sealPayload(key, kmsKey, val string) (string, string, error) {
+	if kmsKey == "" {
+		return val, "", nil
+	}
+	version, ciphertext, _, err := s.kmsEncrypt(kmsKey, []byte(val), []byte(key))
+	if err != nil {
+		return "", "", connect.NewError(connect.CodeOf(err), fmt.Errorf("encrypting %s with %s: %w", key, kmsKey, err))
+	}
+	return base64.StdEncoding.EncodeToString(ciphertext), version, nil
+}
+
+// openPayload reverses sealPayload for one stored version. Versions whose
+// key version is disabled, destroyed or gone cannot be read.
+func (s *VaultServer) openPayload(key string, version int32, meta versionMeta, stored string) (string, error) {
+	if meta.KMSKeyVersion == "" {
+		return stored, nil
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(stored)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("version %d of %s is corrupt: %w", version, key, err))
+	}
+	kmsKey, _, _ := strings.Cut(meta.KMSKeyVersion, kmsVersionsInfix)
+	plaintext, _, _, err := s.kmsDecrypt(kmsKey, ciphertext, []byte(key))
+	if err != nil {
+		code := connect.CodeFailedPrecondition
+		if connect.CodeOf(err) == connect.CodeInternal {
+			code = connect.CodeInternal
+		}
+		return "", connect.NewError(code, fmt.Errorf("version %d of %s cannot be decrypted with %s: %w", version, key, meta.KMSKeyVersion, err))
+	}
+	return string(plaintext), nil
+}
+
+// ReencryptSecrets moves every live secret version protected by a
+// CryptoKey onto its current primary version, so older key versions can
+// be disabled and destroyed. UpdateCryptoKeyPrimaryVersion does this on
+// its own; calling it again retries the versions that failed then.
+func (s *VaultServer) ReencryptSecrets(ctx context.Context, req *connect.Request[vaultv1.ReencryptSecretsRequest]) (*connect.Response[vaultv1.ReencryptSecretsResponse], error) {
+	kmsKey := req.Msg.KmsKeyName
+	slog.Info("ReencryptSecrets", "kms_key", kmsKey)
+	if err := s.authorizeSudo(ctx, "sys/reencrypt/"+kmsKey); err != nil {
+		return nil, err
+	}
+	res, err := s.reencryptSecrets(ctx, kmsKey)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *VaultServer) reencryptSecrets(ctx context.Context, kmsKey string) (*vaultv1.ReencryptSecretsResponse, error) {
+	k, err := s.loadKMSCryptoKey(kmsKey)
+	if err != nil {
+		return nil, err
+	}
+	if k.Primary == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s has no primary version", kmsKey))
+	}
+	primary := kmsVersionName(kmsKey, k.Primary)
+
+	type stale struct {
+		key     string
+		version int32
+		meta    versionMeta
+		stored  string
+	}
+	var todo []stale
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		h := tx.Bucket([]byte(bucketHistory))
+		return tx.Bucket([]byte(bucketSecretMeta)).ForEach(func(k, v []byte) error {
+			var m secretMeta
+			json.Unmarshal(v, &m)
+			var history []string
+			json.Unmarshal(h.Get(k), &history)
+			for i, vm := range m.Versions {
+				if vm.Destroyed || i >= len(history) || vm.KMSKeyVersion == primary || !strings.HasPrefix(vm.KMSKeyVersion, kmsKey+kmsVersionsInfix) {
+					continue
+				}
+				todo = append(todo, stale{key: string(k), version: int32(i + 1), meta: vm, stored: history[i]})
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &vaultv1.ReencryptSecretsResponse{}
+	for _, st := range todo {
+		val, err := s.openPayload(st.key, st.version, st.meta, st.stored)
+		var stored, kmsVersion string
+		if err == nil {
+			stored, kmsVersion, err = s.sealPayload(st.key, kmsKey, val)
+		}
+		if err == nil {
+			err = s.db.Update(func(tx *bbolt.Tx) error {
+				h := tx.Bucket([]byte(bucketHistory))
+				var history []string
+				json.Unmarshal(h.Get([]byte(st.key)), &history)
+				m := getSecretMeta(tx, st.key)
+				i := int(st.version) - 1
+				// Skip versions changed since the scan, e.g. destroyed.
+				if i >= len(history) || i >= len(m.Versions) || history[i] != st.stored {
+					return nil
+				}
+				history[i] = stored
+				m.Versions[i].KMSKeyVersion = kmsVersion
+				if int32(i+1) == m.current(len(history)) {
+					if err := tx.Bucket([]byte(bucketSecrets)).Put([]byte(st.key), []byte(stored)); err != nil {
+						return err
+					}
+				}
+				data, _ := json.Marshal(history)
+				if err := h.Put([]byte(st.key), data); err != nil {
+					return err
+				}
+				return putSecretMeta(tx, st.key, m)
+			})
+		}
+		s.audit(ctx, "ReencryptSecret", st.key, st.version, err)
+		if err != nil {
+			slog.Warn("Re-encryption failed", "key", st.key, "version", st.version, "error", err)
+			res.Failed = append(res.Failed, fmt.Sprintf("%s@%d", st.key, st.version))
+			continue
+		}
+		s.bus.Emit(resonance.SecretChanged, st.key, fmt.Sprintf("reencrypted=%d", st.version))
+		res.Reencrypted++
+	}
+	return res, nil
+}
@@ -0,0 +1,130 @@
+package inference
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	kmsv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/kms"
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestCMEK(t *testing.T) {
+	server, client, root := newTestClient(t)
+	kms := newKMSClient(t, server)
+	ctx := context.Background()
+
+	kms.CreateKeyRing(ctx, withToken(&kmsv1.CreateKeyRingRequest{Parent: "projects/olympus/locations/global", KeyRingId: "vault"}, root))
+	keyName := kmsTestRing + "/cryptoKeys/cmek"
+	if _, err := kms.CreateCryptoKey(ctx, withToken(&kmsv1.CreateCryptoKeyRequest{Parent: kmsTestRing, CryptoKeyId: "cmek", CryptoKey: &kmsv1.CryptoKey{Purpose: kmsv1.CryptoKey_ENCRYPT_DECRYPT}}, root)); err != nil {
+		t.Fatalf("CreateCryptoKey: %v", err)
+	}
+	setState := func(id string, state kmsv1.CryptoKeyVersion_CryptoKeyVersionState) {
+		t.Helper()
+		if _, err := kms.UpdateCryptoKeyVersion(ctx, withToken(&kmsv1.UpdateCryptoKeyVersionRequest{
+			CryptoKeyVersion: &kmsv1.CryptoKeyVersion{Name: keyName + "/cryptoKeyVersions/" + id, State: state},
+			UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+		}, root)); err != nil {
+			t.Fatalf("UpdateCryptoKeyVersion(%s): %v", id, err)
+		}
+	}
+	rotate := func(id string) {
+		t.Helper()
+		kms.CreateCryptoKeyVersion(ctx, withToken(&kmsv1.CreateCryptoKeyVersionRequest{Parent: keyName}, root))
+		if _, err := kms.UpdateCryptoKeyPrimaryVersion(ctx, withToken(&kmsv1.UpdateCryptoKeyPrimaryVersionRequest{Name: keyName, CryptoKeyVersionId: id}, root)); err != nil {
+			t.Fatalf("UpdateCryptoKeyPrimaryVersion(%s): %v", id, err)
+		}
+	}
+	read := func(version int32) (string, error) {
+		res, err := client.GetSecretVersion(ctx, withToken(&vaultv1.GetSecretVersionRequest{Key: "payments/api", Version: version}, root))
+		if err != nil {
+			return "", err
+		}
+		return res.Msg.Value, nil
+	}
+	keyVersions := func() []string {
+		t.Helper()
+		res, err := client.GetSecretMetadata(ctx, withToken(&vaultv1.GetSecretMetadataRequest{Key: "payments/api"}, root))
+		if err != nil {
+			t.Fatalf("GetSecretMetadata: %v", err)
+		}
+		if res.Msg.KmsKeyName != keyName {
+			t.Errorf("kms_key_name = %q", res.Msg.KmsKeyName)
+		}
+		var names []string
+		for _, v := range res.Msg.Versions {
+			names = append(names, strings.TrimPrefix(v.KmsKeyVersionName, keyName+"/cryptoKeyVersions/"))
+		}
+		return names
+	}
+
+	if _, err := client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "payments/api", Value: "sk_live_1", KmsKeyName: keyName}, root)); err != nil {
+		t.Fatalf("VaultWrite: %v", err)
+	}
+	// Later writes keep the secret's key without naming it again.
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "payments/api", Value: "sk_live_2"}, root))
+	if got := keyVersions(); strings.Join(got, ",") != "1,1" {
+		t.Errorf("key versions = %v, want [1 1]", got)
+	}
+	server.db.View(func(tx *bbolt.Tx) error {
+		if cur := tx.Bucket([]byte(bucketSecrets)).Get([]byte("payments/api")); strings.Contains(string(cur), "sk_live") {
+			t.Error("CMEK secret stored in the clear")
+		}
+		return nil
+	})
+	res, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "payments/api"}, root))
+	if err != nil || res.Msg.Value != "sk_live_2" {
+		t.Fatalf("VaultRead = %v, %v", res, err)
+	}
+
+	// Changing the primary version re-encrypts every version onto it, after
+	// which the old key version can be disabled.
+	rotate("2")
+	if got := keyVersions(); strings.Join(got, ",") != "2,2" {
+		t.Errorf("key versions after re-encryption = %v, want [2 2]", got)
+	}
+	re, err := client.ReencryptSecrets(ctx, withToken(&vaultv1.ReencryptSecretsRequest{KmsKeyName: keyName}, root))
+	if err != nil || re.Msg.Reencrypted != 0 || len(re.Msg.Failed) != 0 {
+		t.Fatalf("ReencryptSecrets after primary change = %+v, %v", re, err)
+	}
+	setState("1", kmsv1.CryptoKeyVersion_DISABLED)
+	if v, err := read(1); err != nil || v != "sk_live_1" {
+		t.Errorf("version 1 after re-encryption = %q, %v", v, err)
+	}
+
+	// Reads fail while the protecting key version is disabled or destroyed.
+	setState("2", kmsv1.CryptoKeyVersion_DISABLED)
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "payments/api"}, root)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("VaultRead with disabled key version: expected FailedPrecondition, got %v", err)
+	}
+	// Versions that could not move with the primary are retried by hand
+	// once their key version is usable again.
+	rotate("3")
+	if re, _ := client.ReencryptSecrets(ctx, withToken(&vaultv1.ReencryptSecretsRequest{KmsKeyName: keyName}, root)); re.Msg.Reencrypted != 0 || len(re.Msg.Failed) != 2 {
+		t.Errorf("ReencryptSecrets with disabled source = %+v, want 2 failures", re.Msg)
+	}
+	setState("2", kmsv1.CryptoKeyVersion_ENABLED)
+	if re, _ := client.ReencryptSecrets(ctx, withToken(&vaultv1.ReencryptSecretsRequest{KmsKeyName: keyName}, root)); re.Msg.Reencrypted != 2 || len(re.Msg.Failed) != 0 {
+		t.Errorf("ReencryptSecrets after re-enabling = %+v, want 2 re-encrypted", re.Msg)
+	}
+	if got := keyVersions(); strings.Join(got, ",") != "3,3" {
+		t.Errorf("key versions after retry = %v, want [3 3]", got)
+	}
+	kms.DestroyCryptoKeyVersion(ctx, withToken(&kmsv1.DestroyCryptoKeyVersionRequest{Name: keyName + "/cryptoKeyVersions/3"}, root))
+	if _, err := read(2); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("GetSecretVersion with destroyed key version: expected FailedPrecondition, got %v", err)
+	}
+
+	// New writes use the primary version.
+	rotate("4")
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "payments/api", Value: "sk_live_3"}, root))
+	if v, err := read(3); err != nil || v != "sk_live_3" {
+		t.Errorf("version 3 = %q, %v", v, err)
+	}
+	if _, err := client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "other", Value: "x", KmsKeyName: kmsTestRing + "/cryptoKeys/missing"}, root)); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("VaultWrite with unknown key: expected NotFound, got %v", err)
+	}
+}
@@ -0,0 +1,265 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+const defaultAccessRequestTTL = time.Hour
+
+const (
+	accessPending  = "pending"
+	accessApproved = "approved"
+	accessRedeemed = "redeemed"
+)
+
+type controlGroup struct {
+	Required int32 `json:"required_approvals"`
+	TTL      int64 `json:"ttl"`
+}
+
+// accessRequest tracks one requester's attempt to read a control-group
+// secret. An approved request can be redeemed exactly once.
+type accessRequest struct {
+	ID         string    `json:"id"`
+	Key        string    `json:"key"`
+	Requester  string    `json:"requester"`
+	Approvers  []string  `json:"approvers"`
+	Required   int32     `json:"required_approvals"`
+	Status     string    `json:"status"`
+	CreateTime time.Time `json:"create_time"`
+	ExpireTime time.Time `json:"expire_time"`
+}
+
+func (r *accessRequest) proto() *vaultv1.AccessRequest {
+	return &vaultv1.AccessRequest{
+		Id:                r.ID,
+		Key:               r.Key,
+		Requester:         r.Requester,
+		Approvers:         r.Approvers,
+		RequiredApprovals: r.Required,
+		Status:            r.Status,
+		ExpireTime:        r.ExpireTime.Unix(),
+	}
+}
+
+func getAccessRequest(tx *bbolt.Tx, id string) (*accessRequest, error) {
+	data := tx.Bucket([]byte(bucketAccessRequests)).Get([]byte(id))
+	if data == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("access request not found: %s", id))
+	}
+	var r accessRequest
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &r, nil
+}
+
+func putAccessRequest(tx *bbolt.Tx, r *accessRequest) error {
+	data, _ := json.Marshal(r)
+	return tx.Bucket([]byte(bucketAccessRequests)).Put([]byte(r.ID), data)
+}
+
+func (s *VaultServer) PutControlGroup(ctx context.Context, req *connect.Request[vaultv1.PutControlGroupRequest]) (*connect.Response[vaultv1.PutControlGroupResponse], error) {
+	slog.Info("PutControlGroup", "key", req.Msg.Key)
+	if req.Msg.Key == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key is required"))
+	}
+	if err := s.authorizeSudo(ctx, "sys/control-group/"+req.Msg.Key); err != nil {
+		return nil, err
+	}
+	cg := req.Msg.ControlGroup
+	if cg != nil && cg.RequiredApprovals < 1 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("required_approvals must be at least 1"))
+	}
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucketControlGroups))
+		if cg == nil {
+			return b.Delete([]byte(req.Msg.Key))
+		}
+		data, _ := json.Marshal(controlGroup{Required: cg.RequiredApprovals, TTL: cg.TtlSeconds})
+		return b.Put([]byte(req.Msg.Key), data)
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutControlGroupResponse{}), nil
+}
+
+// controlGroupGate holds back reads of control-group secrets. Without a
+// request id it opens a pending request and returns its id; with one it
+// checks that the caller's request is approved, and reports through
+// redeem that the read must consume it via redeemAccess once it succeeds.
+func (s *VaultServer) controlGroupGate(ctx context.Context, key, requestID string) (pending string, redeem bool, err error) {
+	var cg *controlGroup
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		if data := tx.Bucket([]byte(bucketControlGroups)).Get([]byte(key)); data != nil {
+			cg = &controlGroup{}
+			return json.Unmarshal(data, cg)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", false, connect.NewError(connect.CodeInternal, err)
+	}
+	if cg == nil {
+		return "", false, nil
+	}
+	c := CallerFrom(ctx)
+	if c == nil {
+		return "", false, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("%s is in a control group; an authenticated identity is required", key))
+	}
+	now := s.now()
+
+	if requestID == "" {
+		ttl := time.Duration(cg.TTL) * time.Second
+		if ttl <= 0 {
+			ttl = defaultAccessRequestTTL
+		}
+		r := &accessRequest{
+			ID:         randomString(16),
+			Key:        key,
+			Requester:  c.principal(),
+			Required:   cg.Required,
+			Status:     accessPending,
+			CreateTime: now,
+			ExpireTime: now.Add(ttl),
+		}
+		err := s.db.Update(func(tx *bbolt.Tx) error { return putAccessRequest(tx, r) })
+		s.audit(ctx, "RequestAccess", key, 0, err)
+		if err != nil {
+			return "", false, connect.NewError(connect.CodeInternal, err)
+		}
+		return r.ID, false, nil
+	}
+
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		r, err := getAccessRequest(tx, requestID)
+		if err != nil {
+			return err
+		}
+		return r.checkRedeemable(key, c.principal(), now)
+	})
+	if err != nil {
+		s.audit(ctx, "RedeemAccess", key, 0, err)
+		return "", false, err
+	}
+	return "", true, nil
+}
+
+// redeemAccess consumes an approved request after the read it unlocked has
+// succeeded, so a failed read leaves the grant usable. The approval is
+// checked again because a concurrent read may have redeemed it first.
+func (s *VaultServer) redeemAccess(ctx context.Context, key, requestID string) error {
+	c := CallerFrom(ctx)
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		r, err := getAccessRequest(tx, requestID)
+		if err != nil {
+			return err
+		}
+		if err := r.checkRedeemable(key, c.principal(), s.now()); err != nil {
+			return err
+		}
+		r.Status = accessRedeemed
+		return putAccessRequest(tx, r)
+	})
+	s.audit(ctx, "RedeemAccess", key, 0, err)
+	return err
+}
+
+func (r *accessRequest) checkRedeemable(key, principal string, now time.Time) error {
+	switch {
+	case r.Key != key || r.Requester != principal:
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("access request %s was not issued to %s for %s", r.ID, principal, key))
+	case !now.Before(r.ExpireTime):
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("access request %s has expired", r.ID))
+	case r.Status == accessRedeemed:
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("access request %s has already been redeemed", r.ID))
+	case r.Status != accessApproved:
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("access request %s has %d of %d approvals", r.ID, len(r.Approvers), r.Required))
+	}
+	return nil
+}
+
+func (s *VaultServer) ApproveAccess(ctx context.Context, req *connect.Request[vaultv1.ApproveAccessRequest]) (*connect.Response[vaultv1.AccessRequest], error) {
+	slog.Info("ApproveAccess", "request_id", req.Msg.RequestId)
+	c := CallerFrom(ctx)
+	if c == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("approvals require an authenticated identity"))
+	}
+	var key string
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		r, err := getAccessRequest(tx, req.Msg.RequestId)
+		if err == nil {
+			key = r.Key
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, "update", "sys/control-group/"+key); err != nil {
+		s.audit(ctx, "ApproveAccess", key, 0, err)
+		return nil, err
+	}
+
+	var res *accessRequest
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		r, err := getAccessRequest(tx, req.Msg.RequestId)
+		if err != nil {
+			return err
+		}
+		switch {
+		case r.Requester == c.principal():
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("requesters cannot approve their own access"))
+		case !s.now().Before(r.ExpireTime):
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("access request %s has expired", r.ID))
+		case r.Status == accessRedeemed:
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("access request %s has already been redeemed", r.ID))
+		}
+		if !slices.Contains(r.Approvers, c.principal()) {
+			r.Approvers = append(r.Approvers, c.principal())
+		}
+		if int32(len(r.Approvers)) >= r.Required {
+			r.Status = accessApproved
+		}
+		res = r
+		return putAccessRequest(tx, r)
+	})
+	s.audit(ctx, "ApproveAccess", key, 0, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res.proto()), nil
+}
+
+func (s *VaultServer) GetAccessRequest(ctx context.Context, req *connect.Request[vaultv1.GetAccessRequestRequest]) (*connect.Response[vaultv1.AccessRequest], error) {
+	slog.Info("GetAccessRequest", "request_id", req.Msg.RequestId)
+	var r *accessRequest
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		r, err = getAccessRequest(tx, req.Msg.RequestId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Requesters may poll their own request; anyone else needs to be able
+	// to see the control group.
+	if c := CallerFrom(ctx); c == nil || c.principal() != r.Requester {
+		if err := s.authorize(ctx, "read", "sys/control-group/"+r.Key); err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(r.proto()), nil
+}
@@ -0,0 +1,139 @@
+package inference
+
+import (
+	"context"
+	"testing"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+)
+
+func TestControlGroupApproval(t *testing.T) {
+	_, client, root := newTestClient(t)
+	ctx := context.Background()
+
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "prod/root", Value: "hunter2"}, root))
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name:  "prod-reader",
+		Rules: []*vaultv1.PolicyRule{{Path: "prod/*", Capabilities: []string{"read"}}},
+	}}, root))
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name:  "approver",
+		Rules: []*vaultv1.PolicyRule{{Path: "sys/control-group/*", Capabilities: []string{"read", "update"}}, {Path: "prod/*", Capabilities: []string{"read"}}},
+	}}, root))
+	token := func(name, policy string) string {
+		res, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{Policies: []string{policy}, DisplayName: name}, root))
+		if err != nil {
+			t.Fatalf("CreateToken failed: %v", err)
+		}
+		return res.Msg.Token
+	}
+	requester, alice, bob := token("requester", "prod-reader"), token("alice", "approver"), token("bob", "approver")
+
+	if _, err := client.PutControlGroup(ctx, withToken(&vaultv1.PutControlGroupRequest{Key: "prod/root", ControlGroup: &vaultv1.ControlGroup{RequiredApprovals: 2}}, root)); err != nil {
+		t.Fatalf("PutControlGroup failed: %v", err)
+	}
+
+	res, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "prod/root"}, requester))
+	if err != nil {
+		t.Fatalf("VaultRead failed: %v", err)
+	}
+	id := res.Msg.AccessRequestId
+	if id == "" || res.Msg.Value != "" {
+		t.Fatalf("Expected pending request instead of value, got %+v", res.Msg)
+	}
+
+	redeem := func() error {
+		_, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "prod/root", AccessRequestId: id}, requester))
+		return err
+	}
+	approve := func(token string) (*vaultv1.AccessRequest, error) {
+		res, err := client.ApproveAccess(ctx, withToken(&vaultv1.ApproveAccessRequest{RequestId: id}, token))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}
+
+	if _, err := approve(requester); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected requester without approver policy to be denied, got %v", err)
+	}
+	if r, err := approve(alice); err != nil || r.Status != accessPending {
+		t.Fatalf("Expected first approval to leave request pending, got %v %v", r, err)
+	}
+	approve(alice)
+	if err := redeem(); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Expected redeem before quorum to fail, got %v", err)
+	}
+	if r, err := approve(bob); err != nil || r.Status != accessApproved {
+		t.Fatalf("Expected quorum to approve request, got %v %v", r, err)
+	}
+
+	// Only the requester can redeem, and only once.
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "prod/root", AccessRequestId: id}, alice)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected approver redeem to be denied, got %v", err)
+	}
+	// A read that fails leaves the grant for the next attempt.
+	if _, err := client.GetSecretVersion(ctx, withToken(&vaultv1.GetSecretVersionRequest{Key: "prod/root", Version: 9, AccessRequestId: id}, requester)); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("Expected missing version to be NotFound, got %v", err)
+	}
+	got, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "prod/root", AccessRequestId: id}, requester))
+	if err != nil || got.Msg.Value != "hunter2" {
+		t.Fatalf("Expected redeemed read to return value, got %v %v", got, err)
+	}
+	if err := redeem(); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Expected second redeem to fail, got %v", err)
+	}
+}
+
+func TestControlGroupChildTokensShareApprover(t *testing.T) {
+	_, client, root := newTestClient(t)
+	ctx := context.Background()
+
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "prod/root", Value: "hunter2"}, root))
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name:  "approver",
+		Rules: []*vaultv1.PolicyRule{{Path: "sys/control-group/*", Capabilities: []string{"read", "update"}}, {Path: "prod/*", Capabilities: []string{"read"}}},
+	}}, root))
+	client.PutControlGroup(ctx, withToken(&vaultv1.PutControlGroupRequest{Key: "prod/root", ControlGroup: &vaultv1.ControlGroup{RequiredApprovals: 2}}, root))
+	token := func(parent, name string) string {
+		t.Helper()
+		req := &vaultv1.CreateTokenRequest{DisplayName: name}
+		if parent == root {
+			req.Policies = []string{"approver"}
+		}
+		res, err := client.CreateToken(ctx, withToken(req, parent))
+		if err != nil {
+			t.Fatalf("CreateToken(%s) failed: %v", name, err)
+		}
+		return res.Msg.Token
+	}
+	requester, approver := token(root, "requester"), token(root, "approver")
+
+	res, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "prod/root"}, requester))
+	if err != nil {
+		t.Fatalf("VaultRead failed: %v", err)
+	}
+	id := res.Msg.AccessRequestId
+
+	// Children of one approver are the same principal, whatever they are called.
+	for _, name := range []string{"x1", "x2"} {
+		r, err := client.ApproveAccess(ctx, withToken(&vaultv1.ApproveAccessRequest{RequestId: id}, token(approver, name)))
+		if err != nil {
+			t.Fatalf("ApproveAccess(%s) failed: %v", name, err)
+		}
+		if r.Msg.Status != accessPending || len(r.Msg.Approvers) != 1 {
+			t.Errorf("after approval by child %s: status %s, approvers %v; want one pending approval", name, r.Msg.Status, r.Msg.Approvers)
+		}
+	}
+
+	// A child named after the requester cannot redeem the request.
+	client.ApproveAccess(ctx, withToken(&vaultv1.ApproveAccessRequest{RequestId: id}, token(root, "bob")))
+	impostor := token(approver, "requester")
+	if got, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "prod/root", AccessRequestId: id}, impostor)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected impersonated redeem to be denied, got %v %v", got, err)
+	}
+	if got, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "prod/root", AccessRequestId: id}, requester)); err != nil || got.Msg.Value != "hunter2" {
+		t.Errorf("Expected requester to redeem, got %v %v", got, err)
+	}
+}
@@ -0,0 +1,181 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+// CPIHeader carries the Composite Platform Identity attestation token.
+const CPIHeader = "X-Olympus-CPI"
+
+const cpiConfigKey = "config"
+
+type cpiConfig struct {
+	JWKSURLs     []string `json:"jwks_urls"`
+	JWKSFiles    []string `json:"jwks_files"`
+	Issuer       string   `json:"issuer"`
+	Audience     string   `json:"audience"`
+	Prohibited   []string `json:"prohibited"`
+	RegistryFile string   `json:"prohibition_registry_file"`
+}
+
+// cpiAttestation is what a verified CPI token tells us about the platform.
+type cpiAttestation struct {
+	Subject     string
+	Platform    string
+	Mechanism   string
+	Fingerprint string
+}
+
+type attestationKey struct{}
+
+func withAttestation(ctx context.Context, a *cpiAttestation) context.Context {
+	return context.WithValue(ctx, attestationKey{}, a)
+}
+
+func attestationFrom(ctx context.Context) *cpiAttestation {
+	a, _ := ctx.Value(attestationKey{}).(*cpiAttestation)
+	return a
+}
+
+func (s *VaultServer) PutCPIConfig(ctx context.Context, req *connect.Request[vaultv1.PutCPIConfigRequest]) (*connect.Response[vaultv1.PutCPIConfigResponse], error) {
+	slog.Info("PutCPIConfig")
+	if err := s.authorizeSudo(ctx, "sys/cpi/config"); err != nil {
+		return nil, err
+	}
+	c := req.Msg.Config
+	if c == nil || len(c.JwksUrls)+len(c.JwksFiles) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one CPI trust JWKS file or URL is required"))
+	}
+	data, _ := json.Marshal(cpiConfig{
+		JWKSURLs:     c.JwksUrls,
+		JWKSFiles:    c.JwksFiles,
+		Issuer:       c.Issuer,
+		Audience:     c.Audience,
+		Prohibited:   c.Prohibited,
+		RegistryFile: c.ProhibitionRegistryFile,
+	})
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketCPI)).Put([]byte(cpiConfigKey), data)
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutCPIConfigResponse{}), nil
+}
+
+func (s *VaultServer) loadCPIConfig() (*cpiConfig, error) {
+	var cfg *cpiConfig
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket([]byte(bucketCPI)).Get([]byte(cpiConfigKey))
+		if data == nil {
+			return nil
+		}
+		cfg = &cpiConfig{}
+		return json.Unmarshal(data, cfg)
+	})
+	return cfg, err
+}
+
+// prohibitionRegistry merges configured entries with the registry file.
+func (c *cpiConfig) prohibitionRegistry() ([]string, error) {
+	entries := slices.Clone(c.Prohibited)
+	if c.RegistryFile == "" {
+		return entries, nil
+	}
+	f, err := os.Open(c.RegistryFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			entries = append(entries, line)
+		}
+	}
+	return entries, sc.Err()
+}
+
+// attest enforces the Refusal to Run mandate for a read of resource. It is
+// a no-op until CPI trust keys are configured. The token must verify
+// against the trust keys, sit inside its temporal window, be absent from
+// the Global Prohibition Registry and satisfy the sovereign policy.
+func (s *VaultServer) attest(ctx context.Context, header interface{ Get(string) string }, resource string) (context.Context, error) {
+	cfg, err := s.loadCPIConfig()
+	if err != nil {
+		return ctx, connect.NewError(connect.CodeInternal, err)
+	}
+	if cfg == nil {
+		return ctx, nil
+	}
+
+	token := header.Get(CPIHeader)
+	if token == "" {
+		return ctx, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("refusal to run: no CPI attestation presented"))
+	}
+	keys, err := s.keySet(&jwtConfig{JWKSURLs: cfg.JWKSURLs, JWKSFiles: cfg.JWKSFiles})
+	if err != nil {
+		return ctx, connect.NewError(connect.CodeUnavailable, err)
+	}
+	now := s.now()
+	claims, err := verifyJWT(token, keys, now, 0)
+	if err != nil {
+		return ctx, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("refusal to run: %w", err))
+	}
+	if cfg.Issuer != "" && claims.str("iss") != cfg.Issuer {
+		return ctx, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("refusal to run: CPI issuer %q is not trusted", claims.str("iss")))
+	}
+	if cfg.Audience != "" && !audienceMatches(claims, []string{cfg.Audience}) {
+		return ctx, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("refusal to run: CPI audience mismatch"))
+	}
+
+	a := &cpiAttestation{
+		Subject:     claims.str("sub"),
+		Platform:    claims.str("platform"),
+		Mechanism:   claims.str("mechanism"),
+		Fingerprint: claims.str("fingerprint"),
+	}
+	if a.Fingerprint == "" {
+		return ctx, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("refusal to run: CPI token carries no fingerprint"))
+	}
+
+	if window, ok := claims["window"].(map[string]any); ok {
+		w := jwtClaims(window)
+		if start, ok := w.time("start"); ok && now.Before(start) {
+			return ctx, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("refusal to run: temporal window opens at %s", start.UTC().Format(time.RFC3339)))
+		}
+		if end, ok := w.time("end"); ok && !now.Before(end) {
+			return ctx, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("refusal to run: temporal window closed at %s", end.UTC().Format(time.RFC3339)))
+		}
+	}
+
+	registry, err := cfg.prohibitionRegistry()
+	if err != nil {
+		return ctx, connect.NewError(connect.CodeUnavailable, fmt.Errorf("global prohibition registry unavailable: %w", err))
+	}
+	for _, id := range []string{a.Fingerprint, a.Platform, a.Subject} {
+		if id != "" && slices.Contains(registry, id) {
+			return ctx, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("refusal to run: %s is on the global prohibition registry", id))
+		}
+	}
+
+	if allowed, reason := s.pe.Authorize("George", a.Subject, resource); !allowed {
+		return ctx, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("refusal to run: sovereign policy denies %s: %s", a.Subject, reason))
+	}
+	return withAttestation(ctx, a), nil
+}
@@ -0,0 +1,100 @@
+package inference
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+func TestCPIRefusalToRun(t *testing.T) {
+	server, client, root := newTestClient(t)
+	ctx := context.Background()
+
+	_, attestor, _ := ed25519.GenerateKey(rand.Reader)
+	dir := t.TempDir()
+	jwksFile := filepath.Join(dir, "cpi-jwks.json")
+	set, _ := json.Marshal(jwkSet{Keys: []jwk{publicJWK(attestor, "attestor")}})
+	os.WriteFile(jwksFile, set, 0600)
+	registry := filepath.Join(dir, "prohibition.registry")
+	os.WriteFile(registry, []byte("# Global Prohibition Registry\nMFI-REVOKED-7\n"), 0600)
+
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "prod/db", Value: "s3cret"}, root))
+
+	// Reads are unrestricted until CPI trust is configured.
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "prod/db"}, root)); err != nil {
+		t.Fatalf("Expected read before CPI config to succeed: %v", err)
+	}
+	if _, err := client.PutCPIConfig(ctx, withToken(&vaultv1.PutCPIConfigRequest{Config: &vaultv1.CPIConfig{
+		JwksFiles:               []string{jwksFile},
+		Issuer:                  "olympus-attestor",
+		ProhibitionRegistryFile: registry,
+	}}, root)); err != nil {
+		t.Fatalf("PutCPIConfig failed: %v", err)
+	}
+
+	now := time.Now()
+	cpi := func(fingerprint string, window map[string]any) string {
+		claims := map[string]any{
+			"iss":         "olympus-attestor",
+			"sub":         "flight-001",
+			"platform":    "workstation-athena",
+			"mechanism":   "hardware-key",
+			"fingerprint": fingerprint,
+			"exp":         now.Add(time.Hour).Unix(),
+		}
+		if window != nil {
+			claims["window"] = window
+		}
+		return mintJWT(t, attestor, "attestor", claims)
+	}
+	read := func(token string) error {
+		req := withToken(&vaultv1.VaultReadRequest{Key: "prod/db"}, root)
+		if token != "" {
+			req.Header().Set(CPIHeader, token)
+		}
+		_, err := client.VaultRead(ctx, req)
+		return err
+	}
+
+	if err := read(""); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("Expected read without CPI to be refused, got %v", err)
+	}
+	if err := read(cpi("MFI-OK-1", nil)); err != nil {
+		t.Errorf("Expected attested read to succeed: %v", err)
+	}
+	if err := read(cpi("MFI-REVOKED-7", nil)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected prohibited fingerprint to be refused, got %v", err)
+	}
+	if err := read(cpi("MFI-OK-1", map[string]any{"start": now.Add(time.Hour).Unix()})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected read before temporal window to be refused, got %v", err)
+	}
+	_, forged, _ := ed25519.GenerateKey(rand.Reader)
+	if err := read(mintJWT(t, forged, "attestor", map[string]any{"iss": "olympus-attestor", "fingerprint": "MFI-OK-1", "exp": now.Add(time.Hour).Unix()})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("Expected forged CPI to be refused, got %v", err)
+	}
+
+	// The successful read is recorded with its CPI fingerprint.
+	found := false
+	server.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketAudit)).ForEach(func(_, v []byte) error {
+			var e auditEntry
+			json.Unmarshal(v, &e)
+			if e.Operation == "VaultRead" && e.Outcome == "ok" && e.CPIFingerprint == "MFI-OK-1" {
+				found = true
+			}
+			return nil
+		})
+	})
+	if !found {
+		t.Error("Expected audit entry carrying the CPI fingerprint")
+	}
+}
@@ -0,0 +1,476 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+	_ "modernc.org/sqlite"
+)
+
+const defaultDatabaseTTL = time.Hour
+
+// DatabaseDriver creates and drops the short-lived users handed out by the
+// database secrets engine. Statements arrive already rendered.
+type DatabaseDriver interface {
+	CreateUser(ctx context.Context, statements []string, username, password string) error
+	RenewUser(ctx context.Context, statements []string, username string) error
+	RevokeUser(ctx context.Context, statements []string, username string) error
+	Close() error
+}
+
+var (
+	databasePluginsMu sync.RWMutex
+	databasePlugins   = map[string]func(connectionURL string) (DatabaseDriver, error){
+		"embedded": openEmbeddedDatabase,
+	}
+)
+
+// RegisterDatabasePlugin makes a driver available to PutDatabaseConfig
+// under name. Names not registered here fall back to the database/sql
+// driver of the same name, so linking a SQL driver into VaultManager is
+// enough to use it.
+func RegisterDatabasePlugin(name string, open func(connectionURL string) (DatabaseDriver, error)) {
+	databasePluginsMu.Lock()
+	defer databasePluginsMu.Unlock()
+	databasePlugins[name] = open
+}
+
+func openDatabase(plugin, connectionURL string) (DatabaseDriver, error) {
+	databasePluginsMu.RLock()
+	open := databasePlugins[plugin]
+	databasePluginsMu.RUnlock()
+	if open != nil {
+		return open(connectionURL)
+	}
+	if !slices.Contains(sql.Drivers(), plugin) {
+		return nil, fmt.Errorf("unknown database plugin %q", plugin)
+	}
+	db, err := sql.Open(plugin, connectionURL)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlDatabase{db: db}, nil
+}
+
+// renderStatements fills the {{name}}, {{password}} and {{expiration}}
+// placeholders of a role's statements.
+func renderStatements(statements []string, username, password string, expiration time.Time) []string {
+	r := strings.NewReplacer(
+		"{{name}}", username,
+		"{{username}}", username,
+		"{{password}}", password,
+		"{{expiration}}", expiration.UTC().Format("2006-01-02 15:04:05Z07:00"),
+	)
+	out := make([]string, len(statements))
+	for i, st := range statements {
+		out[i] = r.Replace(st)
+	}
+	return out
+}
+
+// sqlDatabase runs statements through database/sql in a single transaction.
+// Each statement is sent as configured, so string literals, DO blocks and
+// procedure bodies may contain semicolons; a role that needs several
+// commands lists them as separate statements.
+type sqlDatabase struct {
+	db *sql.DB
+}
+
+func (d *sqlDatabase) exec(ctx context.Context, statements []string) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, st := range statements {
+		if strings.TrimSpace(st) == "" {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, st); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *sqlDatabase) CreateUser(ctx context.Context, statements []string, _, _ string) error {
+	return d.exec(ctx, statements)
+}
+
+func (d *sqlDatabase) RenewUser(ctx context.Context, statements []string, _ string) error {
+	return d.exec(ctx, statements)
+}
+
+func (d *sqlDatabase) RevokeUser(ctx context.Context, statements []string, _ string) error {
+	return d.exec(ctx, statements)
+}
+
+func (d *sqlDatabase) Close() error {
+	return d.db.Close()
+}
+
+// openEmbeddedDatabase opens the SQLite database at connectionURL, a file
+// path or SQLite URI. SQLite has no users of its own, so roles on an
+// embedded database keep credentials in tables their statements write.
+func openEmbeddedDatabase(connectionURL string) (DatabaseDriver, error) {
+	if connectionURL == "" {
+		return nil, fmt.Errorf("the embedded plugin needs a SQLite file as connection_url")
+	}
+	db, err := sql.Open("sqlite", connectionURL)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlDatabase{db: db}, nil
+}
+
+// databaseConns caches one open driver per database config.
+type databaseConns struct {
+	mu    sync.Mutex
+	conns map[string]DatabaseDriver
+}
+
+func (c *databaseConns) get(name string, cfg *databaseConfig) (DatabaseDriver, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if d, ok := c.conns[name]; ok {
+		return d, nil
+	}
+	d, err := openDatabase(cfg.Plugin, cfg.ConnectionURL)
+	if err != nil {
+		return nil, err
+	}
+	if c.conns == nil {
+		c.conns = map[string]DatabaseDriver{}
+	}
+	c.conns[name] = d
+	return d, nil
+}
+
+func (c *databaseConns) reset(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if d, ok := c.conns[name]; ok {
+		d.Close()
+		delete(c.conns, name)
+	}
+}
+
+func (c *databaseConns) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for name, d := range c.conns {
+		d.Close()
+		delete(c.conns, name)
+	}
+}
+
+type databaseConfig struct {
+	Plugin string `json:"plugin"`
+	// ConnectionURL may carry admin credentials. It is stored sealed by
+	// the barrier in SealedURL; configs written before that keep it in
+	// the clear until sealDatabaseConfigs moves it.
+	ConnectionURL string   `json:"connection_url,omitempty"`
+	SealedURL     []byte   `json:"sealed_connection_url,omitempty"`
+	AllowedRoles  []string `json:"allowed_roles,omitempty"`
+}
+
+type databaseRole struct {
+	DBName     string   `json:"db_name"`
+	Creation   []string `json:"creation_statements"`
+	Revocation []string `json:"revocation_statements"`
+	Renewal    []string `json:"renew_statements,omitempty"`
+	DefaultTTL int64    `json:"default_ttl,omitempty"`
+	MaxTTL     int64    `json:"max_ttl,omitempty"`
+}
+
+// databaseUser is the lease data for an issued user. It carries the
+// statements needed to renew and revoke it so later role edits cannot
+// orphan the user.
+type databaseUser struct {
+	Role       string   `json:"role"`
+	DBName     string   `json:"db_name"`
+	Username   string   `json:"username"`
+	Renewal    []string `json:"renew_statements,omitempty"`
+	Revocation []string `json:"revocation_statements"`
+}
+
+func (s *VaultServer) getDatabaseConfig(tx *bbolt.Tx, name string) (*databaseConfig, error) {
+	data := tx.Bucket([]byte(bucketDatabaseConfigs)).Get([]byte(name))
+	if data == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("database config not found: %s", name))
+	}
+	var c databaseConfig
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if c.SealedURL != nil {
+		url, err := s.barrier.open(bucketDatabaseConfigs+"/"+name, c.SealedURL)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		c.ConnectionURL, c.SealedURL = string(url), nil
+	}
+	return &c, nil
+}
+
+func (s *VaultServer) putDatabaseConfig(tx *bbolt.Tx, name string, c databaseConfig) error {
+	c.SealedURL, c.ConnectionURL = s.barrier.seal(bucketDatabaseConfigs+"/"+name, []byte(c.ConnectionURL)), ""
+	data, _ := json.Marshal(c)
+	return tx.Bucket([]byte(bucketDatabaseConfigs)).Put([]byte(name), data)
+}
+
+// sealDatabaseConfigs seals the connection URLs of configs stored in the
+// clear by earlier versions.
+func (s *VaultServer) sealDatabaseConfigs() error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		var names []string
+		err := tx.Bucket([]byte(bucketDatabaseConfigs)).ForEach(func(k, v []byte) error {
+			var c databaseConfig
+			if err := json.Unmarshal(v, &c); err != nil || c.SealedURL != nil {
+				return err
+			}
+			names = append(names, string(k))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			c, err := s.getDatabaseConfig(tx, name)
+			if err != nil {
+				return err
+			}
+			if err := s.putDatabaseConfig(tx, name, *c); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func getDatabaseRole(tx *bbolt.Tx, name string) (*databaseRole, error) {
+	data := tx.Bucket([]byte(bucketDatabaseRoles)).Get([]byte(name))
+	if data == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("database role not found: %s", name))
+	}
+	var r databaseRole
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &r, nil
+}
+
+// databaseUsername builds a unique, identifier-safe user name for role.
+func databaseUsername(role string) string {
+	clean := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			return r
+		}
+		if r >= 'A' && r <= 'Z' {
+			return r + 'a' - 'A'
+		}
+		return '_'
+	}, role)
+	if len(clean) > 32 {
+		clean = clean[:32]
+	}
+	b := make([]byte, 5)
+	rand.Read(b)
+	return "v_" + clean + "_" + hex.EncodeToString(b)
+}
+
+func (s *VaultServer) PutDatabaseConfig(ctx context.Context, req *connect.Request[vaultv1.PutDatabaseConfigRequest]) (*connect.Response[vaultv1.PutDatabaseConfigResponse], error) {
+	c := req.Msg.Config
+	if c == nil || c.Name == "" || c.Plugin == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name and plugin are required"))
+	}
+	slog.Info("PutDatabaseConfig", "name", c.Name, "plugin", c.Plugin)
+	if err := s.authorizeSudo(ctx, "database/config/"+c.Name); err != nil {
+		return nil, err
+	}
+	d, err := openDatabase(c.Plugin, c.ConnectionUrl)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if sd, ok := d.(*sqlDatabase); ok {
+		err = sd.db.PingContext(ctx)
+	}
+	d.Close()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("cannot connect to %s: %w", c.Name, err))
+	}
+
+	cfg := databaseConfig{Plugin: c.Plugin, ConnectionURL: c.ConnectionUrl, AllowedRoles: c.AllowedRoles}
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		return s.putDatabaseConfig(tx, c.Name, cfg)
+	})
+	s.audit(ctx, "PutDatabaseConfig", "database/config/"+c.Name, 0, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.databases.reset(c.Name)
+	return connect.NewResponse(&vaultv1.PutDatabaseConfigResponse{}), nil
+}
+
+func (s *VaultServer) PutDatabaseRole(ctx context.Context, req *connect.Request[vaultv1.PutDatabaseRoleRequest]) (*connect.Response[vaultv1.PutDatabaseRoleResponse], error) {
+	r := req.Msg.Role
+	if r == nil || r.Name == "" || r.DbName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name and db_name are required"))
+	}
+	slog.Info("PutDatabaseRole", "role", r.Name, "db", r.DbName)
+	if len(r.CreationStatements) == 0 || len(r.RevocationStatements) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("creation and revocation statements are required"))
+	}
+	if r.DefaultTtlSeconds < 0 || r.MaxTtlSeconds < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("TTLs cannot be negative"))
+	}
+	if err := s.authorizeSudo(ctx, "database/roles/"+r.Name); err != nil {
+		return nil, err
+	}
+
+	role := databaseRole{
+		DBName:     r.DbName,
+		Creation:   r.CreationStatements,
+		Revocation: r.RevocationStatements,
+		Renewal:    r.RenewStatements,
+		DefaultTTL: r.DefaultTtlSeconds,
+		MaxTTL:     r.MaxTtlSeconds,
+	}
+	data, _ := json.Marshal(role)
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketDatabaseRoles)).Put([]byte(r.Name), data)
+	})
+	s.audit(ctx, "PutDatabaseRole", "database/roles/"+r.Name, 0, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutDatabaseRoleResponse{}), nil
+}
+
+// GetDatabaseCredentials creates a database user for the role and returns
+// it under a renewable lease; the user is dropped when the lease expires
+// or is revoked.
+func (s *VaultServer) GetDatabaseCredentials(ctx context.Context, req *connect.Request[vaultv1.GetDatabaseCredentialsRequest]) (*connect.Response[vaultv1.DatabaseCredentials], error) {
+	name := req.Msg.Role
+	path := "database/creds/" + name
+	slog.Info("GetDatabaseCredentials", "role", name)
+	if err := s.authorize(ctx, "read", path); err != nil {
+		s.audit(ctx, "GetDatabaseCredentials", path, 0, err)
+		return nil, err
+	}
+
+	var role *databaseRole
+	var cfg *databaseConfig
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		if role, err = getDatabaseRole(tx, name); err != nil {
+			return err
+		}
+		cfg, err = s.getDatabaseConfig(tx, role.DBName)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(cfg.AllowedRoles, "*") && !slices.Contains(cfg.AllowedRoles, name) {
+		err := connect.NewError(connect.CodePermissionDenied, fmt.Errorf("role %s is not allowed on database %s", name, role.DBName))
+		s.audit(ctx, "GetDatabaseCredentials", path, 0, err)
+		return nil, err
+	}
+	conn, err := s.databases.get(role.DBName, cfg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+
+	ttl := time.Duration(role.DefaultTTL) * time.Second
+	if ttl <= 0 {
+		ttl = defaultDatabaseTTL
+	}
+	if role.MaxTTL > 0 {
+		ttl = min(ttl, time.Duration(role.MaxTTL)*time.Second)
+	}
+	user := databaseUser{
+		Role:     name,
+		DBName:   role.DBName,
+		Username: databaseUsername(name),
+		Renewal:  role.Renewal,
+	}
+	user.Revocation = renderStatements(role.Revocation, user.Username, "", time.Time{})
+	password := randomString(24)
+	leaseID := path + "/" + randomString(18)
+
+	if err := conn.CreateUser(ctx, renderStatements(role.Creation, user.Username, password, s.now().Add(ttl)), user.Username, password); err != nil {
+		err = connect.NewError(connect.CodeUnavailable, fmt.Errorf("creating user on %s: %w", role.DBName, err))
+		s.audit(ctx, "GetDatabaseCredentials", path, 0, err)
+		return nil, err
+	}
+	var lease *leaseEntry
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		var err error
+		lease, err = s.createLease(tx, leaseID, "database", user, ttl, time.Duration(role.MaxTTL)*time.Second, true)
+		return err
+	})
+	if err != nil {
+		conn.RevokeUser(ctx, user.Revocation, user.Username)
+		s.audit(ctx, "GetDatabaseCredentials", path, 0, err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.wakeLeases()
+	s.audit(ctx, "GetDatabaseCredentials", path, 0, nil)
+
+	return connect.NewResponse(&vaultv1.DatabaseCredentials{
+		Username:             user.Username,
+		Password:             password,
+		LeaseId:              leaseID,
+		LeaseDurationSeconds: lease.TTL,
+		ExpireTime:           lease.ExpireTime.Unix(),
+	}), nil
+}
+
+func (s *VaultServer) databaseUserConn(data json.RawMessage) (*databaseUser, DatabaseDriver, error) {
+	var u databaseUser
+	if err := json.Unmarshal(data, &u); err != nil {
+		return nil, nil, err
+	}
+	var cfg *databaseConfig
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		cfg, err = s.getDatabaseConfig(tx, u.DBName)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := s.databases.get(u.DBName, cfg)
+	return &u, conn, err
+}
+
+func (s *VaultServer) renewDatabaseUser(ctx context.Context, data json.RawMessage, expire time.Time) error {
+	u, conn, err := s.databaseUserConn(data)
+	if err != nil || len(u.Renewal) == 0 {
+		return err
+	}
+	return conn.RenewUser(ctx, renderStatements(u.Renewal, u.Username, "", expire), u.Username)
+}
+
+func (s *VaultServer) revokeDatabaseUser(ctx context.Context, data json.RawMessage) error {
+	u, conn, err := s.databaseUserConn(data)
+	if err != nil {
+		return err
+	}
+	return conn.RevokeUser(ctx, u.Revocation, u.Username)
+}
@@ -0,0 +1,192 @@
+package inference
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+// Statements that keep credentials in a table, as SQLite has no users.
+const (
+	sqliteCreateUser = `INSERT INTO users (name, password, valid_until) VALUES ('{{name}}', '{{password}}', '{{expiration}}')`
+	sqliteDropUser   = `DELETE FROM users WHERE name = '{{name}}'`
+)
+
+// newSQLiteDatabase creates a SQLite file with a users table for the
+// embedded plugin and returns its path and a handle to inspect it.
+func newSQLiteDatabase(t *testing.T) (string, *sql.DB) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "app.db")
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec(`CREATE TABLE users (name TEXT PRIMARY KEY, password TEXT NOT NULL, valid_until TEXT)`); err != nil {
+		t.Fatalf("create users table: %v", err)
+	}
+	return path, db
+}
+
+// sqliteUser returns the password and expiry stored for name.
+func sqliteUser(t *testing.T, db *sql.DB, name string) ([2]string, bool) {
+	t.Helper()
+	var row [2]string
+	err := db.QueryRow(`SELECT password, valid_until FROM users WHERE name = ?`, name).Scan(&row[0], &row[1])
+	if errors.Is(err, sql.ErrNoRows) {
+		return row, false
+	}
+	if err != nil {
+		t.Fatalf("query users: %v", err)
+	}
+	return row, true
+}
+
+func TestDatabaseCredentials(t *testing.T) {
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	server, client, root := newTestClient(t, WithClock(func() time.Time { return clock }))
+	ctx := context.Background()
+	url, db := newSQLiteDatabase(t)
+
+	_, err := client.PutDatabaseConfig(ctx, withToken(&vaultv1.PutDatabaseConfigRequest{Config: &vaultv1.DatabaseConfig{Name: "app", Plugin: "embedded", ConnectionUrl: url, AllowedRoles: []string{"readonly"}}}, root))
+	if err != nil {
+		t.Fatalf("PutDatabaseConfig: %v", err)
+	}
+
+	// Connection URLs are sealed at rest, including those stored in the
+	// clear by earlier versions.
+	legacy, _ := json.Marshal(databaseConfig{Plugin: "embedded", ConnectionURL: url})
+	server.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketDatabaseConfigs)).Put([]byte("legacy"), legacy)
+	})
+	if err := server.sealDatabaseConfigs(); err != nil {
+		t.Fatalf("sealDatabaseConfigs: %v", err)
+	}
+	server.db.View(func(tx *bbolt.Tx) error {
+		for _, name := range []string{"app", "legacy"} {
+			if bytes.Contains(tx.Bucket([]byte(bucketDatabaseConfigs)).Get([]byte(name)), []byte(url)) {
+				t.Errorf("database config %s stores its connection URL in the clear", name)
+			}
+			if c, err := server.getDatabaseConfig(tx, name); err != nil || c.ConnectionURL != url {
+				t.Errorf("getDatabaseConfig(%s) = %+v, %v", name, c, err)
+			}
+		}
+		return nil
+	})
+	for _, name := range []string{"readonly", "other"} {
+		_, err = client.PutDatabaseRole(ctx, withToken(&vaultv1.PutDatabaseRoleRequest{Role: &vaultv1.DatabaseRole{
+			Name:                 name,
+			DbName:               "app",
+			CreationStatements:   []string{sqliteCreateUser},
+			RevocationStatements: []string{sqliteDropUser},
+			DefaultTtlSeconds:    600,
+			MaxTtlSeconds:        300,
+		}}, root))
+		if err != nil {
+			t.Fatalf("PutDatabaseRole: %v", err)
+		}
+	}
+
+	res, err := client.GetDatabaseCredentials(ctx, withToken(&vaultv1.GetDatabaseCredentialsRequest{Role: "readonly"}, root))
+	if err != nil {
+		t.Fatalf("GetDatabaseCredentials: %v", err)
+	}
+	creds := res.Msg
+	if !strings.HasPrefix(creds.Username, "v_readonly_") || creds.Password == "" || creds.LeaseDurationSeconds != 300 {
+		t.Fatalf("unexpected credentials %+v", creds)
+	}
+	if !strings.HasPrefix(creds.LeaseId, "database/creds/readonly/") {
+		t.Errorf("lease id = %s", creds.LeaseId)
+	}
+
+	if row, ok := sqliteUser(t, db, creds.Username); !ok || row != [2]string{creds.Password, "2026-10-19 12:05:00Z"} {
+		t.Fatalf("embedded database row = %q, %v; want the issued password valid until expiry", row, ok)
+	}
+
+	_, err = client.GetDatabaseCredentials(ctx, withToken(&vaultv1.GetDatabaseCredentialsRequest{Role: "other"}, root))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("role outside allowed_roles: expected PermissionDenied, got %v", err)
+	}
+
+	if n, err := server.RevokeExpiredLeases(ctx); err != nil || n != 0 {
+		t.Fatalf("revoked %d before expiry (err %v)", n, err)
+	}
+	clock = clock.Add(5 * time.Minute)
+	if n, err := server.RevokeExpiredLeases(ctx); err != nil || n != 1 {
+		t.Fatalf("RevokeExpiredLeases = %d, %v; want 1", n, err)
+	}
+	if _, ok := sqliteUser(t, db, creds.Username); ok {
+		t.Error("user still present after lease expiry")
+	}
+	if n, _ := server.RevokeExpiredLeases(ctx); n != 0 {
+		t.Error("lease revoked twice")
+	}
+}
+
+// recordingDriver is a database/sql driver that records executed statements.
+type recordingDriver struct {
+	mu    sync.Mutex
+	execs []string
+}
+
+func (d *recordingDriver) Open(string) (driver.Conn, error) { return recordingConn{d}, nil }
+
+type recordingConn struct{ d *recordingDriver }
+
+func (c recordingConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c recordingConn) Close() error                        { return nil }
+func (c recordingConn) Begin() (driver.Tx, error)           { return c, nil }
+func (c recordingConn) Commit() error                       { return nil }
+func (c recordingConn) Rollback() error                     { return nil }
+
+func (c recordingConn) Exec(query string, _ []driver.Value) (driver.Result, error) {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	c.d.execs = append(c.d.execs, query)
+	return driver.RowsAffected(1), nil
+}
+
+func TestDatabaseSQLPlugin(t *testing.T) {
+	rec := &recordingDriver{}
+	sql.Register("vault-test-recorder", rec)
+	_, client, root := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.PutDatabaseConfig(ctx, withToken(&vaultv1.PutDatabaseConfigRequest{Config: &vaultv1.DatabaseConfig{Name: "pg", Plugin: "no-such-driver"}}, root))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("unknown plugin: expected InvalidArgument, got %v", err)
+	}
+	if _, err := client.PutDatabaseConfig(ctx, withToken(&vaultv1.PutDatabaseConfigRequest{Config: &vaultv1.DatabaseConfig{Name: "pg", Plugin: "vault-test-recorder", AllowedRoles: []string{"*"}}}, root)); err != nil {
+		t.Fatalf("PutDatabaseConfig: %v", err)
+	}
+	client.PutDatabaseRole(ctx, withToken(&vaultv1.PutDatabaseRoleRequest{Role: &vaultv1.DatabaseRole{
+		Name:                 "rw",
+		DbName:               "pg",
+		CreationStatements:   []string{"CREATE ROLE {{name}}", "GRANT ALL TO {{name}}", "DO $$ BEGIN RAISE NOTICE 'created; {{name}}'; END $$"},
+		RevocationStatements: []string{"DROP ROLE {{name}}"},
+	}}, root))
+	res, err := client.GetDatabaseCredentials(ctx, withToken(&vaultv1.GetDatabaseCredentialsRequest{Role: "rw"}, root))
+	if err != nil {
+		t.Fatalf("GetDatabaseCredentials: %v", err)
+	}
+	if res.Msg.LeaseDurationSeconds != int64(defaultDatabaseTTL/time.Second) {
+		t.Errorf("lease duration = %d", res.Msg.LeaseDurationSeconds)
+	}
+	u := res.Msg.Username
+	// Statements run as written, semicolons inside them included.
+	if len(rec.execs) != 3 || rec.execs[0] != "CREATE ROLE "+u || rec.execs[1] != "GRANT ALL TO "+u || rec.execs[2] != "DO $$ BEGIN RAISE NOTICE 'created; "+u+"'; END $$" {
+		t.Errorf("executed %q", rec.execs)
+	}
+}
@@ -0,0 +1,456 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+// Event types follow GCP Secret Manager's Pub/Sub notifications.
+const (
+	eventCreate         = "SECRET_CREATE"
+	eventUpdate         = "SECRET_UPDATE"
+	eventDelete         = "SECRET_DELETE"
+	eventVersionAdd     = "SECRET_VERSION_ADD"
+	eventVersionDestroy = "SECRET_VERSION_DESTROY"
+	eventRotate         = "SECRET_ROTATE"
+)
+
+const (
+	publishTimeout    = 10 * time.Second
+	publishBackoff    = 5 * time.Second
+	maxPublishBackoff = 10 * time.Minute
+	// maxDeliveryAttempts moves a delivery to the dead-letter bucket.
+	maxDeliveryAttempts = 8
+)
+
+// Webhook request headers.
+const (
+	WebhookEventHeader     = "X-Vault-Event"
+	WebhookDeliveryHeader  = "X-Vault-Delivery"
+	WebhookTimestampHeader = "X-Vault-Timestamp"
+	WebhookSignatureHeader = "X-Vault-Signature"
+)
+
+var errWebhookRemoved = errors.New("webhook no longer configured")
+
+type topicBinding struct {
+	Name           string `json:"name,omitempty"`
+	PubSubEndpoint string `json:"pubsub_endpoint,omitempty"`
+	WebhookURL     string `json:"webhook_url,omitempty"`
+}
+
+func (t topicBinding) proto() *vaultv1.Topic {
+	return &vaultv1.Topic{Name: t.Name, PubsubEndpoint: t.PubSubEndpoint, WebhookUrl: t.WebhookURL}
+}
+
+// secretEvent is the JSON payload of a notification. It never carries
+// secret material.
+type secretEvent struct {
+	EventType   string    `json:"eventType"`
+	SecretID    string    `json:"secretId"`
+	VersionID   int32     `json:"versionId,omitempty"`
+	PublishTime time.Time `json:"publishTime"`
+}
+
+type webhook struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// SealedSecret is the signing key sealed by the barrier. No RPC
+	// returns it.
+	SealedSecret []byte `json:"sealed_secret,omitempty"`
+	// Secret is the plaintext signing key of webhooks stored before keys
+	// were sealed; sealWebhookSecrets moves it into SealedSecret.
+	Secret string   `json:"secret,omitempty"`
+	Prefix string   `json:"prefix,omitempty"`
+	Events []string `json:"events,omitempty"`
+}
+
+func (w *webhook) wants(key, eventType string) bool {
+	return strings.HasPrefix(key, w.Prefix) && (len(w.Events) == 0 || slices.Contains(w.Events, eventType))
+}
+
+func (s *VaultServer) sealWebhookSecret(w *webhook, secret string) {
+	w.SealedSecret, w.Secret = s.barrier.seal(bucketWebhooks+"/"+w.Name, []byte(secret)), ""
+}
+
+func (s *VaultServer) webhookSecret(w *webhook) (string, error) {
+	secret, err := s.barrier.open(bucketWebhooks+"/"+w.Name, w.SealedSecret)
+	return string(secret), err
+}
+
+// sealWebhookSecrets seals the signing keys of webhooks stored in the
+// clear by earlier versions.
+func (s *VaultServer) sealWebhookSecrets() error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucketWebhooks))
+		sealed := map[string][]byte{}
+		err := b.ForEach(func(k, v []byte) error {
+			var w webhook
+			if err := json.Unmarshal(v, &w); err != nil || w.Secret == "" {
+				return err
+			}
+			s.sealWebhookSecret(&w, w.Secret)
+			sealed[string(k)], _ = json.Marshal(w)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for k, data := range sealed {
+			if err := b.Put([]byte(k), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// outboxEntry is a pending delivery of one event to one topic or webhook.
+// Entries are written in the same transaction as the change they describe
+// and removed only after the receiver acknowledges, giving at-least-once
+// delivery.
+type outboxEntry struct {
+	Topic       *topicBinding `json:"topic,omitempty"`
+	Webhook     string        `json:"webhook,omitempty"`
+	Event       secretEvent   `json:"event"`
+	Attempts    int32         `json:"attempts,omitempty"`
+	NextAttempt time.Time     `json:"next_attempt"`
+	LastError   string        `json:"last_error,omitempty"`
+}
+
+func (e *outboxEntry) target() string {
+	if e.Topic != nil {
+		return e.Topic.Name
+	}
+	return e.Webhook
+}
+
+func (e *outboxEntry) proto(k []byte, state string) *vaultv1.Delivery {
+	return &vaultv1.Delivery{
+		Id:              deliveryID(k),
+		Target:          e.target(),
+		EventType:       e.Event.EventType,
+		Key:             e.Event.SecretID,
+		Version:         e.Event.VersionID,
+		Attempts:        e.Attempts,
+		NextAttemptTime: e.NextAttempt.Unix(),
+		LastError:       e.LastError,
+		State:           state,
+	}
+}
+
+func deliveryID(k []byte) string {
+	return strconv.FormatUint(binary.BigEndian.Uint64(k), 10)
+}
+
+// enqueueEvent queues eventType for every topic bound to key and every
+// webhook whose prefix and event filter match.
+func (s *VaultServer) enqueueEvent(tx *bbolt.Tx, m *secretMeta, key, eventType string, version int32) error {
+	b := tx.Bucket([]byte(bucketOutbox))
+	now := s.now()
+	event := secretEvent{EventType: eventType, SecretID: key, VersionID: version, PublishTime: now.UTC()}
+	queue := func(e outboxEntry) error {
+		n, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		data, _ := json.Marshal(e)
+		return b.Put(seqKey(n), data)
+	}
+	for _, t := range m.Topics {
+		if err := queue(outboxEntry{Topic: &t, Event: event, NextAttempt: now}); err != nil {
+			return err
+		}
+	}
+	return tx.Bucket([]byte(bucketWebhooks)).ForEach(func(_, v []byte) error {
+		var w webhook
+		if json.Unmarshal(v, &w) != nil || !w.wants(key, eventType) {
+			return nil
+		}
+		return queue(outboxEntry{Webhook: w.Name, Event: event, NextAttempt: now})
+	})
+}
+
+func (s *VaultServer) PutWebhook(ctx context.Context, req *connect.Request[vaultv1.PutWebhookRequest]) (*connect.Response[vaultv1.PutWebhookResponse], error) {
+	m := req.Msg.Webhook
+	if m == nil || m.Name == "" || m.Url == "" || m.Secret == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name, url and secret are required"))
+	}
+	slog.Info("PutWebhook", "name", m.Name, "prefix", m.Prefix)
+	if err := s.authorizeSudo(ctx, "sys/webhooks/"+m.Name); err != nil {
+		return nil, err
+	}
+	w := webhook{Name: m.Name, URL: m.Url, Prefix: m.Prefix, Events: m.Events}
+	s.sealWebhookSecret(&w, m.Secret)
+	data, _ := json.Marshal(w)
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketWebhooks)).Put([]byte(m.Name), data)
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutWebhookResponse{}), nil
+}
+
+func (s *VaultServer) DeleteWebhook(ctx context.Context, req *connect.Request[vaultv1.DeleteWebhookRequest]) (*connect.Response[vaultv1.DeleteWebhookResponse], error) {
+	slog.Info("DeleteWebhook", "name", req.Msg.Name)
+	if err := s.authorizeSudo(ctx, "sys/webhooks/"+req.Msg.Name); err != nil {
+		return nil, err
+	}
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketWebhooks)).Delete([]byte(req.Msg.Name))
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.DeleteWebhookResponse{}), nil
+}
+
+func (s *VaultServer) ListDeliveries(ctx context.Context, req *connect.Request[vaultv1.ListDeliveriesRequest]) (*connect.Response[vaultv1.ListDeliveriesResponse], error) {
+	slog.Info("ListDeliveries", "state", req.Msg.State, "target", req.Msg.Target)
+	if err := s.authorize(ctx, "list", "sys/webhooks/deliveries"); err != nil {
+		return nil, err
+	}
+	bucket, state := bucketOutbox, "pending"
+	switch req.Msg.State {
+	case "", "pending":
+	case "dead":
+		bucket, state = bucketDeadLetters, "dead"
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown delivery state %q", req.Msg.State))
+	}
+	res := &vaultv1.ListDeliveriesResponse{}
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucket)).ForEach(func(k, v []byte) error {
+			var e outboxEntry
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			if req.Msg.Target == "" || e.target() == req.Msg.Target {
+				res.Deliveries = append(res.Deliveries, e.proto(k, state))
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// sign computes the webhook signature over the timestamp and body.
+func sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *VaultServer) PutSecretTopics(ctx context.Context, req *connect.Request[vaultv1.PutSecretTopicsRequest]) (*connect.Response[vaultv1.PutSecretTopicsResponse], error) {
+	key := req.Msg.Key
+	slog.Info("PutSecretTopics", "key", key, "topics", len(req.Msg.Topics))
+	if err := s.authorizeSudo(ctx, "sys/topics/"+key); err != nil {
+		return nil, err
+	}
+	var topics []topicBinding
+	for _, t := range req.Msg.Topics {
+		if (t.PubsubEndpoint == "") == (t.WebhookUrl == "") {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("topic %q needs exactly one of pubsub_endpoint or webhook_url", t.Name))
+		}
+		if t.PubsubEndpoint != "" && !strings.Contains(t.Name, "/topics/") {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("topic name %q must be projects/<project>/topics/<topic>", t.Name))
+		}
+		topics = append(topics, topicBinding{Name: t.Name, PubSubEndpoint: t.PubsubEndpoint, WebhookURL: t.WebhookUrl})
+	}
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		if _, ok := historyLen(tx, key); !ok {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("secret not found: %s", key))
+		}
+		m := getSecretMeta(tx, key)
+		m.Topics = topics
+		return putSecretMeta(tx, key, m)
+	})
+	s.audit(ctx, "PutSecretTopics", key, 0, err)
+	if err != nil {
+		return nil, readError(err)
+	}
+	return connect.NewResponse(&vaultv1.PutSecretTopicsResponse{}), nil
+}
+
+// publish delivers one event. Pub/Sub emulators receive the REST publish
+// call with the event as base64 data and GCP-style attributes; topic and
+// configured webhooks receive the event as a JSON body, the latter signed.
+func (s *VaultServer) publish(ctx context.Context, id string, o *outboxEntry) error {
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+
+	e := o.Event
+	payload, _ := json.Marshal(e)
+	var hook *webhook
+	if o.Topic == nil {
+		s.db.View(func(tx *bbolt.Tx) error {
+			if data := tx.Bucket([]byte(bucketWebhooks)).Get([]byte(o.Webhook)); data != nil {
+				hook = &webhook{}
+				return json.Unmarshal(data, hook)
+			}
+			return nil
+		})
+		if hook == nil {
+			return errWebhookRemoved
+		}
+	}
+
+	var url string
+	body := payload
+	switch {
+	case hook != nil:
+		url = hook.URL
+	case o.Topic.PubSubEndpoint == "":
+		url = o.Topic.WebhookURL
+	default:
+		t := o.Topic
+		attrs := map[string]string{
+			"eventType":  e.EventType,
+			"secretId":   e.SecretID,
+			"dataFormat": "JSON_API_V1",
+		}
+		if e.VersionID != 0 {
+			attrs["versionId"] = strconv.Itoa(int(e.VersionID))
+		}
+		url = strings.TrimRight(t.PubSubEndpoint, "/") + "/v1/" + t.Name + ":publish"
+		body, _ = json.Marshal(map[string]any{
+			"messages": []map[string]any{{"data": base64.StdEncoding.EncodeToString(payload), "attributes": attrs}},
+		})
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if hook != nil {
+		secret, err := s.webhookSecret(hook)
+		if err != nil {
+			return err
+		}
+		ts := strconv.FormatInt(s.now().Unix(), 10)
+		req.Header.Set(WebhookEventHeader, e.EventType)
+		req.Header.Set(WebhookDeliveryHeader, id)
+		req.Header.Set(WebhookTimestampHeader, ts)
+		req.Header.Set(WebhookSignatureHeader, sign(secret, ts, body))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	return nil
+}
+
+// PublishEvents attempts every due outbox entry once, removing those that
+// were delivered and backing off the rest. Entries that exhaust their
+// attempts, or whose webhook no longer exists, move to the dead-letter
+// bucket. It returns how many were delivered.
+func (s *VaultServer) PublishEvents(ctx context.Context) (int, error) {
+	now := s.now()
+	// Keys are sequence numbers, so the cursor yields entries in the order
+	// they were queued and deliveries go out in that order.
+	type dueEntry struct {
+		key   string
+		entry *outboxEntry
+	}
+	var due []dueEntry
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketOutbox)).ForEach(func(k, v []byte) error {
+			var e outboxEntry
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			if !now.Before(e.NextAttempt) {
+				due = append(due, dueEntry{string(k), &e})
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, d := range due {
+		k, e := d.key, d.entry
+		perr := s.publish(ctx, deliveryID([]byte(k)), e)
+		dead := false
+		err := s.db.Update(func(tx *bbolt.Tx) error {
+			b := tx.Bucket([]byte(bucketOutbox))
+			if perr == nil {
+				return b.Delete([]byte(k))
+			}
+			e.Attempts++
+			e.LastError = perr.Error()
+			e.NextAttempt = s.now().Add(min(publishBackoff<<min(e.Attempts-1, 16), maxPublishBackoff))
+			data, _ := json.Marshal(e)
+			if e.Attempts < maxDeliveryAttempts && !errors.Is(perr, errWebhookRemoved) {
+				return b.Put([]byte(k), data)
+			}
+			dead = true
+			if err := tx.Bucket([]byte(bucketDeadLetters)).Put([]byte(k), data); err != nil {
+				return err
+			}
+			return b.Delete([]byte(k))
+		})
+		if err != nil {
+			return delivered, err
+		}
+		if perr != nil {
+			slog.Warn("Event delivery failed", "event", e.Event.EventType, "key", e.Event.SecretID, "target", e.target(), "attempts", e.Attempts, "dead_lettered", dead, "error", perr)
+			continue
+		}
+		delivered++
+	}
+	return delivered, nil
+}
+
+// RunPublisher calls PublishEvents every interval until ctx is done,
+// pulsing replication lag on the Resonance Bus after each pass.
+func (s *VaultServer) RunPublisher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	pending := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.PublishEvents(ctx); err != nil {
+				slog.Error("Event publisher failed", "error", err)
+			}
+			pending = s.pulseReplicationLag(pending)
+		}
+	}
+}
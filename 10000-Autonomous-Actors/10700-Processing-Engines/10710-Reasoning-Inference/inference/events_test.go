@@ -0,0 +1,88 @@
+package inference
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+)
+
+func TestSecretEventsOutbox(t *testing.T) {
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	server, client, root := newTestClient(t, WithClock(func() time.Time { return clock }))
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var published []map[string]string
+	var webhook []secretEvent
+	failWebhook := true
+	emulator := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/projects/p1/topics/secrets:publish" {
+			http.NotFound(w, r)
+			return
+		}
+		var body struct {
+			Messages []struct {
+				Data       string            `json:"data"`
+				Attributes map[string]string `json:"attributes"`
+			} `json:"messages"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		defer mu.Unlock()
+		for _, m := range body.Messages {
+			data, _ := base64.StdEncoding.DecodeString(m.Data)
+			m.Attributes["data"] = string(data)
+			published = append(published, m.Attributes)
+		}
+		w.Write([]byte(`{"messageIds":["1"]}`))
+	}))
+	defer emulator.Close()
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failWebhook {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		var e secretEvent
+		json.NewDecoder(r.Body).Decode(&e)
+		webhook = append(webhook, e)
+	}))
+	defer hook.Close()
+
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "svc/key", Value: "v1"}, root))
+	if _, err := client.PutSecretTopics(ctx, withToken(&vaultv1.PutSecretTopicsRequest{Key: "svc/key", Topics: []*vaultv1.Topic{
+		{Name: "projects/p1/topics/secrets", PubsubEndpoint: emulator.URL},
+		{Name: "ops-hook", WebhookUrl: hook.URL},
+	}}, root)); err != nil {
+		t.Fatalf("PutSecretTopics failed: %v", err)
+	}
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "svc/key", Value: "v2"}, root))
+
+	if n, err := server.PublishEvents(ctx); err != nil || n != 1 {
+		t.Fatalf("Expected one delivery with the webhook down, got %d %v", n, err)
+	}
+	if len(published) != 1 || published[0]["eventType"] != eventVersionAdd || published[0]["versionId"] != "2" || published[0]["secretId"] != "svc/key" {
+		t.Errorf("Unexpected Pub/Sub message: %v", published)
+	}
+
+	// The failed delivery stays in the outbox until its backoff elapses.
+	failWebhook = false
+	if n, _ := server.PublishEvents(ctx); n != 0 {
+		t.Errorf("Expected retry to wait for backoff, delivered %d", n)
+	}
+	clock = clock.Add(publishBackoff)
+	if n, _ := server.PublishEvents(ctx); n != 1 || len(webhook) != 1 || webhook[0].VersionID != 2 {
+		t.Errorf("Expected webhook redelivery, got %d %v", n, webhook)
+	}
+	if n, _ := server.PublishEvents(ctx); n != 0 {
+		t.Errorf("Expected outbox to be drained, delivered %d", n)
+	}
+}
@@ -0,0 +1,319 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/410-Interaction-Models/410-100-Resonance-Bus"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+type versionMeta struct {
+	CreateTime time.Time `json:"create_time,omitzero"`
+	ExpireTime time.Time `json:"expire_time,omitzero"`
+	Destroyed  bool      `json:"destroyed,omitempty"`
+	// KMSKeyVersion is set when the stored payload is KMS ciphertext.
+	KMSKeyVersion string `json:"kms_key_version,omitempty"`
+}
+
+// secretMeta sits beside the history bucket, one entry per version, so
+// history keeps its original shape. Secrets written before metadata
+// existed simply have fewer entries than versions.
+type secretMeta struct {
+	ExpireTime time.Time        `json:"expire_time,omitzero"`
+	Versions   []versionMeta    `json:"versions"`
+	Retention  *retentionPolicy `json:"retention,omitempty"`
+	Topics     []topicBinding   `json:"topics,omitempty"`
+	KMSKey     string           `json:"kms_key,omitempty"`
+}
+
+func getSecretMeta(tx *bbolt.Tx, key string) *secretMeta {
+	m := &secretMeta{}
+	if data := tx.Bucket([]byte(bucketSecretMeta)).Get([]byte(key)); data != nil {
+		json.Unmarshal(data, m)
+	}
+	return m
+}
+
+func putSecretMeta(tx *bbolt.Tx, key string, m *secretMeta) error {
+	data, _ := json.Marshal(m)
+	return tx.Bucket([]byte(bucketSecretMeta)).Put([]byte(key), data)
+}
+
+// version returns the metadata of a 1-based version.
+func (m *secretMeta) version(n int32) versionMeta {
+	if n < 1 || int(n) > len(m.Versions) {
+		return versionMeta{}
+	}
+	return m.Versions[n-1]
+}
+
+// current is the version of the first n that the secrets bucket holds:
+// the newest one not destroyed, or n when all of them are.
+func (m *secretMeta) current(n int) int32 {
+	for v := int32(n); v > 0; v-- {
+		if !m.version(v).Destroyed {
+			return v
+		}
+	}
+	return int32(n)
+}
+
+func expired(t, now time.Time) bool {
+	return !t.IsZero() && !now.Before(t)
+}
+
+// expiryFrom resolves a relative or absolute expiry; zero means none.
+func expiryFrom(ttlSeconds, expireTime int64, now time.Time) (time.Time, error) {
+	switch {
+	case ttlSeconds < 0 || expireTime < 0:
+		return time.Time{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ttl and expire_time must not be negative"))
+	case expireTime != 0:
+		return time.Unix(expireTime, 0), nil
+	case ttlSeconds != 0:
+		return now.Add(time.Duration(ttlSeconds) * time.Second), nil
+	}
+	return time.Time{}, nil
+}
+
+// checkReadable refuses expired secrets with NotFound and expired or
+// destroyed versions with FailedPrecondition.
+func (m *secretMeta) checkReadable(key string, version int32, now time.Time) error {
+	if expired(m.ExpireTime, now) {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("secret not found: %s", key))
+	}
+	v := m.version(version)
+	if v.Destroyed {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("version %d of %s has been destroyed", version, key))
+	}
+	if expired(v.ExpireTime, now) {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("version %d of %s expired at %s", version, key, v.ExpireTime.UTC().Format(time.RFC3339)))
+	}
+	return nil
+}
+
+// readError maps a failed read to NotFound unless it already carries a code.
+func readError(err error) error {
+	var ce *connect.Error
+	if errors.As(err, &ce) {
+		return err
+	}
+	return connect.NewError(connect.CodeNotFound, err)
+}
+
+func (m *secretMeta) proto(key string, current int32) *vaultv1.SecretMetadata {
+	res := &vaultv1.SecretMetadata{Key: key, CurrentVersion: current, ExpireTime: unixOrZero(m.ExpireTime), KmsKeyName: m.KMSKey}
+	for i := int32(1); i <= current; i++ {
+		v := m.version(i)
+		res.Versions = append(res.Versions, &vaultv1.VersionMetadata{
+			Version:           i,
+			CreateTime:        unixOrZero(v.CreateTime),
+			ExpireTime:        unixOrZero(v.ExpireTime),
+			Destroyed:         v.Destroyed,
+			KmsKeyVersionName: v.KMSKeyVersion,
+		})
+	}
+	for _, t := range m.Topics {
+		res.Topics = append(res.Topics, t.proto())
+	}
+	return res
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func historyLen(tx *bbolt.Tx, key string) (int32, bool) {
+	data := tx.Bucket([]byte(bucketHistory)).Get([]byte(key))
+	if data == nil {
+		return 0, false
+	}
+	var versions []string
+	json.Unmarshal(data, &versions)
+	return int32(len(versions)), true
+}
+
+func (s *VaultServer) GetSecretMetadata(ctx context.Context, req *connect.Request[vaultv1.GetSecretMetadataRequest]) (*connect.Response[vaultv1.SecretMetadata], error) {
+	slog.Info("GetSecretMetadata", "key", req.Msg.Key)
+	if err := s.authorize(ctx, "list", req.Msg.Key); err != nil {
+		return nil, err
+	}
+	var res *vaultv1.SecretMetadata
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		n, ok := historyLen(tx, req.Msg.Key)
+		if !ok {
+			return fmt.Errorf("secret not found: %s", req.Msg.Key)
+		}
+		m := getSecretMeta(tx, req.Msg.Key)
+		res = m.proto(req.Msg.Key, n)
+		res.Retention = effectiveRetention(tx, m).proto()
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *VaultServer) UpdateSecretMetadata(ctx context.Context, req *connect.Request[vaultv1.UpdateSecretMetadataRequest]) (*connect.Response[vaultv1.SecretMetadata], error) {
+	key := req.Msg.Key
+	slog.Info("UpdateSecretMetadata", "key", key)
+	if err := s.authorize(ctx, "update", key); err != nil {
+		return nil, err
+	}
+	expire, err := expiryFrom(req.Msg.TtlSeconds, req.Msg.ExpireTime, s.now())
+	if err != nil {
+		return nil, err
+	}
+	var res *vaultv1.SecretMetadata
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		n, ok := historyLen(tx, key)
+		if !ok {
+			return fmt.Errorf("secret not found: %s", key)
+		}
+		m := getSecretMeta(tx, key)
+		m.ExpireTime = expire
+		res = m.proto(key, n)
+		res.Retention = effectiveRetention(tx, m).proto()
+		if err := s.enqueueEvent(tx, m, key, eventUpdate, 0); err != nil {
+			return err
+		}
+		return putSecretMeta(tx, key, m)
+	})
+	s.audit(ctx, "UpdateSecretMetadata", key, 0, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	s.bus.Emit(resonance.SecretChanged, key, "metadata")
+	return connect.NewResponse(res), nil
+}
+
+// ReapExpired deletes secrets past their expiry and destroys expired
+// versions, blanking their value in history. It returns how many secrets
+// and versions were removed.
+func (s *VaultServer) ReapExpired(ctx context.Context) (secrets, versions int, err error) {
+	now := s.now()
+	type reaped struct {
+		key      string
+		versions []int32
+		secret   bool
+	}
+	var done []reaped
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		var keys []string
+		tx.Bucket([]byte(bucketSecretMeta)).ForEach(func(k, _ []byte) error {
+			keys = append(keys, string(k))
+			return nil
+		})
+		for _, key := range keys {
+			m := getSecretMeta(tx, key)
+			if expired(m.ExpireTime, now) {
+				if err := s.enqueueEvent(tx, m, key, eventDelete, 0); err != nil {
+					return err
+				}
+				for _, b := range []string{bucketSecrets, bucketHistory, bucketSecretMeta} {
+					if err := tx.Bucket([]byte(b)).Delete([]byte(key)); err != nil {
+						return err
+					}
+				}
+				done = append(done, reaped{key: key, secret: true})
+				continue
+			}
+
+			h := tx.Bucket([]byte(bucketHistory))
+			var history []string
+			json.Unmarshal(h.Get([]byte(key)), &history)
+			r := reaped{key: key}
+			for i := range m.Versions {
+				if m.Versions[i].Destroyed || !expired(m.Versions[i].ExpireTime, now) || i >= len(history) {
+					continue
+				}
+				history[i] = ""
+				m.Versions[i].Destroyed = true
+				r.versions = append(r.versions, int32(i+1))
+			}
+			if len(r.versions) == 0 {
+				continue
+			}
+			// Fall back to the newest surviving version when the latest
+			// expired, and drop the current value only if none survive.
+			if r.versions[len(r.versions)-1] == int32(len(history)) {
+				b := tx.Bucket([]byte(bucketSecrets))
+				cur := m.current(len(history))
+				if m.version(cur).Destroyed {
+					if err := b.Delete([]byte(key)); err != nil {
+						return err
+					}
+				} else if err := b.Put([]byte(key), []byte(history[cur-1])); err != nil {
+					return err
+				}
+			}
+			data, _ := json.Marshal(history)
+			if err := h.Put([]byte(key), data); err != nil {
+				return err
+			}
+			for _, v := range r.versions {
+				if err := s.enqueueEvent(tx, m, key, eventVersionDestroy, v); err != nil {
+					return err
+				}
+			}
+			if err := putSecretMeta(tx, key, m); err != nil {
+				return err
+			}
+			done = append(done, r)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, r := range done {
+		if r.secret {
+			secrets++
+			s.audit(ctx, "ReapSecret", r.key, 0, nil)
+			s.bus.Emit(resonance.SecretChanged, r.key, "deleted")
+		}
+		for _, v := range r.versions {
+			versions++
+			s.audit(ctx, "ReapVersion", r.key, v, nil)
+			s.bus.Emit(resonance.SecretChanged, r.key, fmt.Sprintf("destroyed=%d", v))
+		}
+	}
+	return secrets, versions, nil
+}
+
+// RunReaper calls ReapExpired and Compact every interval until ctx is done.
+func (s *VaultServer) RunReaper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			secrets, versions, err := s.ReapExpired(ctx)
+			if err != nil {
+				slog.Error("Expiry reaper failed", "error", err)
+			} else if secrets+versions > 0 {
+				slog.Info("Reaped expired secrets", "secrets", secrets, "versions", versions)
+			}
+			if pruned, err := s.Compact(ctx); err != nil {
+				slog.Error("Retention compaction failed", "error", err)
+			} else if pruned > 0 {
+				slog.Info("Pruned versions past retention", "versions", pruned)
+			}
+		}
+	}
+}
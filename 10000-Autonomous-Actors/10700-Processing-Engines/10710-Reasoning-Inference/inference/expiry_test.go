@@ -0,0 +1,80 @@
+package inference
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+)
+
+func TestSecretExpiry(t *testing.T) {
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	server := NewVaultServer(t.TempDir(), WithClock(func() time.Time { return clock }))
+	defer server.Close()
+	ctx := context.Background()
+
+	server.VaultWrite(ctx, connect.NewRequest(&vaultv1.VaultWriteRequest{Key: "ci/token", Value: "long-lived"}))
+	server.VaultWrite(ctx, connect.NewRequest(&vaultv1.VaultWriteRequest{Key: "ci/once", Value: "temp", TtlSeconds: 60}))
+	w, err := server.VaultWrite(ctx, connect.NewRequest(&vaultv1.VaultWriteRequest{Key: "ci/token", Value: "temp", TtlSeconds: 60}))
+	if err != nil || w.Msg.ExpireTime != clock.Add(time.Minute).Unix() {
+		t.Fatalf("Expected version expiry to be reported, got %v %v", w, err)
+	}
+
+	clock = clock.Add(2 * time.Minute)
+	if _, err := server.VaultRead(ctx, connect.NewRequest(&vaultv1.VaultReadRequest{Key: "ci/token"})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Expected expired current version to fail, got %v", err)
+	}
+	if res, err := server.GetSecretVersion(ctx, connect.NewRequest(&vaultv1.GetSecretVersionRequest{Key: "ci/token", Version: 1})); err != nil || res.Msg.Value != "long-lived" {
+		t.Errorf("Expected unexpired version to stay readable, got %v %v", res, err)
+	}
+
+	secrets, versions, err := server.ReapExpired(ctx)
+	if err != nil || secrets != 0 || versions != 2 {
+		t.Fatalf("Expected two reaped versions, got %d %d %v", secrets, versions, err)
+	}
+	meta, _ := server.GetSecretMetadata(ctx, connect.NewRequest(&vaultv1.GetSecretMetadataRequest{Key: "ci/token"}))
+	if v := meta.Msg.Versions; len(v) != 2 || v[0].Destroyed || !v[1].Destroyed {
+		t.Errorf("Expected version 2 destroyed in metadata, got %v", v)
+	}
+	if _, err := server.GetSecretVersion(ctx, connect.NewRequest(&vaultv1.GetSecretVersionRequest{Key: "ci/token", Version: 2})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Expected destroyed version to fail, got %v", err)
+	}
+	// The newest surviving version becomes current again.
+	if res, err := server.VaultRead(ctx, connect.NewRequest(&vaultv1.VaultReadRequest{Key: "ci/token"})); err != nil || res.Msg.Value != "long-lived" || res.Msg.Version != 1 {
+		t.Errorf("Expected version 1 to be current after reaping, got %v %v", res, err)
+	}
+	if _, err := server.VaultRead(ctx, connect.NewRequest(&vaultv1.VaultReadRequest{Key: "ci/once"})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("Expected a secret with no live version to be NotFound, got %v", err)
+	}
+
+	// Expiring the secret as a whole removes it entirely.
+	if _, err := server.UpdateSecretMetadata(ctx, connect.NewRequest(&vaultv1.UpdateSecretMetadataRequest{Key: "ci/token", TtlSeconds: 30})); err != nil {
+		t.Fatalf("UpdateSecretMetadata failed: %v", err)
+	}
+	clock = clock.Add(time.Minute)
+	if _, err := server.GetSecretVersion(ctx, connect.NewRequest(&vaultv1.GetSecretVersionRequest{Key: "ci/token", Version: 1})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("Expected expired secret to be NotFound, got %v", err)
+	}
+	if secrets, _, _ := server.ReapExpired(ctx); secrets != 1 {
+		t.Errorf("Expected the secret to be reaped, got %d", secrets)
+	}
+	if _, err := server.GetSecretMetadata(ctx, connect.NewRequest(&vaultv1.GetSecretMetadataRequest{Key: "ci/token"})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("Expected reaped secret to be gone, got %v", err)
+	}
+
+	// Writing a secret that expired but was not yet reaped starts it afresh.
+	server.VaultWrite(ctx, connect.NewRequest(&vaultv1.VaultWriteRequest{Key: "ci/session", Value: "old"}))
+	server.UpdateSecretMetadata(ctx, connect.NewRequest(&vaultv1.UpdateSecretMetadataRequest{Key: "ci/session", TtlSeconds: 30}))
+	clock = clock.Add(time.Minute)
+	if w, err := server.VaultWrite(ctx, connect.NewRequest(&vaultv1.VaultWriteRequest{Key: "ci/session", Value: "new"})); err != nil || w.Msg.Version != 1 {
+		t.Fatalf("Expected the write to create version 1, got %v %v", w, err)
+	}
+	if secrets, versions, _ := server.ReapExpired(ctx); secrets != 0 || versions != 0 {
+		t.Errorf("Expected nothing to reap after the write, got %d %d", secrets, versions)
+	}
+	if res, err := server.VaultRead(ctx, connect.NewRequest(&vaultv1.VaultReadRequest{Key: "ci/session"})); err != nil || res.Msg.Value != "new" {
+		t.Errorf("Expected the new value to be readable, got %v %v", res, err)
+	}
+}
@@ -0,0 +1,81 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"strings"
+)
+
+// Caller is the authenticated identity behind a request, as established by
+// the transport (mTLS) or an auth method. A nil Caller means the request
+// arrived anonymously on the plaintext listener.
+type Caller struct {
+	Identity string
+	Source   string
+
+	// Set when the request presented a vault token.
+	TokenID       string
+	TokenAccessor string
+	Policies      []string
+}
+
+// principal identifies the caller in separation-of-duty checks such as
+// control-group approvals. Token identities are entities fixed when the
+// token tree was issued, so a token's children share its principal; the
+// source keeps certificate and token identities apart.
+func (c *Caller) principal() string {
+	return c.Source + ":" + c.Identity
+}
+
+type callerKey struct{}
+
+// WithCaller returns a context carrying the given caller.
+func WithCaller(ctx context.Context, c *Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, c)
+}
+
+// CallerFrom returns the caller attached to ctx, or nil if there is none.
+func CallerFrom(ctx context.Context) *Caller {
+	c, _ := ctx.Value(callerKey{}).(*Caller)
+	return c
+}
+
+// IdentityFromCertificate maps a client certificate to a vault identity.
+// A SPIFFE ID in the URI SANs wins, followed by any other URI SAN, DNS SAN,
+// email SAN and finally the subject common name.
+func IdentityFromCertificate(cert *x509.Certificate) string {
+	if cert == nil {
+		return ""
+	}
+	for _, u := range cert.URIs {
+		if u.Scheme == "spiffe" {
+			return u.String()
+		}
+	}
+	if len(cert.URIs) > 0 {
+		return cert.URIs[0].String()
+	}
+	if len(cert.DNSNames) > 0 {
+		return cert.DNSNames[0]
+	}
+	if len(cert.EmailAddresses) > 0 {
+		return cert.EmailAddresses[0]
+	}
+	return strings.TrimSpace(cert.Subject.CommonName)
+}
+
+// PeerIdentityHandler attaches the verified client certificate identity to
+// the request context so that policy evaluation sees who is calling.
+func PeerIdentityHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+			if id := IdentityFromCertificate(r.TLS.VerifiedChains[0][0]); id != "" {
+				r = r.WithContext(WithCaller(r.Context(), &Caller{Identity: id, Source: "mtls"}))
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,46 @@
+package inference
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+)
+
+func TestIdentityFromCertificate(t *testing.T) {
+	spiffe, _ := url.Parse("spiffe://olympus.fleet/ci/runner")
+	cases := []struct {
+		cert *x509.Certificate
+		want string
+	}{
+		{&x509.Certificate{Subject: pkix.Name{CommonName: "cn"}, DNSNames: []string{"svc.local"}, URIs: []*url.URL{spiffe}}, "spiffe://olympus.fleet/ci/runner"},
+		{&x509.Certificate{Subject: pkix.Name{CommonName: "cn"}, DNSNames: []string{"svc.local"}}, "svc.local"},
+		{&x509.Certificate{Subject: pkix.Name{CommonName: "cn"}, EmailAddresses: []string{"ops@olympus.fleet"}}, "ops@olympus.fleet"},
+		{&x509.Certificate{Subject: pkix.Name{CommonName: "cn"}}, "cn"},
+	}
+	for _, c := range cases {
+		if got := IdentityFromCertificate(c.cert); got != c.want {
+			t.Errorf("IdentityFromCertificate = %q, want %q", got, c.want)
+		}
+	}
+}
+
+func TestCallerIdentityIsAuthorized(t *testing.T) {
+	server := NewVaultServer(t.TempDir())
+	defer server.Close()
+
+	denied := WithCaller(context.Background(), &Caller{Identity: "unauthorized", Source: "mtls"})
+	_, err := server.VaultRead(denied, connect.NewRequest(&vaultv1.VaultReadRequest{Key: "k"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected PermissionDenied for unauthorized caller, got %v", err)
+	}
+
+	res, _ := server.TestIAMPolicy(denied, connect.NewRequest(&vaultv1.TestIAMPolicyRequest{Action: "read"}))
+	if res.Msg.Allowed {
+		t.Error("Expected TestIAMPolicy to evaluate the caller identity when none is given")
+	}
+}
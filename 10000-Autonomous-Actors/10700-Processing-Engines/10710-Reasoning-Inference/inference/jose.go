@@ -0,0 +1,325 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+// jwk is a single JSON Web Key as published in a JWKS document.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Use string `json:"use,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type jwkSet struct {
+	Keys []jwk `json:"keys"`
+}
+
+func b64(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// publicKey decodes the key material of an RSA, EC (P-256/P-384) or
+// OKP (Ed25519) JWK.
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := b64(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := b64(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := b64(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := b64(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := b64(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+// jwtClaims holds a verified payload. Registered claims are decoded
+// leniently since "aud" may be a string or a list.
+type jwtClaims map[string]any
+
+func (c jwtClaims) str(name string) string {
+	v, _ := c[name].(string)
+	return v
+}
+
+func (c jwtClaims) strings(name string) []string {
+	switch v := c[name].(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var out []string
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func (c jwtClaims) time(name string) (time.Time, bool) {
+	switch v := c[name].(type) {
+	case float64:
+		return time.Unix(int64(v), 0), true
+	case json.Number:
+		n, err := v.Int64()
+		return time.Unix(n, 0), err == nil
+	}
+	return time.Time{}, false
+}
+
+var errJWTSignature = errors.New("jwt signature verification failed")
+
+func verifySignature(alg string, key crypto.PublicKey, signed, sig []byte) error {
+	hashFor := func(h crypto.Hash) []byte {
+		switch h {
+		case crypto.SHA384:
+			sum := sha512.Sum384(signed)
+			return sum[:]
+		case crypto.SHA512:
+			sum := sha512.Sum512(signed)
+			return sum[:]
+		}
+		sum := sha256.Sum256(signed)
+		return sum[:]
+	}
+	hashes := map[string]crypto.Hash{
+		"RS256": crypto.SHA256, "RS384": crypto.SHA384, "RS512": crypto.SHA512,
+		"PS256": crypto.SHA256, "PS384": crypto.SHA384, "PS512": crypto.SHA512,
+		"ES256": crypto.SHA256, "ES384": crypto.SHA384,
+	}
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		h, ok := hashes[alg]
+		if !ok || alg[0] == 'E' {
+			break
+		}
+		if alg[0] == 'P' {
+			return rsa.VerifyPSS(k, h, hashFor(h), sig, nil)
+		}
+		return rsa.VerifyPKCS1v15(k, h, hashFor(h), sig)
+	case *ecdsa.PublicKey:
+		h, ok := hashes[alg]
+		if !ok || alg[0] != 'E' {
+			break
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errJWTSignature
+		}
+		r, s := new(big.Int).SetBytes(sig[:size]), new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(k, hashFor(h), r, s) {
+			return errJWTSignature
+		}
+		return nil
+	case ed25519.PublicKey:
+		if alg != "EdDSA" {
+			break
+		}
+		if !ed25519.Verify(k, signed, sig) {
+			return errJWTSignature
+		}
+		return nil
+	}
+	return fmt.Errorf("algorithm %q does not match key type %T", alg, key)
+}
+
+// verifyJWT checks a compact JWS against the key set and returns its
+// claims. Expiry and not-before are enforced with the given leeway; the
+// caller validates issuer, audience and everything else.
+func verifyJWT(token string, keys []jwk, now time.Time, leeway time.Duration) (jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed jwt")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	raw, err := b64(parts[0])
+	if err != nil || json.Unmarshal(raw, &header) != nil {
+		return nil, fmt.Errorf("malformed jwt header")
+	}
+	if header.Alg == "" || header.Alg == "none" {
+		return nil, fmt.Errorf("unsigned jwt rejected")
+	}
+	sig, err := b64(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed jwt signature")
+	}
+
+	signed := []byte(parts[0] + "." + parts[1])
+	verified := false
+	for _, k := range keys {
+		if header.Kid != "" && k.Kid != header.Kid {
+			continue
+		}
+		if k.Alg != "" && k.Alg != header.Alg {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		if verifySignature(header.Alg, pub, signed, sig) == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errJWTSignature
+	}
+
+	payload, err := b64(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("malformed jwt payload")
+	}
+	var claims jwtClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("malformed jwt payload: %w", err)
+	}
+
+	exp, ok := claims.time("exp")
+	if !ok {
+		return nil, fmt.Errorf("jwt has no expiry")
+	}
+	if !now.Before(exp.Add(leeway)) {
+		return nil, fmt.Errorf("jwt expired at %s", exp.Format(time.RFC3339))
+	}
+	if nbf, ok := claims.time("nbf"); ok && now.Add(leeway).Before(nbf) {
+		return nil, fmt.Errorf("jwt not valid before %s", nbf.Format(time.RFC3339))
+	}
+	return claims, nil
+}
+
+// audienceMatches reports whether the token audience intersects bound.
+func audienceMatches(claims jwtClaims, bound []string) bool {
+	if len(bound) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(claims.strings("aud"), func(a string) bool { return slices.Contains(bound, a) })
+}
+
+// jwsAlgorithm names the JWS algorithm used for a signing key: RS256,
+// ES256 or EdDSA.
+func jwsAlgorithm(key crypto.Signer) (string, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return "RS256", nil
+	case *ecdsa.PrivateKey:
+		if k.Curve == elliptic.P256() {
+			return "ES256", nil
+		}
+	case ed25519.PrivateKey:
+		return "EdDSA", nil
+	}
+	return "", fmt.Errorf("unsupported signing key %T", key)
+}
+
+// signJWT returns claims as a compact JWS signed by key, carrying kid in
+// its header so verifiers can pick the key from a JWKS.
+func signJWT(kid string, key crypto.Signer, claims map[string]any) (string, error) {
+	alg, err := jwsAlgorithm(key)
+	if err != nil {
+		return "", err
+	}
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	sum := sha256.Sum256([]byte(signed))
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, sum[:])
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		if r, s, err = ecdsa.Sign(rand.Reader, k, sum[:]); err == nil {
+			sig = make([]byte, 64)
+			r.FillBytes(sig[:32])
+			s.FillBytes(sig[32:])
+		}
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(k, []byte(signed))
+	}
+	if err != nil {
+		return "", err
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// publicJWK encodes the public half of a signing key for a JWKS.
+func publicJWK(key crypto.Signer, kid string) jwk {
+	alg, _ := jwsAlgorithm(key)
+	k := jwk{Kid: kid, Alg: alg, Use: "sig"}
+	enc := base64.RawURLEncoding.EncodeToString
+	switch pub := key.Public().(type) {
+	case *rsa.PublicKey:
+		k.Kty, k.N, k.E = "RSA", enc(pub.N.Bytes()), enc(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		k.Kty, k.Crv = "EC", "P-256"
+		k.X, k.Y = enc(pub.X.FillBytes(make([]byte, 32))), enc(pub.Y.FillBytes(make([]byte, 32)))
+	case ed25519.PublicKey:
+		k.Kty, k.Crv, k.X = "OKP", "Ed25519", enc(pub)
+	}
+	return k
+}
@@ -0,0 +1,290 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	jwtConfigKey  = "config"
+	jwtRolePrefix = "role/"
+	jwksCacheTTL  = 5 * time.Minute
+)
+
+var jwksHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+type jwtConfig struct {
+	JWKSURLs     []string `json:"jwks_urls"`
+	JWKSFiles    []string `json:"jwks_files"`
+	BoundIssuer  string   `json:"bound_issuer"`
+	LeewaySecond int64    `json:"leeway"`
+}
+
+type jwtRole struct {
+	Name           string            `json:"name"`
+	BoundIssuer    string            `json:"bound_issuer"`
+	BoundAudiences []string          `json:"bound_audiences"`
+	BoundSubject   string            `json:"bound_subject"`
+	BoundClaims    map[string]string `json:"bound_claims"`
+	UserClaim      string            `json:"user_claim"`
+	TokenPolicies  []string          `json:"token_policies"`
+	TokenTTL       int64             `json:"token_ttl"`
+	GroupsClaim    string            `json:"groups_claim"`
+	GroupPolicies  map[string]string `json:"group_policies"`
+}
+
+// jwksCache keeps remote key sets for a few minutes so every login does
+// not hit the issuer.
+type jwksCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedJWKS
+}
+
+type cachedJWKS struct {
+	keys    []jwk
+	fetched time.Time
+}
+
+func (c *jwksCache) fetch(url string, now time.Time) ([]jwk, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[url]; ok && now.Sub(e.fetched) < jwksCacheTTL {
+		return e.keys, nil
+	}
+	resp, err := jwksHTTPClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", url, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	var set jwkSet
+	if err := json.Unmarshal(body, &set); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", url, err)
+	}
+	if c.entries == nil {
+		c.entries = map[string]cachedJWKS{}
+	}
+	c.entries[url] = cachedJWKS{keys: set.Keys, fetched: now}
+	return set.Keys, nil
+}
+
+// keySet merges every configured JWKS source. Files are re-read on each
+// call so that rotated keys take effect immediately.
+func (s *VaultServer) keySet(cfg *jwtConfig) ([]jwk, error) {
+	var keys []jwk
+	for _, path := range cfg.JWKSFiles {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var set jwkSet
+		if err := json.Unmarshal(data, &set); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+		keys = append(keys, set.Keys...)
+	}
+	for _, url := range cfg.JWKSURLs {
+		set, err := s.jwks.fetch(url, s.now())
+		if err != nil {
+			slog.Warn("JWKS fetch failed", "url", url, "error", err)
+			continue
+		}
+		keys = append(keys, set...)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no JWKS keys available")
+	}
+	return keys, nil
+}
+
+func (s *VaultServer) PutJWTConfig(ctx context.Context, req *connect.Request[vaultv1.PutJWTConfigRequest]) (*connect.Response[vaultv1.PutJWTConfigResponse], error) {
+	slog.Info("PutJWTConfig")
+	if err := s.authorizeSudo(ctx, "auth/jwt/config"); err != nil {
+		return nil, err
+	}
+	c := req.Msg.Config
+	if c == nil || len(c.JwksUrls)+len(c.JwksFiles) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one JWKS file or URL is required"))
+	}
+	data, _ := json.Marshal(jwtConfig{JWKSURLs: c.JwksUrls, JWKSFiles: c.JwksFiles, BoundIssuer: c.BoundIssuer, LeewaySecond: c.ClockSkewLeewaySeconds})
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketJWT)).Put([]byte(jwtConfigKey), data)
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutJWTConfigResponse{}), nil
+}
+
+func (s *VaultServer) PutJWTRole(ctx context.Context, req *connect.Request[vaultv1.PutJWTRoleRequest]) (*connect.Response[vaultv1.PutJWTRoleResponse], error) {
+	r := req.Msg.Role
+	if r == nil || r.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("role name is required"))
+	}
+	slog.Info("PutJWTRole", "role", r.Name, "policies", r.TokenPolicies)
+	if err := s.authorizeSudo(ctx, "auth/jwt/role/"+r.Name); err != nil {
+		return nil, err
+	}
+	if len(r.BoundAudiences) == 0 && r.BoundSubject == "" && len(r.BoundClaims) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("role must bind an audience, subject or claim"))
+	}
+	if slices.Contains(r.TokenPolicies, policyRoot) || slices.Contains(slices.Collect(maps.Values(r.GroupPolicies)), policyRoot) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("roles cannot issue root tokens"))
+	}
+
+	data, _ := json.Marshal(jwtRole{
+		Name:           r.Name,
+		BoundIssuer:    r.BoundIssuer,
+		BoundAudiences: r.BoundAudiences,
+		BoundSubject:   r.BoundSubject,
+		BoundClaims:    r.BoundClaims,
+		UserClaim:      r.UserClaim,
+		TokenPolicies:  r.TokenPolicies,
+		TokenTTL:       r.TokenTtlSeconds,
+		GroupsClaim:    r.GroupsClaim,
+		GroupPolicies:  r.GroupPolicies,
+	})
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketJWT)).Put([]byte(jwtRolePrefix+r.Name), data)
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutJWTRoleResponse{}), nil
+}
+
+func (s *VaultServer) loadJWTAuth(role string) (*jwtConfig, *jwtRole, error) {
+	var cfg jwtConfig
+	var r jwtRole
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucketJWT))
+		data := b.Get([]byte(jwtConfigKey))
+		if data == nil {
+			return fmt.Errorf("jwt auth is not configured")
+		}
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return err
+		}
+		data = b.Get([]byte(jwtRolePrefix + role))
+		if data == nil {
+			return fmt.Errorf("role not found: %s", role)
+		}
+		return json.Unmarshal(data, &r)
+	})
+	return &cfg, &r, err
+}
+
+// checkBoundClaims validates issuer, audience, subject and bound claims.
+func (r *jwtRole) checkBoundClaims(cfg *jwtConfig, claims jwtClaims) error {
+	issuer := r.BoundIssuer
+	if issuer == "" {
+		issuer = cfg.BoundIssuer
+	}
+	if issuer != "" && claims.str("iss") != issuer {
+		return fmt.Errorf("issuer %q is not trusted", claims.str("iss"))
+	}
+	if !audienceMatches(claims, r.BoundAudiences) {
+		return fmt.Errorf("audience %v does not match role %s", claims.strings("aud"), r.Name)
+	}
+	if r.BoundSubject != "" && claims.str("sub") != r.BoundSubject {
+		return fmt.Errorf("subject %q does not match role %s", claims.str("sub"), r.Name)
+	}
+	for name, want := range r.BoundClaims {
+		if !slices.Contains(claims.strings(name), want) {
+			return fmt.Errorf("claim %q does not match role %s", name, r.Name)
+		}
+	}
+	return nil
+}
+
+// policiesFor maps the role and the token's groups onto vault policies.
+func (r *jwtRole) policiesFor(claims jwtClaims) []string {
+	policies := slices.Clone(r.TokenPolicies)
+	if r.GroupsClaim != "" {
+		for _, g := range claims.strings(r.GroupsClaim) {
+			if p, ok := r.GroupPolicies[g]; ok && !slices.Contains(policies, p) {
+				policies = append(policies, p)
+			}
+		}
+	}
+	sort.Strings(policies)
+	return append(policies, policyDefault)
+}
+
+// JWTLogin exchanges a signed workload identity token for a vault token.
+func (s *VaultServer) JWTLogin(ctx context.Context, req *connect.Request[vaultv1.JWTLoginRequest]) (*connect.Response[vaultv1.CreateTokenResponse], error) {
+	slog.Info("Login", "method", "jwt", "role", req.Msg.Role)
+	cfg, role, err := s.loadJWTAuth(req.Msg.Role)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	keys, err := s.keySet(cfg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	claims, err := verifyJWT(req.Msg.Jwt, keys, s.now(), time.Duration(cfg.LeewaySecond)*time.Second)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if err := role.checkBoundClaims(cfg, claims); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	userClaim := role.UserClaim
+	if userClaim == "" {
+		userClaim = "sub"
+	}
+	identity := claims.str(userClaim)
+	if identity == "" {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("token has no %q claim", userClaim))
+	}
+
+	ttl := time.Duration(role.TokenTTL) * time.Second
+	if ttl <= 0 {
+		ttl = defaultTokenTTL
+	}
+	// Never outlive the credential that was presented.
+	if exp, _ := claims.time("exp"); exp.Sub(s.now()) < ttl {
+		ttl = max(exp.Sub(s.now()), time.Second)
+	}
+
+	var token string
+	var info *vaultv1.TokenInfo
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		var e *tokenEntry
+		var err error
+		token, e, err = s.issueToken(tx, nil, identity, role.policiesFor(claims), identity, ttl, 0, false)
+		if err != nil {
+			return err
+		}
+		info = e.info(tx)
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.wakeLeases()
+	return connect.NewResponse(&vaultv1.CreateTokenResponse{Token: token, Info: info}), nil
+}
@@ -0,0 +1,114 @@
+package inference
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+)
+
+// mintJWT signs claims with a locally held key.
+func mintJWT(t *testing.T, key crypto.Signer, kid string, claims map[string]any) string {
+	t.Helper()
+	token, err := signJWT(kid, key, claims)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return token
+}
+
+func TestJWTLogin(t *testing.T) {
+	_, client, root := newTestClient(t)
+	ctx := context.Background()
+
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	// One key set comes from a file, the other from a URL.
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	fileSet, _ := json.Marshal(jwkSet{Keys: []jwk{publicJWK(edKey, "file-key")}})
+	os.WriteFile(jwksFile, fileSet, 0600)
+	jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jwkSet{Keys: []jwk{publicJWK(ecKey, "url-key")}})
+	}))
+	defer jwksServer.Close()
+
+	if _, err := client.PutJWTConfig(ctx, withToken(&vaultv1.PutJWTConfigRequest{Config: &vaultv1.JWTConfig{
+		JwksFiles:   []string{jwksFile},
+		JwksUrls:    []string{jwksServer.URL},
+		BoundIssuer: "https://ci.olympus.fleet",
+	}}, root)); err != nil {
+		t.Fatalf("PutJWTConfig failed: %v", err)
+	}
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name: "builds", Rules: []*vaultv1.PolicyRule{{Path: "builds/*", Capabilities: []string{"read"}}},
+	}}, root))
+	if _, err := client.PutJWTRole(ctx, withToken(&vaultv1.PutJWTRoleRequest{Role: &vaultv1.JWTRole{
+		Name:           "ci",
+		BoundAudiences: []string{"olympus-vault"},
+		BoundClaims:    map[string]string{"repository": "OlympusGCP-Vault"},
+		GroupsClaim:    "groups",
+		GroupPolicies:  map[string]string{"release": "builds"},
+	}}, root)); err != nil {
+		t.Fatalf("PutJWTRole failed: %v", err)
+	}
+
+	now := time.Now()
+	claims := func(extra map[string]any) map[string]any {
+		c := map[string]any{
+			"iss":        "https://ci.olympus.fleet",
+			"sub":        "spiffe://olympus.fleet/ci/job-42",
+			"aud":        []string{"olympus-vault"},
+			"exp":        now.Add(10 * time.Minute).Unix(),
+			"iat":        now.Unix(),
+			"repository": "OlympusGCP-Vault",
+			"groups":     []string{"release"},
+		}
+		for k, v := range extra {
+			c[k] = v
+		}
+		return c
+	}
+
+	for _, tc := range []struct {
+		name string
+		key  crypto.Signer
+		kid  string
+	}{{"file", edKey, "file-key"}, {"url", ecKey, "url-key"}} {
+		res, err := client.JWTLogin(ctx, connect.NewRequest(&vaultv1.JWTLoginRequest{Role: "ci", Jwt: mintJWT(t, tc.key, tc.kid, claims(nil))}))
+		if err != nil {
+			t.Fatalf("JWTLogin (%s) failed: %v", tc.name, err)
+		}
+		if res.Msg.Info.DisplayName != "spiffe://olympus.fleet/ci/job-42" {
+			t.Errorf("Expected identity from sub, got %q", res.Msg.Info.DisplayName)
+		}
+		if len(res.Msg.Info.Policies) != 2 || res.Msg.Info.Policies[0] != "builds" {
+			t.Errorf("Expected group-mapped policies [builds default], got %v", res.Msg.Info.Policies)
+		}
+	}
+
+	rejected := map[string]string{
+		"expired":  mintJWT(t, edKey, "file-key", claims(map[string]any{"exp": now.Add(-time.Minute).Unix()})),
+		"audience": mintJWT(t, edKey, "file-key", claims(map[string]any{"aud": "someone-else"})),
+		"issuer":   mintJWT(t, edKey, "file-key", claims(map[string]any{"iss": "https://evil.example"})),
+		"claim":    mintJWT(t, edKey, "file-key", claims(map[string]any{"repository": "other"})),
+		"kid":      mintJWT(t, edKey, "url-key", claims(nil)),
+	}
+	for name, jwt := range rejected {
+		if _, err := client.JWTLogin(ctx, connect.NewRequest(&vaultv1.JWTLoginRequest{Role: "ci", Jwt: jwt})); err == nil {
+			t.Errorf("Expected %s mismatch to be rejected", name)
+		}
+	}
+}
@@ -0,0 +1,976 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"hash/crc32"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	kmsv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/kms"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	kmsDestroyDelay  = 30 * 24 * time.Hour
+	kmsMaxPlaintext  = 64 << 10
+	kmsPageSize      = 100
+	kmsVersionsInfix = "/cryptoKeyVersions/"
+)
+
+var (
+	kmsLocationName = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+$`)
+	kmsKeyRingName  = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+/keyRings/[^/]+$`)
+	kmsResourceID   = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,63}$`)
+	crc32cTable     = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// KMSServer serves the Cloud KMS KeyManagementService API from the
+// vault's storage.
+type KMSServer struct {
+	*VaultServer
+}
+
+// KMS returns the KeyManagementService handler backed by s.
+func (s *VaultServer) KMS() *KMSServer {
+	return &KMSServer{s}
+}
+
+// kmsAlgorithm describes a CryptoKeyVersionAlgorithm the emulator
+// supports. generate is nil for symmetric algorithms.
+type kmsAlgorithm struct {
+	purpose  kmsv1.CryptoKey_CryptoKeyPurpose
+	generate func() (crypto.Signer, error)
+	hash     crypto.Hash
+	pss      bool
+}
+
+func rsaSigner(bits int) func() (crypto.Signer, error) {
+	return func() (crypto.Signer, error) { return rsa.GenerateKey(rand.Reader, bits) }
+}
+
+func ecSigner(curve elliptic.Curve) func() (crypto.Signer, error) {
+	return func() (crypto.Signer, error) { return ecdsa.GenerateKey(curve, rand.Reader) }
+}
+
+var kmsAlgorithms = map[kmsv1.CryptoKeyVersion_CryptoKeyVersionAlgorithm]kmsAlgorithm{
+	kmsv1.CryptoKeyVersion_GOOGLE_SYMMETRIC_ENCRYPTION: {purpose: kmsv1.CryptoKey_ENCRYPT_DECRYPT},
+	kmsv1.CryptoKeyVersion_RSA_SIGN_PSS_2048_SHA256:    {kmsv1.CryptoKey_ASYMMETRIC_SIGN, rsaSigner(2048), crypto.SHA256, true},
+	kmsv1.CryptoKeyVersion_RSA_SIGN_PSS_3072_SHA256:    {kmsv1.CryptoKey_ASYMMETRIC_SIGN, rsaSigner(3072), crypto.SHA256, true},
+	kmsv1.CryptoKeyVersion_RSA_SIGN_PSS_4096_SHA256:    {kmsv1.CryptoKey_ASYMMETRIC_SIGN, rsaSigner(4096), crypto.SHA256, true},
+	kmsv1.CryptoKeyVersion_RSA_SIGN_PSS_4096_SHA512:    {kmsv1.CryptoKey_ASYMMETRIC_SIGN, rsaSigner(4096), crypto.SHA512, true},
+	kmsv1.CryptoKeyVersion_RSA_SIGN_PKCS1_2048_SHA256:  {kmsv1.CryptoKey_ASYMMETRIC_SIGN, rsaSigner(2048), crypto.SHA256, false},
+	kmsv1.CryptoKeyVersion_RSA_SIGN_PKCS1_3072_SHA256:  {kmsv1.CryptoKey_ASYMMETRIC_SIGN, rsaSigner(3072), crypto.SHA256, false},
+	kmsv1.CryptoKeyVersion_RSA_SIGN_PKCS1_4096_SHA256:  {kmsv1.CryptoKey_ASYMMETRIC_SIGN, rsaSigner(4096), crypto.SHA256, false},
+	kmsv1.CryptoKeyVersion_RSA_SIGN_PKCS1_4096_SHA512:  {kmsv1.CryptoKey_ASYMMETRIC_SIGN, rsaSigner(4096), crypto.SHA512, false},
+	kmsv1.CryptoKeyVersion_EC_SIGN_P256_SHA256:         {kmsv1.CryptoKey_ASYMMETRIC_SIGN, ecSigner(elliptic.P256()), crypto.SHA256, false},
+	kmsv1.CryptoKeyVersion_EC_SIGN_P384_SHA384:         {kmsv1.CryptoKey_ASYMMETRIC_SIGN, ecSigner(elliptic.P384()), crypto.SHA384, false},
+	kmsv1.CryptoKeyVersion_EC_SIGN_ED25519:             {purpose: kmsv1.CryptoKey_ASYMMETRIC_SIGN, generate: signingKeyTypes["ed25519"]},
+}
+
+type kmsKeyRing struct {
+	CreateTime time.Time `json:"create_time"`
+}
+
+// kmsKeyVersion holds a raw AES key or a PKCS #8 private key.
+type kmsKeyVersion struct {
+	State            kmsv1.CryptoKeyVersion_CryptoKeyVersionState     `json:"state"`
+	Algorithm        kmsv1.CryptoKeyVersion_CryptoKeyVersionAlgorithm `json:"algorithm"`
+	Key              []byte                                           `json:"key,omitempty"`
+	CreateTime       time.Time                                        `json:"create_time"`
+	DestroyTime      time.Time                                        `json:"destroy_time,omitzero"`
+	DestroyEventTime time.Time                                        `json:"destroy_event_time,omitzero"`
+}
+
+// kmsCryptoKey is stored sealed by the barrier. Versions[i] is version
+// i+1; Primary is 0 when the key has none.
+type kmsCryptoKey struct {
+	Purpose         kmsv1.CryptoKey_CryptoKeyPurpose                 `json:"purpose"`
+	Algorithm       kmsv1.CryptoKeyVersion_CryptoKeyVersionAlgorithm `json:"algorithm"`
+	ProtectionLevel kmsv1.ProtectionLevel                            `json:"protection_level"`
+	Primary         int                                              `json:"primary,omitempty"`
+	Labels          map[string]string                                `json:"labels,omitempty"`
+	DestroyDelay    time.Duration                                    `json:"destroy_scheduled_duration"`
+	CreateTime      time.Time                                        `json:"create_time"`
+	Versions        []*kmsKeyVersion                                 `json:"versions"`
+}
+
+func kmsVersionName(key string, id int) string {
+	return key + kmsVersionsInfix + strconv.Itoa(id)
+}
+
+// splitKMSVersionName splits a CryptoKeyVersion name into its CryptoKey
+// name and version id.
+func splitKMSVersionName(name string) (string, int, error) {
+	key, id, ok := strings.Cut(name, kmsVersionsInfix)
+	n, err := strconv.Atoi(id)
+	if !ok || err != nil || n <= 0 {
+		return "", 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid CryptoKeyVersion name %q", name))
+	}
+	return key, n, nil
+}
+
+func (v *kmsKeyVersion) proto(name string, level kmsv1.ProtectionLevel) *kmsv1.CryptoKeyVersion {
+	res := &kmsv1.CryptoKeyVersion{
+		Name:            name,
+		State:           v.State,
+		ProtectionLevel: level,
+		Algorithm:       v.Algorithm,
+		CreateTime:      timestamppb.New(v.CreateTime),
+		GenerateTime:    timestamppb.New(v.CreateTime),
+	}
+	if !v.DestroyTime.IsZero() {
+		res.DestroyTime = timestamppb.New(v.DestroyTime)
+	}
+	if !v.DestroyEventTime.IsZero() {
+		res.DestroyEventTime = timestamppb.New(v.DestroyEventTime)
+	}
+	return res
+}
+
+// enabled reports a FailedPrecondition unless the version can be used for
+// cryptographic operations.
+func (v *kmsKeyVersion) enabled(name string) error {
+	if v.State != kmsv1.CryptoKeyVersion_ENABLED {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s is not enabled, current state: %s", name, v.State))
+	}
+	return nil
+}
+
+func (v *kmsKeyVersion) aead() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(v.Key)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return aead, nil
+}
+
+func (v *kmsKeyVersion) signer() (crypto.Signer, error) {
+	key, err := x509.ParsePKCS8PrivateKey(v.Key)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return key.(crypto.Signer), nil
+}
+
+func (k *kmsCryptoKey) proto(name string) *kmsv1.CryptoKey {
+	res := &kmsv1.CryptoKey{
+		Name:                     name,
+		Purpose:                  k.Purpose,
+		CreateTime:               timestamppb.New(k.CreateTime),
+		VersionTemplate:          &kmsv1.CryptoKeyVersionTemplate{ProtectionLevel: k.ProtectionLevel, Algorithm: k.Algorithm},
+		Labels:                   k.Labels,
+		DestroyScheduledDuration: durationpb.New(k.DestroyDelay),
+	}
+	if k.Primary > 0 {
+		res.Primary = k.Versions[k.Primary-1].proto(kmsVersionName(name, k.Primary), k.ProtectionLevel)
+	}
+	return res
+}
+
+func (k *kmsCryptoKey) version(name string, id int) (*kmsKeyVersion, error) {
+	if id <= 0 || id > len(k.Versions) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("CryptoKeyVersion not found: %s", kmsVersionName(name, id)))
+	}
+	return k.Versions[id-1], nil
+}
+
+func (k *kmsCryptoKey) addVersion(now time.Time) (int, error) {
+	v := &kmsKeyVersion{State: kmsv1.CryptoKeyVersion_ENABLED, Algorithm: k.Algorithm, CreateTime: now}
+	if alg := kmsAlgorithms[k.Algorithm]; alg.generate != nil {
+		key, err := alg.generate()
+		if err != nil {
+			return 0, connect.NewError(connect.CodeInternal, err)
+		}
+		if v.Key, err = x509.MarshalPKCS8PrivateKey(key); err != nil {
+			return 0, connect.NewError(connect.CodeInternal, err)
+		}
+	} else {
+		v.Key = make([]byte, 32)
+		rand.Read(v.Key)
+	}
+	k.Versions = append(k.Versions, v)
+	return len(k.Versions), nil
+}
+
+// settle completes scheduled destructions that are due, discarding their
+// key material. It runs whenever a key is loaded, so the material is
+// gone from disk once the key is next written.
+func (k *kmsCryptoKey) settle(now time.Time) {
+	for _, v := range k.Versions {
+		if v.State == kmsv1.CryptoKeyVersion_DESTROY_SCHEDULED && !now.Before(v.DestroyTime) {
+			v.State, v.Key, v.DestroyEventTime = kmsv1.CryptoKeyVersion_DESTROYED, nil, v.DestroyTime
+		}
+	}
+}
+
+func (s *VaultServer) getKMSCryptoKey(tx *bbolt.Tx, name string) (*kmsCryptoKey, error) {
+	data := tx.Bucket([]byte(bucketKMSCryptoKeys)).Get([]byte(name))
+	if data == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("CryptoKey not found: %s", name))
+	}
+	plain, err := s.barrier.open(bucketKMSCryptoKeys+"/"+name, data)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var k kmsCryptoKey
+	if err := json.Unmarshal(plain, &k); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	k.settle(s.now())
+	return &k, nil
+}
+
+func (s *VaultServer) putKMSCryptoKey(tx *bbolt.Tx, name string, k *kmsCryptoKey) error {
+	data, _ := json.Marshal(k)
+	return tx.Bucket([]byte(bucketKMSCryptoKeys)).Put([]byte(name), s.barrier.seal(bucketKMSCryptoKeys+"/"+name, data))
+}
+
+func (s *VaultServer) loadKMSCryptoKey(name string) (*kmsCryptoKey, error) {
+	var k *kmsCryptoKey
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		k, err = s.getKMSCryptoKey(tx, name)
+		return err
+	})
+	return k, err
+}
+
+// updateKMSCryptoKey applies fn to the stored key under a write
+// transaction.
+func (s *VaultServer) updateKMSCryptoKey(name string, fn func(k *kmsCryptoKey) error) (*kmsCryptoKey, error) {
+	var k *kmsCryptoKey
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		var err error
+		if k, err = s.getKMSCryptoKey(tx, name); err != nil {
+			return err
+		}
+		if err := fn(k); err != nil {
+			return err
+		}
+		if err := s.putKMSCryptoKey(tx, name, k); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	})
+	return k, err
+}
+
+// updateKMSVersion applies fn to one version of a stored key.
+func (s *VaultServer) updateKMSVersion(name string, fn func(k *kmsCryptoKey, v *kmsKeyVersion) error) (*kmsKeyVersion, kmsv1.ProtectionLevel, error) {
+	keyName, id, err := splitKMSVersionName(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	var v *kmsKeyVersion
+	k, err := s.updateKMSCryptoKey(keyName, func(k *kmsCryptoKey) error {
+		var err error
+		if v, err = k.version(keyName, id); err != nil {
+			return err
+		}
+		return fn(k, v)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return v, k.ProtectionLevel, nil
+}
+
+// kmsPage returns the entries of bucket under prefix, at most size of them
+// starting after token, which is the last name of the previous page.
+func (s *VaultServer) kmsPage(bucket, prefix, token string, size int32) (names []string, values [][]byte, next string, total int32, err error) {
+	if size <= 0 || size > kmsPageSize {
+		size = kmsPageSize
+	}
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket([]byte(bucket)).Cursor()
+		for k, v := c.Seek([]byte(prefix)); k != nil && bytes.HasPrefix(k, []byte(prefix)); k, v = c.Next() {
+			if strings.Contains(string(k[len(prefix):]), "/") {
+				continue
+			}
+			total++
+			if string(k) <= token {
+				continue
+			}
+			if int32(len(names)) == size {
+				next = names[len(names)-1]
+				continue
+			}
+			names = append(names, string(k))
+			values = append(values, slices.Clone(v))
+		}
+		return nil
+	})
+	return names, values, next, total, err
+}
+
+func crc32c(data []byte) *wrapperspb.Int64Value {
+	return wrapperspb.Int64(int64(crc32.Checksum(data, crc32cTable)))
+}
+
+// verifyCRC32C checks an optional client-supplied checksum, reporting
+// whether one was verified.
+func verifyCRC32C(field string, data []byte, sum *wrapperspb.Int64Value) (bool, error) {
+	if sum == nil {
+		return false, nil
+	}
+	if sum.Value != int64(crc32.Checksum(data, crc32cTable)) {
+		return false, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s checksum mismatch", field))
+	}
+	return true, nil
+}
+
+// kmsEncrypt seals plaintext with name, a CryptoKey (using its primary
+// version) or a CryptoKeyVersion. The ciphertext starts with the version
+// id so kmsDecrypt needs only the CryptoKey.
+func (s *VaultServer) kmsEncrypt(name string, plaintext, aad []byte) (string, []byte, kmsv1.ProtectionLevel, error) {
+	keyName, id := name, 0
+	if strings.Contains(name, kmsVersionsInfix) {
+		var err error
+		if keyName, id, err = splitKMSVersionName(name); err != nil {
+			return "", nil, 0, err
+		}
+	}
+	if len(plaintext) > kmsMaxPlaintext {
+		return "", nil, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("plaintext exceeds %d bytes", kmsMaxPlaintext))
+	}
+	k, err := s.loadKMSCryptoKey(keyName)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	if k.Purpose != kmsv1.CryptoKey_ENCRYPT_DECRYPT {
+		return "", nil, 0, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s is not an ENCRYPT_DECRYPT key", keyName))
+	}
+	if id == 0 {
+		if id = k.Primary; id == 0 {
+			return "", nil, 0, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s has no primary version", keyName))
+		}
+	}
+	versionName := kmsVersionName(keyName, id)
+	v, err := k.version(keyName, id)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	if err := v.enabled(versionName); err != nil {
+		return "", nil, 0, err
+	}
+	aead, err := v.aead()
+	if err != nil {
+		return "", nil, 0, err
+	}
+	out := binary.BigEndian.AppendUint32(nil, uint32(id))
+	nonce := make([]byte, aead.NonceSize())
+	rand.Read(nonce)
+	out = append(out, nonce...)
+	return versionName, aead.Seal(out, nonce, plaintext, aad), k.ProtectionLevel, nil
+}
+
+// kmsDecrypt opens ciphertext produced by kmsEncrypt under the CryptoKey
+// name, returning the plaintext and the version that sealed it.
+func (s *VaultServer) kmsDecrypt(name string, ciphertext, aad []byte) ([]byte, int, *kmsCryptoKey, error) {
+	k, err := s.loadKMSCryptoKey(name)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	if k.Purpose != kmsv1.CryptoKey_ENCRYPT_DECRYPT {
+		return nil, 0, nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s is not an ENCRYPT_DECRYPT key", name))
+	}
+	if len(ciphertext) < 4 {
+		return nil, 0, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("malformed ciphertext"))
+	}
+	id := int(binary.BigEndian.Uint32(ciphertext))
+	v, err := k.version(name, id)
+	if err != nil {
+		return nil, 0, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("malformed ciphertext"))
+	}
+	if err := v.enabled(kmsVersionName(name, id)); err != nil {
+		return nil, 0, nil, err
+	}
+	aead, err := v.aead()
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	body := ciphertext[4:]
+	if len(body) < aead.NonceSize() {
+		return nil, 0, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("malformed ciphertext"))
+	}
+	plaintext, err := aead.Open(nil, body[:aead.NonceSize()], body[aead.NonceSize():], aad)
+	if err != nil {
+		return nil, 0, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("decryption failed: ciphertext or additional authenticated data is invalid"))
+	}
+	return plaintext, id, k, nil
+}
+
+func (m *KMSServer) CreateKeyRing(ctx context.Context, req *connect.Request[kmsv1.CreateKeyRingRequest]) (*connect.Response[kmsv1.KeyRing], error) {
+	name := req.Msg.Parent + "/keyRings/" + req.Msg.KeyRingId
+	slog.Info("CreateKeyRing", "name", name)
+	if !kmsLocationName.MatchString(req.Msg.Parent) || !kmsResourceID.MatchString(req.Msg.KeyRingId) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid KeyRing name %q", name))
+	}
+	if err := m.authorize(ctx, "create", "kms/"+name); err != nil {
+		return nil, err
+	}
+	ring := kmsKeyRing{CreateTime: m.now()}
+	err := m.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucketKMSKeyRings))
+		if b.Get([]byte(name)) != nil {
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("KeyRing already exists: %s", name))
+		}
+		data, _ := json.Marshal(ring)
+		if err := b.Put([]byte(name), data); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	})
+	m.audit(ctx, "CreateKeyRing", name, 0, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&kmsv1.KeyRing{Name: name, CreateTime: timestamppb.New(ring.CreateTime)}), nil
+}
+
+func (m *KMSServer) GetKeyRing(ctx context.Context, req *connect.Request[kmsv1.GetKeyRingRequest]) (*connect.Response[kmsv1.KeyRing], error) {
+	name := req.Msg.Name
+	slog.Info("GetKeyRing", "name", name)
+	if err := m.authorize(ctx, "read", "kms/"+name); err != nil {
+		return nil, err
+	}
+	var ring kmsKeyRing
+	err := m.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket([]byte(bucketKMSKeyRings)).Get([]byte(name))
+		if data == nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("KeyRing not found: %s", name))
+		}
+		if err := json.Unmarshal(data, &ring); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&kmsv1.KeyRing{Name: name, CreateTime: timestamppb.New(ring.CreateTime)}), nil
+}
+
+func (m *KMSServer) ListKeyRings(ctx context.Context, req *connect.Request[kmsv1.ListKeyRingsRequest]) (*connect.Response[kmsv1.ListKeyRingsResponse], error) {
+	parent := req.Msg.Parent
+	slog.Info("ListKeyRings", "parent", parent)
+	if err := m.authorize(ctx, "list", "kms/"+parent); err != nil {
+		return nil, err
+	}
+	names, values, next, total, err := m.kmsPage(bucketKMSKeyRings, parent+"/keyRings/", req.Msg.PageToken, req.Msg.PageSize)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := &kmsv1.ListKeyRingsResponse{NextPageToken: next, TotalSize: total}
+	for i, name := range names {
+		var ring kmsKeyRing
+		json.Unmarshal(values[i], &ring)
+		res.KeyRings = append(res.KeyRings, &kmsv1.KeyRing{Name: name, CreateTime: timestamppb.New(ring.CreateTime)})
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (m *KMSServer) CreateCryptoKey(ctx context.Context, req *connect.Request[kmsv1.CreateCryptoKeyRequest]) (*connect.Response[kmsv1.CryptoKey], error) {
+	name := req.Msg.Parent + "/cryptoKeys/" + req.Msg.CryptoKeyId
+	slog.Info("CreateCryptoKey", "name", name)
+	if !kmsKeyRingName.MatchString(req.Msg.Parent) || !kmsResourceID.MatchString(req.Msg.CryptoKeyId) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid CryptoKey name %q", name))
+	}
+	if err := m.authorize(ctx, "create", "kms/"+name); err != nil {
+		return nil, err
+	}
+	spec := req.Msg.CryptoKey
+	if spec == nil {
+		spec = &kmsv1.CryptoKey{}
+	}
+	k := &kmsCryptoKey{
+		Purpose:         spec.Purpose,
+		Algorithm:       spec.GetVersionTemplate().GetAlgorithm(),
+		ProtectionLevel: spec.GetVersionTemplate().GetProtectionLevel(),
+		Labels:          spec.Labels,
+		DestroyDelay:    spec.GetDestroyScheduledDuration().AsDuration(),
+		CreateTime:      m.now(),
+	}
+	if k.Algorithm == kmsv1.CryptoKeyVersion_CRYPTO_KEY_VERSION_ALGORITHM_UNSPECIFIED && k.Purpose == kmsv1.CryptoKey_ENCRYPT_DECRYPT {
+		k.Algorithm = kmsv1.CryptoKeyVersion_GOOGLE_SYMMETRIC_ENCRYPTION
+	}
+	if alg, ok := kmsAlgorithms[k.Algorithm]; !ok || alg.purpose != k.Purpose {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported purpose %s with algorithm %s", k.Purpose, k.Algorithm))
+	}
+	if k.ProtectionLevel == kmsv1.ProtectionLevel_PROTECTION_LEVEL_UNSPECIFIED {
+		k.ProtectionLevel = kmsv1.ProtectionLevel_SOFTWARE
+	}
+	if k.DestroyDelay <= 0 {
+		k.DestroyDelay = kmsDestroyDelay
+	}
+	if !req.Msg.SkipInitialVersionCreation {
+		if _, err := k.addVersion(k.CreateTime); err != nil {
+			return nil, err
+		}
+		if k.Purpose == kmsv1.CryptoKey_ENCRYPT_DECRYPT {
+			k.Primary = 1
+		}
+	}
+
+	err := m.db.Update(func(tx *bbolt.Tx) error {
+		if tx.Bucket([]byte(bucketKMSKeyRings)).Get([]byte(req.Msg.Parent)) == nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("KeyRing not found: %s", req.Msg.Parent))
+		}
+		if tx.Bucket([]byte(bucketKMSCryptoKeys)).Get([]byte(name)) != nil {
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("CryptoKey already exists: %s", name))
+		}
+		if err := m.putKMSCryptoKey(tx, name, k); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	})
+	m.audit(ctx, "CreateCryptoKey", name, int32(len(k.Versions)), err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(k.proto(name)), nil
+}
+
+func (m *KMSServer) GetCryptoKey(ctx context.Context, req *connect.Request[kmsv1.GetCryptoKeyRequest]) (*connect.Response[kmsv1.CryptoKey], error) {
+	name := req.Msg.Name
+	slog.Info("GetCryptoKey", "name", name)
+	if err := m.authorize(ctx, "read", "kms/"+name); err != nil {
+		return nil, err
+	}
+	k, err := m.loadKMSCryptoKey(name)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(k.proto(name)), nil
+}
+
+func (m *KMSServer) ListCryptoKeys(ctx context.Context, req *connect.Request[kmsv1.ListCryptoKeysRequest]) (*connect.Response[kmsv1.ListCryptoKeysResponse], error) {
+	parent := req.Msg.Parent
+	slog.Info("ListCryptoKeys", "parent", parent)
+	if err := m.authorize(ctx, "list", "kms/"+parent); err != nil {
+		return nil, err
+	}
+	names, values, next, total, err := m.kmsPage(bucketKMSCryptoKeys, parent+"/cryptoKeys/", req.Msg.PageToken, req.Msg.PageSize)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := &kmsv1.ListCryptoKeysResponse{NextPageToken: next, TotalSize: total}
+	for i, name := range names {
+		plain, err := m.barrier.open(bucketKMSCryptoKeys+"/"+name, values[i])
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		var k kmsCryptoKey
+		if err := json.Unmarshal(plain, &k); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		k.settle(m.now())
+		res.CryptoKeys = append(res.CryptoKeys, k.proto(name))
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (m *KMSServer) CreateCryptoKeyVersion(ctx context.Context, req *connect.Request[kmsv1.CreateCryptoKeyVersionRequest]) (*connect.Response[kmsv1.CryptoKeyVersion], error) {
+	name := req.Msg.Parent
+	slog.Info("CreateCryptoKeyVersion", "name", name)
+	if err := m.authorize(ctx, "update", "kms/"+name); err != nil {
+		return nil, err
+	}
+	var id int
+	k, err := m.updateKMSCryptoKey(name, func(k *kmsCryptoKey) error {
+		var err error
+		id, err = k.addVersion(m.now())
+		return err
+	})
+	m.audit(ctx, "CreateCryptoKeyVersion", name, int32(id), err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(k.Versions[id-1].proto(kmsVersionName(name, id), k.ProtectionLevel)), nil
+}
+
+func (m *KMSServer) GetCryptoKeyVersion(ctx context.Context, req *connect.Request[kmsv1.GetCryptoKeyVersionRequest]) (*connect.Response[kmsv1.CryptoKeyVersion], error) {
+	name := req.Msg.Name
+	slog.Info("GetCryptoKeyVersion", "name", name)
+	keyName, id, err := splitKMSVersionName(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.authorize(ctx, "read", "kms/"+keyName); err != nil {
+		return nil, err
+	}
+	k, err := m.loadKMSCryptoKey(keyName)
+	if err != nil {
+		return nil, err
+	}
+	v, err := k.version(keyName, id)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(v.proto(name, k.ProtectionLevel)), nil
+}
+
+func (m *KMSServer) ListCryptoKeyVersions(ctx context.Context, req *connect.Request[kmsv1.ListCryptoKeyVersionsRequest]) (*connect.Response[kmsv1.ListCryptoKeyVersionsResponse], error) {
+	name := req.Msg.Parent
+	slog.Info("ListCryptoKeyVersions", "parent", name)
+	if err := m.authorize(ctx, "list", "kms/"+name); err != nil {
+		return nil, err
+	}
+	k, err := m.loadKMSCryptoKey(name)
+	if err != nil {
+		return nil, err
+	}
+	size := int(req.Msg.PageSize)
+	if size <= 0 || size > kmsPageSize {
+		size = kmsPageSize
+	}
+	start := 0
+	if req.Msg.PageToken != "" {
+		if start, err = strconv.Atoi(req.Msg.PageToken); err != nil || start < 0 || start > len(k.Versions) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page token"))
+		}
+	}
+	end := min(start+size, len(k.Versions))
+	res := &kmsv1.ListCryptoKeyVersionsResponse{TotalSize: int32(len(k.Versions))}
+	for i := start; i < end; i++ {
+		res.CryptoKeyVersions = append(res.CryptoKeyVersions, k.Versions[i].proto(kmsVersionName(name, i+1), k.ProtectionLevel))
+	}
+	if end < len(k.Versions) {
+		res.NextPageToken = strconv.Itoa(end)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// UpdateCryptoKeyVersion moves a version between ENABLED and DISABLED,
+// the only update the emulator supports.
+func (m *KMSServer) UpdateCryptoKeyVersion(ctx context.Context, req *connect.Request[kmsv1.UpdateCryptoKeyVersionRequest]) (*connect.Response[kmsv1.CryptoKeyVersion], error) {
+	spec := req.Msg.CryptoKeyVersion
+	if spec == nil || !slices.Equal(req.Msg.GetUpdateMask().GetPaths(), []string{"state"}) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("update_mask must be exactly [state]"))
+	}
+	name := spec.Name
+	slog.Info("UpdateCryptoKeyVersion", "name", name, "state", spec.State)
+	if spec.State != kmsv1.CryptoKeyVersion_ENABLED && spec.State != kmsv1.CryptoKeyVersion_DISABLED {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("state must be ENABLED or DISABLED"))
+	}
+	keyName, id, err := splitKMSVersionName(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.authorize(ctx, "update", "kms/"+keyName); err != nil {
+		return nil, err
+	}
+	v, level, err := m.updateKMSVersion(name, func(_ *kmsCryptoKey, v *kmsKeyVersion) error {
+		if v.State != kmsv1.CryptoKeyVersion_ENABLED && v.State != kmsv1.CryptoKeyVersion_DISABLED {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s cannot be updated in state %s", name, v.State))
+		}
+		v.State = spec.State
+		return nil
+	})
+	m.audit(ctx, "UpdateCryptoKeyVersion", keyName, int32(id), err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(v.proto(name, level)), nil
+}
+
+func (m *KMSServer) UpdateCryptoKeyPrimaryVersion(ctx context.Context, req *connect.Request[kmsv1.UpdateCryptoKeyPrimaryVersionRequest]) (*connect.Response[kmsv1.CryptoKey], error) {
+	name := req.Msg.Name
+	slog.Info("UpdateCryptoKeyPrimaryVersion", "name", name, "version", req.Msg.CryptoKeyVersionId)
+	id, err := strconv.Atoi(req.Msg.CryptoKeyVersionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid crypto_key_version_id %q", req.Msg.CryptoKeyVersionId))
+	}
+	if err := m.authorize(ctx, "update", "kms/"+name); err != nil {
+		return nil, err
+	}
+	var changed bool
+	k, err := m.updateKMSCryptoKey(name, func(k *kmsCryptoKey) error {
+		if k.Purpose != kmsv1.CryptoKey_ENCRYPT_DECRYPT {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s is not an ENCRYPT_DECRYPT key", name))
+		}
+		v, err := k.version(name, id)
+		if err != nil {
+			return err
+		}
+		if err := v.enabled(kmsVersionName(name, id)); err != nil {
+			return err
+		}
+		changed = k.Primary != id
+		k.Primary = id
+		return nil
+	})
+	m.audit(ctx, "UpdateCryptoKeyPrimaryVersion", name, int32(id), err)
+	if err != nil {
+		return nil, err
+	}
+	// Move the vault's secrets onto the new primary. Failures are left for
+	// ReencryptSecrets to retry; the primary has already changed.
+	if changed {
+		if res, err := m.reencryptSecrets(ctx, name); err != nil {
+			slog.Warn("Re-encryption after primary change failed", "name", name, "error", err)
+		} else if len(res.Failed) > 0 {
+			slog.Warn("Some secrets were not re-encrypted", "name", name, "reencrypted", res.Reencrypted, "failed", len(res.Failed))
+		}
+	}
+	return connect.NewResponse(k.proto(name)), nil
+}
+
+// DestroyCryptoKeyVersion schedules a version for destruction after the
+// key's destroy_scheduled_duration; RestoreCryptoKeyVersion cancels it.
+func (m *KMSServer) DestroyCryptoKeyVersion(ctx context.Context, req *connect.Request[kmsv1.DestroyCryptoKeyVersionRequest]) (*connect.Response[kmsv1.CryptoKeyVersion], error) {
+	name := req.Msg.Name
+	slog.Info("DestroyCryptoKeyVersion", "name", name)
+	keyName, id, err := splitKMSVersionName(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.authorize(ctx, "update", "kms/"+keyName); err != nil {
+		return nil, err
+	}
+	v, level, err := m.updateKMSVersion(name, func(k *kmsCryptoKey, v *kmsKeyVersion) error {
+		if v.State != kmsv1.CryptoKeyVersion_ENABLED && v.State != kmsv1.CryptoKeyVersion_DISABLED {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s cannot be destroyed in state %s", name, v.State))
+		}
+		v.State, v.DestroyTime = kmsv1.CryptoKeyVersion_DESTROY_SCHEDULED, m.now().Add(k.DestroyDelay)
+		return nil
+	})
+	m.audit(ctx, "DestroyCryptoKeyVersion", keyName, int32(id), err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(v.proto(name, level)), nil
+}
+
+func (m *KMSServer) RestoreCryptoKeyVersion(ctx context.Context, req *connect.Request[kmsv1.RestoreCryptoKeyVersionRequest]) (*connect.Response[kmsv1.CryptoKeyVersion], error) {
+	name := req.Msg.Name
+	slog.Info("RestoreCryptoKeyVersion", "name", name)
+	keyName, id, err := splitKMSVersionName(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.authorize(ctx, "update", "kms/"+keyName); err != nil {
+		return nil, err
+	}
+	v, level, err := m.updateKMSVersion(name, func(_ *kmsCryptoKey, v *kmsKeyVersion) error {
+		if v.State != kmsv1.CryptoKeyVersion_DESTROY_SCHEDULED {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s is not scheduled for destruction", name))
+		}
+		v.State, v.DestroyTime = kmsv1.CryptoKeyVersion_DISABLED, time.Time{}
+		return nil
+	})
+	m.audit(ctx, "RestoreCryptoKeyVersion", keyName, int32(id), err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(v.proto(name, level)), nil
+}
+
+func (m *KMSServer) Encrypt(ctx context.Context, req *connect.Request[kmsv1.EncryptRequest]) (*connect.Response[kmsv1.EncryptResponse], error) {
+	name := req.Msg.Name
+	slog.Info("KMSEncrypt", "name", name)
+	keyName, _, _ := strings.Cut(name, kmsVersionsInfix)
+	if err := m.authorize(ctx, "update", "kms/"+keyName); err != nil {
+		return nil, err
+	}
+	verifiedPlaintext, err := verifyCRC32C("plaintext", req.Msg.Plaintext, req.Msg.PlaintextCrc32C)
+	if err != nil {
+		return nil, err
+	}
+	verifiedAAD, err := verifyCRC32C("additional_authenticated_data", req.Msg.AdditionalAuthenticatedData, req.Msg.AdditionalAuthenticatedDataCrc32C)
+	if err != nil {
+		return nil, err
+	}
+	version, ciphertext, level, err := m.kmsEncrypt(name, req.Msg.Plaintext, req.Msg.AdditionalAuthenticatedData)
+	m.audit(ctx, "KMSEncrypt", keyName, 0, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&kmsv1.EncryptResponse{
+		Name:                    version,
+		Ciphertext:              ciphertext,
+		CiphertextCrc32C:        crc32c(ciphertext),
+		VerifiedPlaintextCrc32C: verifiedPlaintext,
+		VerifiedAdditionalAuthenticatedDataCrc32C: verifiedAAD,
+		ProtectionLevel: level,
+	}), nil
+}
+
+func (m *KMSServer) Decrypt(ctx context.Context, req *connect.Request[kmsv1.DecryptRequest]) (*connect.Response[kmsv1.DecryptResponse], error) {
+	name := req.Msg.Name
+	slog.Info("KMSDecrypt", "name", name)
+	if err := m.authorize(ctx, "update", "kms/"+name); err != nil {
+		return nil, err
+	}
+	if _, err := verifyCRC32C("ciphertext", req.Msg.Ciphertext, req.Msg.CiphertextCrc32C); err != nil {
+		return nil, err
+	}
+	if _, err := verifyCRC32C("additional_authenticated_data", req.Msg.AdditionalAuthenticatedData, req.Msg.AdditionalAuthenticatedDataCrc32C); err != nil {
+		return nil, err
+	}
+	plaintext, id, k, err := m.kmsDecrypt(name, req.Msg.Ciphertext, req.Msg.AdditionalAuthenticatedData)
+	m.audit(ctx, "KMSDecrypt", name, int32(id), err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&kmsv1.DecryptResponse{
+		Plaintext:       plaintext,
+		PlaintextCrc32C: crc32c(plaintext),
+		UsedPrimary:     id == k.Primary,
+		ProtectionLevel: k.ProtectionLevel,
+	}), nil
+}
+
+// loadKMSSigningVersion returns the enabled ASYMMETRIC_SIGN version name
+// refers to.
+func (s *VaultServer) loadKMSSigningVersion(name string) (*kmsCryptoKey, *kmsKeyVersion, error) {
+	keyName, id, err := splitKMSVersionName(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	k, err := s.loadKMSCryptoKey(keyName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if k.Purpose != kmsv1.CryptoKey_ASYMMETRIC_SIGN {
+		return nil, nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s is not an ASYMMETRIC_SIGN key", keyName))
+	}
+	v, err := k.version(keyName, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := v.enabled(name); err != nil {
+		return nil, nil, err
+	}
+	return k, v, nil
+}
+
+func (m *KMSServer) AsymmetricSign(ctx context.Context, req *connect.Request[kmsv1.AsymmetricSignRequest]) (*connect.Response[kmsv1.AsymmetricSignResponse], error) {
+	name := req.Msg.Name
+	slog.Info("AsymmetricSign", "name", name)
+	keyName, id, err := splitKMSVersionName(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.authorize(ctx, "update", "kms/"+keyName); err != nil {
+		return nil, err
+	}
+	if (req.Msg.Digest == nil) == (req.Msg.Data == nil) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("exactly one of digest or data is required"))
+	}
+	verifiedData, err := verifyCRC32C("data", req.Msg.Data, req.Msg.DataCrc32C)
+	if err != nil {
+		return nil, err
+	}
+	k, v, err := m.loadKMSSigningVersion(name)
+	if err != nil {
+		return nil, err
+	}
+	alg := kmsAlgorithms[v.Algorithm]
+
+	var (
+		input          []byte
+		verifiedDigest bool
+		opts           crypto.SignerOpts = alg.hash
+	)
+	switch {
+	case alg.hash == 0:
+		if req.Msg.Data == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s signs data, not a digest", v.Algorithm))
+		}
+		input = req.Msg.Data
+	case req.Msg.Data != nil:
+		input = digest(alg.hash, req.Msg.Data)
+	default:
+		d := req.Msg.Digest
+		input = map[crypto.Hash][]byte{crypto.SHA256: d.GetSha256(), crypto.SHA384: d.GetSha384(), crypto.SHA512: d.GetSha512()}[alg.hash]
+		if len(input) != alg.hash.Size() {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s requires a %s digest", v.Algorithm, alg.hash))
+		}
+		if verifiedDigest, err = verifyCRC32C("digest", input, req.Msg.DigestCrc32C); err != nil {
+			return nil, err
+		}
+	}
+	if alg.pss {
+		opts = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: alg.hash}
+	}
+	signer, err := v.signer()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.Sign(rand.Reader, input, opts)
+	if err != nil {
+		err = connect.NewError(connect.CodeInternal, err)
+	}
+	m.audit(ctx, "AsymmetricSign", keyName, int32(id), err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&kmsv1.AsymmetricSignResponse{
+		Signature:            sig,
+		SignatureCrc32C:      crc32c(sig),
+		VerifiedDigestCrc32C: verifiedDigest,
+		VerifiedDataCrc32C:   verifiedData,
+		Name:                 name,
+		ProtectionLevel:      k.ProtectionLevel,
+	}), nil
+}
+
+func (m *KMSServer) GetPublicKey(ctx context.Context, req *connect.Request[kmsv1.GetPublicKeyRequest]) (*connect.Response[kmsv1.PublicKey], error) {
+	name := req.Msg.Name
+	slog.Info("GetPublicKey", "name", name)
+	keyName, _, err := splitKMSVersionName(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.authorize(ctx, "read", "kms/"+keyName); err != nil {
+		return nil, err
+	}
+	k, v, err := m.loadKMSSigningVersion(name)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := v.signer()
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	return connect.NewResponse(&kmsv1.PublicKey{
+		Pem:             string(pemKey),
+		Algorithm:       v.Algorithm,
+		PemCrc32C:       crc32c(pemKey),
+		Name:            name,
+		ProtectionLevel: k.ProtectionLevel,
+	}), nil
+}
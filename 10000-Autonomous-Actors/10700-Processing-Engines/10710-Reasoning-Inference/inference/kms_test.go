@@ -0,0 +1,217 @@
+package inference
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	kmsv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/kms"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/kms/kmsv1connect"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// newKMSClient serves KeyManagementService over HTTP/2 and talks to it
+// with the gRPC protocol, as the Cloud KMS client libraries do.
+func newKMSClient(t *testing.T, server *VaultServer) kmsv1connect.KeyManagementServiceClient {
+	mux := http.NewServeMux()
+	mux.Handle(kmsv1connect.NewKeyManagementServiceHandler(server.KMS(), connect.WithInterceptors(server.AuthInterceptor())))
+	ts := httptest.NewUnstartedServer(mux)
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	t.Cleanup(ts.Close)
+	return kmsv1connect.NewKeyManagementServiceClient(ts.Client(), ts.URL, connect.WithGRPC())
+}
+
+const kmsTestRing = "projects/olympus/locations/global/keyRings/vault"
+
+func TestKMSEncryptDecrypt(t *testing.T) {
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	server, _, root := newTestClient(t, WithClock(func() time.Time { return clock }))
+	kms := newKMSClient(t, server)
+	ctx := context.Background()
+
+	if _, err := kms.CreateKeyRing(ctx, withToken(&kmsv1.CreateKeyRingRequest{Parent: "projects/olympus/locations/global", KeyRingId: "vault"}, root)); err != nil {
+		t.Fatalf("CreateKeyRing: %v", err)
+	}
+	if _, err := kms.CreateKeyRing(ctx, withToken(&kmsv1.CreateKeyRingRequest{Parent: "projects/olympus/locations/global", KeyRingId: "vault"}, root)); connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Errorf("duplicate CreateKeyRing: expected AlreadyExists, got %v", err)
+	}
+	key, err := kms.CreateCryptoKey(ctx, withToken(&kmsv1.CreateCryptoKeyRequest{
+		Parent:      kmsTestRing,
+		CryptoKeyId: "secrets",
+		CryptoKey: &kmsv1.CryptoKey{
+			Purpose:                  kmsv1.CryptoKey_ENCRYPT_DECRYPT,
+			DestroyScheduledDuration: durationpb.New(24 * time.Hour),
+		},
+	}, root))
+	if err != nil {
+		t.Fatalf("CreateCryptoKey: %v", err)
+	}
+	keyName := kmsTestRing + "/cryptoKeys/secrets"
+	if key.Msg.Primary.GetName() != keyName+"/cryptoKeyVersions/1" || key.Msg.VersionTemplate.Algorithm != kmsv1.CryptoKeyVersion_GOOGLE_SYMMETRIC_ENCRYPTION {
+		t.Errorf("created key = %+v", key.Msg)
+	}
+
+	aad := []byte("app/db")
+	enc, err := kms.Encrypt(ctx, withToken(&kmsv1.EncryptRequest{Name: keyName, Plaintext: []byte("hunter2"), AdditionalAuthenticatedData: aad, PlaintextCrc32C: crc32c([]byte("hunter2"))}, root))
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	if !enc.Msg.VerifiedPlaintextCrc32C || enc.Msg.CiphertextCrc32C.GetValue() != crc32c(enc.Msg.Ciphertext).Value {
+		t.Errorf("checksums not handled: %+v", enc.Msg)
+	}
+	if _, err := kms.Encrypt(ctx, withToken(&kmsv1.EncryptRequest{Name: keyName, Plaintext: []byte("x"), PlaintextCrc32C: crc32c([]byte("y"))}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("Encrypt with bad checksum: expected InvalidArgument, got %v", err)
+	}
+	decrypt := func(ct []byte) (*kmsv1.DecryptResponse, error) {
+		res, err := kms.Decrypt(ctx, withToken(&kmsv1.DecryptRequest{Name: keyName, Ciphertext: ct, AdditionalAuthenticatedData: aad}, root))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}
+	if dec, err := decrypt(enc.Msg.Ciphertext); err != nil || string(dec.Plaintext) != "hunter2" || !dec.UsedPrimary {
+		t.Fatalf("Decrypt = %+v, %v", dec, err)
+	}
+	if _, err := kms.Decrypt(ctx, withToken(&kmsv1.DecryptRequest{Name: keyName, Ciphertext: enc.Msg.Ciphertext}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("Decrypt without AAD: expected InvalidArgument, got %v", err)
+	}
+
+	// A new primary encrypts from now on; version 1 still decrypts.
+	v2, err := kms.CreateCryptoKeyVersion(ctx, withToken(&kmsv1.CreateCryptoKeyVersionRequest{Parent: keyName}, root))
+	if err != nil {
+		t.Fatalf("CreateCryptoKeyVersion: %v", err)
+	}
+	if _, err := kms.UpdateCryptoKeyPrimaryVersion(ctx, withToken(&kmsv1.UpdateCryptoKeyPrimaryVersionRequest{Name: keyName, CryptoKeyVersionId: "2"}, root)); err != nil {
+		t.Fatalf("UpdateCryptoKeyPrimaryVersion: %v", err)
+	}
+	enc2, _ := kms.Encrypt(ctx, withToken(&kmsv1.EncryptRequest{Name: keyName, Plaintext: []byte("new")}, root))
+	if enc2.Msg.Name != v2.Msg.Name {
+		t.Errorf("encrypted with %s, want new primary %s", enc2.Msg.Name, v2.Msg.Name)
+	}
+	if dec, err := decrypt(enc.Msg.Ciphertext); err != nil || dec.UsedPrimary {
+		t.Errorf("Decrypt with old version = %+v, %v", dec, err)
+	}
+
+	// Disabled and destroyed versions refuse to decrypt.
+	v1 := keyName + "/cryptoKeyVersions/1"
+	if _, err := kms.UpdateCryptoKeyVersion(ctx, withToken(&kmsv1.UpdateCryptoKeyVersionRequest{
+		CryptoKeyVersion: &kmsv1.CryptoKeyVersion{Name: v1, State: kmsv1.CryptoKeyVersion_DISABLED},
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+	}, root)); err != nil {
+		t.Fatalf("UpdateCryptoKeyVersion: %v", err)
+	}
+	if _, err := decrypt(enc.Msg.Ciphertext); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Decrypt with disabled version: expected FailedPrecondition, got %v", err)
+	}
+	destroyed, err := kms.DestroyCryptoKeyVersion(ctx, withToken(&kmsv1.DestroyCryptoKeyVersionRequest{Name: v1}, root))
+	if err != nil || destroyed.Msg.State != kmsv1.CryptoKeyVersion_DESTROY_SCHEDULED || !destroyed.Msg.DestroyTime.AsTime().Equal(clock.Add(24*time.Hour)) {
+		t.Fatalf("DestroyCryptoKeyVersion = %+v, %v", destroyed, err)
+	}
+	if res, err := kms.RestoreCryptoKeyVersion(ctx, withToken(&kmsv1.RestoreCryptoKeyVersionRequest{Name: v1}, root)); err != nil || res.Msg.State != kmsv1.CryptoKeyVersion_DISABLED {
+		t.Errorf("RestoreCryptoKeyVersion = %+v, %v", res, err)
+	}
+	kms.DestroyCryptoKeyVersion(ctx, withToken(&kmsv1.DestroyCryptoKeyVersionRequest{Name: v1}, root))
+	clock = clock.Add(25 * time.Hour)
+	got, _ := kms.GetCryptoKeyVersion(ctx, withToken(&kmsv1.GetCryptoKeyVersionRequest{Name: v1}, root))
+	if got.Msg.State != kmsv1.CryptoKeyVersion_DESTROYED || got.Msg.DestroyEventTime == nil {
+		t.Errorf("version past its destroy time = %+v", got.Msg)
+	}
+	if _, err := kms.RestoreCryptoKeyVersion(ctx, withToken(&kmsv1.RestoreCryptoKeyVersionRequest{Name: v1}, root)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("restoring a destroyed version: expected FailedPrecondition, got %v", err)
+	}
+	k, _ := server.loadKMSCryptoKey(keyName)
+	if k.Versions[0].Key != nil {
+		t.Error("destroyed version still holds key material")
+	}
+
+	// Listing pages through the key's versions.
+	page, err := kms.ListCryptoKeyVersions(ctx, withToken(&kmsv1.ListCryptoKeyVersionsRequest{Parent: keyName, PageSize: 1}, root))
+	if err != nil || len(page.Msg.CryptoKeyVersions) != 1 || page.Msg.TotalSize != 2 || page.Msg.NextPageToken == "" {
+		t.Fatalf("ListCryptoKeyVersions = %+v, %v", page, err)
+	}
+	page, _ = kms.ListCryptoKeyVersions(ctx, withToken(&kmsv1.ListCryptoKeyVersionsRequest{Parent: keyName, PageSize: 1, PageToken: page.Msg.NextPageToken}, root))
+	if len(page.Msg.CryptoKeyVersions) != 1 || page.Msg.CryptoKeyVersions[0].Name != v2.Msg.Name || page.Msg.NextPageToken != "" {
+		t.Errorf("second page = %+v", page.Msg)
+	}
+}
+
+func TestKMSAsymmetricSign(t *testing.T) {
+	server, _, root := newTestClient(t)
+	kms := newKMSClient(t, server)
+	ctx := context.Background()
+	kms.CreateKeyRing(ctx, withToken(&kmsv1.CreateKeyRingRequest{Parent: "projects/olympus/locations/global", KeyRingId: "vault"}, root))
+
+	create := func(id string, alg kmsv1.CryptoKeyVersion_CryptoKeyVersionAlgorithm) string {
+		t.Helper()
+		_, err := kms.CreateCryptoKey(ctx, withToken(&kmsv1.CreateCryptoKeyRequest{
+			Parent:      kmsTestRing,
+			CryptoKeyId: id,
+			CryptoKey: &kmsv1.CryptoKey{
+				Purpose:         kmsv1.CryptoKey_ASYMMETRIC_SIGN,
+				VersionTemplate: &kmsv1.CryptoKeyVersionTemplate{Algorithm: alg},
+			},
+		}, root))
+		if err != nil {
+			t.Fatalf("CreateCryptoKey(%s): %v", id, err)
+		}
+		return kmsTestRing + "/cryptoKeys/" + id + "/cryptoKeyVersions/1"
+	}
+	publicKey := func(name string) any {
+		t.Helper()
+		res, err := kms.GetPublicKey(ctx, withToken(&kmsv1.GetPublicKeyRequest{Name: name}, root))
+		if err != nil {
+			t.Fatalf("GetPublicKey: %v", err)
+		}
+		if res.Msg.PemCrc32C.GetValue() != crc32c([]byte(res.Msg.Pem)).Value {
+			t.Error("pem_crc32c mismatch")
+		}
+		block, _ := pem.Decode([]byte(res.Msg.Pem))
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			t.Fatalf("parsing public key: %v", err)
+		}
+		return key
+	}
+	artifact := []byte("release-2026.10.tar.gz")
+
+	ec := create("release", kmsv1.CryptoKeyVersion_EC_SIGN_P256_SHA256)
+	sum := sha256.Sum256(artifact)
+	res, err := kms.AsymmetricSign(ctx, withToken(&kmsv1.AsymmetricSignRequest{
+		Name:         ec,
+		Digest:       &kmsv1.Digest{Digest: &kmsv1.Digest_Sha256{Sha256: sum[:]}},
+		DigestCrc32C: crc32c(sum[:]),
+	}, root))
+	if err != nil {
+		t.Fatalf("AsymmetricSign: %v", err)
+	}
+	if !res.Msg.VerifiedDigestCrc32C || !ecdsa.VerifyASN1(publicKey(ec).(*ecdsa.PublicKey), sum[:], res.Msg.Signature) {
+		t.Error("ECDSA signature does not verify")
+	}
+	if _, err := kms.AsymmetricSign(ctx, withToken(&kmsv1.AsymmetricSignRequest{Name: ec, Digest: &kmsv1.Digest{Digest: &kmsv1.Digest_Sha512{Sha512: make([]byte, 64)}}}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("signing the wrong digest: expected InvalidArgument, got %v", err)
+	}
+
+	ed := create("attest", kmsv1.CryptoKeyVersion_EC_SIGN_ED25519)
+	res, err = kms.AsymmetricSign(ctx, withToken(&kmsv1.AsymmetricSignRequest{Name: ed, Data: artifact}, root))
+	if err != nil || !ed25519.Verify(publicKey(ed).(ed25519.PublicKey), artifact, res.Msg.Signature) {
+		t.Errorf("Ed25519 AsymmetricSign = %v, %v", res, err)
+	}
+
+	if _, err := kms.Encrypt(ctx, withToken(&kmsv1.EncryptRequest{Name: kmsTestRing + "/cryptoKeys/attest", Plaintext: artifact}, root)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Encrypt with a signing key: expected FailedPrecondition, got %v", err)
+	}
+	list, err := kms.ListCryptoKeys(ctx, withToken(&kmsv1.ListCryptoKeysRequest{Parent: kmsTestRing}, root))
+	if err != nil || len(list.Msg.CryptoKeys) != 2 || list.Msg.CryptoKeys[0].Name != kmsTestRing+"/cryptoKeys/attest" {
+		t.Errorf("ListCryptoKeys = %+v, %v", list, err)
+	}
+}
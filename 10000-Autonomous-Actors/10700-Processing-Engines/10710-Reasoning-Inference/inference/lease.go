@@ -0,0 +1,329 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	maxLeaseTTL          = 30 * 24 * time.Hour
+	leaseRetryBackoff    = 10 * time.Second
+	maxLeaseRetryBackoff = time.Hour
+	// maxLeaseWait bounds how long the expiration scheduler sleeps, so
+	// leases written by another process are still picked up.
+	maxLeaseWait = time.Minute
+)
+
+// leaseEngine holds the callbacks a secrets engine registers for the
+// credentials it leases out. renew may be nil when renewal needs no work
+// on the engine's side.
+type leaseEngine struct {
+	revoke func(s *VaultServer, ctx context.Context, data json.RawMessage) error
+	renew  func(s *VaultServer, ctx context.Context, data json.RawMessage, expire time.Time) error
+}
+
+var leaseEngines = map[string]leaseEngine{
+	"database": {revoke: (*VaultServer).revokeDatabaseUser, renew: (*VaultServer).renewDatabaseUser},
+	"token":    {revoke: (*VaultServer).revokeTokenLease, renew: (*VaultServer).renewTokenLease},
+}
+
+// leaseEntry is keyed by lease id, which starts with the path the
+// credential was issued from so whole engines or roles can be revoked by
+// prefix.
+type leaseEntry struct {
+	Engine      string          `json:"engine"`
+	IssueTime   time.Time       `json:"issue_time"`
+	ExpireTime  time.Time       `json:"expire_time"`
+	MaxExpire   time.Time       `json:"max_expire_time"`
+	TTL         int64           `json:"ttl"`
+	Renewable   bool            `json:"renewable"`
+	Data        json.RawMessage `json:"data"`
+	Attempts    int32           `json:"revoke_attempts,omitempty"`
+	NextAttempt time.Time       `json:"next_attempt,omitzero"`
+	LastError   string          `json:"last_error,omitempty"`
+}
+
+func (l *leaseEntry) proto(id string) *vaultv1.Lease {
+	return &vaultv1.Lease{
+		LeaseId:        id,
+		IssueTime:      l.IssueTime.Unix(),
+		ExpireTime:     l.ExpireTime.Unix(),
+		MaxExpireTime:  l.MaxExpire.Unix(),
+		Renewable:      l.Renewable,
+		RevokeAttempts: l.Attempts,
+		LastError:      l.LastError,
+	}
+}
+
+// due is when the expiration scheduler should next try to revoke l.
+func (l *leaseEntry) due() time.Time {
+	if l.NextAttempt.After(l.ExpireTime) {
+		return l.NextAttempt
+	}
+	return l.ExpireTime
+}
+
+func getLease(tx *bbolt.Tx, id string) (*leaseEntry, error) {
+	data := tx.Bucket([]byte(bucketLeases)).Get([]byte(id))
+	if data == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("lease not found: %s", id))
+	}
+	var l leaseEntry
+	if err := json.Unmarshal(data, &l); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &l, nil
+}
+
+func putLease(tx *bbolt.Tx, id string, l *leaseEntry) error {
+	data, _ := json.Marshal(l)
+	return tx.Bucket([]byte(bucketLeases)).Put([]byte(id), data)
+}
+
+// createLease records a lease on credentials an engine has just issued.
+// Callers should wakeLeases once their transaction commits.
+func (s *VaultServer) createLease(tx *bbolt.Tx, id, engine string, data any, ttl, maxTTL time.Duration, renewable bool) (*leaseEntry, error) {
+	if maxTTL <= 0 || maxTTL > maxLeaseTTL {
+		maxTTL = maxLeaseTTL
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	ttl = min(ttl, maxTTL)
+	l := &leaseEntry{
+		Engine:     engine,
+		IssueTime:  now,
+		ExpireTime: now.Add(ttl),
+		MaxExpire:  now.Add(maxTTL),
+		TTL:        int64(ttl / time.Second),
+		Renewable:  renewable,
+		Data:       raw,
+	}
+	return l, putLease(tx, id, l)
+}
+
+// wakeLeases tells the expiration scheduler its next deadline may have
+// moved.
+func (s *VaultServer) wakeLeases() {
+	select {
+	case s.leaseWake <- struct{}{}:
+	default:
+	}
+}
+
+// revokeLease calls the engine's revoke callback and drops the lease. On
+// failure the lease is marked expired and retried with backoff by the
+// expiration scheduler.
+func (s *VaultServer) revokeLease(ctx context.Context, id string, l *leaseEntry) error {
+	engine, ok := leaseEngines[l.Engine]
+	err := fmt.Errorf("unknown lease engine %q", l.Engine)
+	if ok {
+		err = engine.revoke(s, ctx, l.Data)
+	}
+	s.record(ctx, auditEntry{Operation: "RevokeLease", Key: id}, err)
+	if err != nil {
+		now := s.now()
+		l.Attempts++
+		l.LastError = err.Error()
+		if l.ExpireTime.After(now) {
+			l.ExpireTime = now
+		}
+		l.NextAttempt = now.Add(min(leaseRetryBackoff<<min(l.Attempts-1, 16), maxLeaseRetryBackoff))
+		s.db.Update(func(tx *bbolt.Tx) error {
+			if tx.Bucket([]byte(bucketLeases)).Get([]byte(id)) == nil {
+				return nil
+			}
+			return putLease(tx, id, l)
+		})
+		s.wakeLeases()
+		return err
+	}
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketLeases)).Delete([]byte(id))
+	})
+}
+
+func (s *VaultServer) RenewLease(ctx context.Context, req *connect.Request[vaultv1.RenewLeaseRequest]) (*connect.Response[vaultv1.Lease], error) {
+	id := req.Msg.LeaseId
+	slog.Info("RenewLease", "lease", id, "increment", req.Msg.IncrementSeconds)
+	if err := s.authorize(ctx, "update", "sys/leases/renew/"+id); err != nil {
+		return nil, err
+	}
+	var l *leaseEntry
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		l, err = getLease(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	if !now.Before(l.ExpireTime) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("lease expired: %s", id))
+	}
+	if !l.Renewable {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("lease is not renewable"))
+	}
+
+	increment := time.Duration(req.Msg.IncrementSeconds) * time.Second
+	if increment <= 0 {
+		increment = time.Duration(l.TTL) * time.Second
+	}
+	expire := now.Add(increment)
+	if expire.After(l.MaxExpire) {
+		expire = l.MaxExpire
+	}
+	if engine := leaseEngines[l.Engine]; engine.renew != nil {
+		if err := engine.renew(s, ctx, l.Data, expire); err != nil {
+			err = connect.NewError(connect.CodeUnavailable, fmt.Errorf("renewing %s: %w", id, err))
+			s.audit(ctx, "RenewLease", id, 0, err)
+			return nil, err
+		}
+	}
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		cur, err := getLease(tx, id)
+		if err != nil {
+			return err
+		}
+		cur.ExpireTime = expire
+		l = cur
+		if err := putLease(tx, id, cur); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	})
+	s.audit(ctx, "RenewLease", id, 0, err)
+	if err != nil {
+		return nil, err
+	}
+	s.wakeLeases()
+	return connect.NewResponse(l.proto(id)), nil
+}
+
+func (s *VaultServer) RevokeLease(ctx context.Context, req *connect.Request[vaultv1.RevokeLeaseRequest]) (*connect.Response[vaultv1.RevokeLeaseResponse], error) {
+	id := req.Msg.LeaseId
+	slog.Info("RevokeLease", "lease", id)
+	if err := s.authorize(ctx, "update", "sys/leases/revoke/"+id); err != nil {
+		return nil, err
+	}
+	var l *leaseEntry
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		l, err = getLease(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.revokeLease(ctx, id, l); err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	return connect.NewResponse(&vaultv1.RevokeLeaseResponse{}), nil
+}
+
+// RevokePrefix revokes every lease issued under prefix, e.g. all
+// credentials of one database role or, with "auth/token/", every token
+// that expires.
+func (s *VaultServer) RevokePrefix(ctx context.Context, req *connect.Request[vaultv1.RevokePrefixRequest]) (*connect.Response[vaultv1.RevokePrefixResponse], error) {
+	prefix := req.Msg.Prefix
+	slog.Info("RevokePrefix", "prefix", prefix)
+	if prefix == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("prefix is required"))
+	}
+	if err := s.authorizeSudo(ctx, "sys/leases/revoke-prefix/"+prefix); err != nil {
+		return nil, err
+	}
+	leases, err := s.scanLeases(func(id string, _ *leaseEntry) bool { return strings.HasPrefix(id, prefix) })
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := &vaultv1.RevokePrefixResponse{}
+	for id, l := range leases {
+		if err := s.revokeLease(ctx, id, l); err != nil {
+			res.Failed = append(res.Failed, id)
+			continue
+		}
+		res.Revoked++
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *VaultServer) scanLeases(match func(id string, l *leaseEntry) bool) (map[string]*leaseEntry, error) {
+	leases := map[string]*leaseEntry{}
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketLeases)).ForEach(func(k, v []byte) error {
+			var l leaseEntry
+			if err := json.Unmarshal(v, &l); err != nil {
+				return err
+			}
+			if match(string(k), &l) {
+				leases[string(k)] = &l
+			}
+			return nil
+		})
+	})
+	return leases, err
+}
+
+// RevokeExpiredLeases revokes every lease past its expiry whose retry
+// backoff has elapsed.
+func (s *VaultServer) RevokeExpiredLeases(ctx context.Context) (int, error) {
+	now := s.now()
+	leases, err := s.scanLeases(func(_ string, l *leaseEntry) bool { return !now.Before(l.due()) })
+	if err != nil {
+		return 0, err
+	}
+	revoked := 0
+	for id, l := range leases {
+		if err := s.revokeLease(ctx, id, l); err != nil {
+			slog.Warn("Lease revocation failed", "lease", id, "attempts", l.Attempts, "error", err)
+			continue
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+// RunLeaseExpiry revokes leases as they expire until ctx is done. It
+// sleeps until the earliest deadline, waking early when leases change.
+func (s *VaultServer) RunLeaseExpiry(ctx context.Context) {
+	for {
+		wait := maxLeaseWait
+		leases, err := s.scanLeases(func(string, *leaseEntry) bool { return true })
+		if err != nil {
+			slog.Error("Lease scan failed", "error", err)
+		}
+		for _, l := range leases {
+			wait = min(wait, max(l.due().Sub(s.now()), 0))
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-s.leaseWake:
+			timer.Stop()
+			continue
+		case <-timer.C:
+		}
+		if n, err := s.RevokeExpiredLeases(ctx); err != nil {
+			slog.Error("Lease expiry failed", "error", err)
+		} else if n > 0 {
+			slog.Info("Revoked expired leases", "leases", n)
+		}
+	}
+}
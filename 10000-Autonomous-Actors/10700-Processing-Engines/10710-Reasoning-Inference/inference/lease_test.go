@@ -0,0 +1,256 @@
+package inference
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+)
+
+// memoryDatabase tracks users by name and logs the statements it is given
+// without running them.
+type memoryDatabase struct {
+	mu    sync.Mutex
+	users map[string]string
+	log   []string
+}
+
+func (d *memoryDatabase) CreateUser(_ context.Context, statements []string, username, password string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.users[username] = password
+	d.log = append(d.log, statements...)
+	return nil
+}
+
+func (d *memoryDatabase) RenewUser(_ context.Context, statements []string, username string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.users[username]; !ok {
+		return fmt.Errorf("user %s does not exist", username)
+	}
+	d.log = append(d.log, statements...)
+	return nil
+}
+
+func (d *memoryDatabase) RevokeUser(_ context.Context, statements []string, username string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.users, username)
+	d.log = append(d.log, statements...)
+	return nil
+}
+
+func (d *memoryDatabase) Close() error { return nil }
+
+// flakyDatabase fails revocations while down is set.
+type flakyDatabase struct {
+	memoryDatabase
+	down bool
+}
+
+func (d *flakyDatabase) RevokeUser(ctx context.Context, statements []string, username string) error {
+	if d.down {
+		return errors.New("connection refused")
+	}
+	return d.memoryDatabase.RevokeUser(ctx, statements, username)
+}
+
+func TestLeaseLifecycle(t *testing.T) {
+	flaky := &flakyDatabase{memoryDatabase: memoryDatabase{users: map[string]string{}}}
+	RegisterDatabasePlugin("flaky-test", func(string) (DatabaseDriver, error) { return flaky, nil })
+
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	server, client, root := newTestClient(t, WithClock(func() time.Time { return clock }))
+	ctx := context.Background()
+
+	client.PutDatabaseConfig(ctx, withToken(&vaultv1.PutDatabaseConfigRequest{Config: &vaultv1.DatabaseConfig{Name: "app", Plugin: "flaky-test", AllowedRoles: []string{"*"}}}, root))
+	for _, name := range []string{"readonly", "admin"} {
+		client.PutDatabaseRole(ctx, withToken(&vaultv1.PutDatabaseRoleRequest{Role: &vaultv1.DatabaseRole{
+			Name:                 name,
+			DbName:               "app",
+			CreationStatements:   []string{`CREATE USER "{{name}}"`},
+			RenewStatements:      []string{`ALTER USER "{{name}}" VALID UNTIL '{{expiration}}'`},
+			RevocationStatements: []string{`DROP USER "{{name}}"`},
+			DefaultTtlSeconds:    60,
+			MaxTtlSeconds:        300,
+		}}, root))
+	}
+	issue := func(role string) *vaultv1.DatabaseCredentials {
+		t.Helper()
+		res, err := client.GetDatabaseCredentials(ctx, withToken(&vaultv1.GetDatabaseCredentialsRequest{Role: role}, root))
+		if err != nil {
+			t.Fatalf("GetDatabaseCredentials(%s): %v", role, err)
+		}
+		return res.Msg
+	}
+	ro1, ro2, admin := issue("readonly"), issue("readonly"), issue("admin")
+
+	// Renewal extends from now and stops at the max TTL.
+	clock = clock.Add(30 * time.Second)
+	res, err := client.RenewLease(ctx, withToken(&vaultv1.RenewLeaseRequest{LeaseId: ro1.LeaseId, IncrementSeconds: 600}, root))
+	if err != nil {
+		t.Fatalf("RenewLease: %v", err)
+	}
+	if res.Msg.ExpireTime != res.Msg.MaxExpireTime || res.Msg.MaxExpireTime != ro1.ExpireTime+240 {
+		t.Errorf("renewed lease = %+v, want capped at issue + 300s", res.Msg)
+	}
+	if got := flaky.log[len(flaky.log)-1]; got != `ALTER USER "`+ro1.Username+`" VALID UNTIL '2026-10-19 12:05:00Z'` {
+		t.Errorf("renew statement = %q", got)
+	}
+
+	// Leases survive a restart.
+	dir := filepath.Dir(server.db.Path())
+	server.Close()
+	server = NewVaultServer(dir, WithClock(func() time.Time { return clock }))
+	t.Cleanup(func() { server.Close() })
+	client = serveTestClient(t, server)
+
+	// ro2 and admin expired at +60s; ro1 was renewed.
+	clock = clock.Add(45 * time.Second)
+	if n, err := server.RevokeExpiredLeases(ctx); err != nil || n != 2 {
+		t.Fatalf("RevokeExpiredLeases = %d, %v; want 2", n, err)
+	}
+	if _, ok := flaky.users[ro2.Username]; ok {
+		t.Error("expired readonly user not dropped")
+	}
+	if _, ok := flaky.users[admin.Username]; ok {
+		t.Error("expired admin user not dropped")
+	}
+	if _, ok := flaky.users[ro1.Username]; !ok {
+		t.Error("renewed user dropped")
+	}
+
+	// A failed revocation keeps the lease, expires it, and is retried.
+	flaky.down = true
+	if _, err := server.RevokeLease(ctx, connect.NewRequest(&vaultv1.RevokeLeaseRequest{LeaseId: ro1.LeaseId})); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("RevokeLease while database down: expected Unavailable, got %v", err)
+	}
+	if _, err := server.RenewLease(ctx, connect.NewRequest(&vaultv1.RenewLeaseRequest{LeaseId: ro1.LeaseId})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("renewing a lease pending revocation: expected NotFound, got %v", err)
+	}
+	if n, _ := server.RevokeExpiredLeases(ctx); n != 0 {
+		t.Error("retried before backoff elapsed")
+	}
+	flaky.down = false
+	clock = clock.Add(leaseRetryBackoff)
+	if n, _ := server.RevokeExpiredLeases(ctx); n != 1 {
+		t.Error("failed revocation not retried")
+	}
+	if _, err := server.RevokeLease(ctx, connect.NewRequest(&vaultv1.RevokeLeaseRequest{LeaseId: ro1.LeaseId})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("revoked lease still present: %v", err)
+	}
+
+	// RevokePrefix only touches leases under the prefix.
+	for _, role := range []string{"readonly", "readonly", "admin"} {
+		issue(role)
+	}
+	pre, err := client.RevokePrefix(ctx, withToken(&vaultv1.RevokePrefixRequest{Prefix: "database/creds/readonly/"}, root))
+	if err != nil || pre.Msg.Revoked != 2 || len(pre.Msg.Failed) != 0 {
+		t.Fatalf("RevokePrefix = %+v, %v", pre, err)
+	}
+	if len(flaky.users) != 1 {
+		t.Errorf("expected only the admin user left, got %v", flaky.users)
+	}
+	if !strings.HasPrefix(ro1.LeaseId, "database/creds/readonly/") {
+		t.Errorf("lease id %s not under its issue path", ro1.LeaseId)
+	}
+}
+
+func TestRunLeaseExpiry(t *testing.T) {
+	server, client, root := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go server.RunLeaseExpiry(ctx)
+
+	url, db := newSQLiteDatabase(t)
+	client.PutDatabaseConfig(ctx, withToken(&vaultv1.PutDatabaseConfigRequest{Config: &vaultv1.DatabaseConfig{Name: "app", Plugin: "embedded", ConnectionUrl: url, AllowedRoles: []string{"*"}}}, root))
+	client.PutDatabaseRole(ctx, withToken(&vaultv1.PutDatabaseRoleRequest{Role: &vaultv1.DatabaseRole{
+		Name:                 "short",
+		DbName:               "app",
+		CreationStatements:   []string{sqliteCreateUser},
+		RevocationStatements: []string{sqliteDropUser},
+		DefaultTtlSeconds:    1,
+	}}, root))
+	res, err := client.GetDatabaseCredentials(ctx, withToken(&vaultv1.GetDatabaseCredentialsRequest{Role: "short"}, root))
+	if err != nil {
+		t.Fatalf("GetDatabaseCredentials: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if _, live := sqliteUser(t, db, res.Msg.Username); !live {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatal("scheduler did not revoke the expired lease")
+}
+
+func TestTokenLeases(t *testing.T) {
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	server, client, root := newTestClient(t, WithClock(func() time.Time { return clock }))
+	ctx := context.Background()
+
+	if info, _ := client.LookupToken(ctx, withToken(&vaultv1.LookupTokenRequest{}, root)); info.Msg.LeaseId != "" {
+		t.Errorf("root token has lease %s", info.Msg.LeaseId)
+	}
+	create := func(req *vaultv1.CreateTokenRequest, token string) *vaultv1.CreateTokenResponse {
+		res, err := client.CreateToken(ctx, withToken(req, token))
+		if err != nil {
+			t.Fatalf("CreateToken: %v", err)
+		}
+		return res.Msg
+	}
+	svc := create(&vaultv1.CreateTokenRequest{TtlSeconds: 60, Renewable: true, DisplayName: "svc"}, root)
+	child := create(&vaultv1.CreateTokenRequest{TtlSeconds: 60}, svc.Token)
+	short := create(&vaultv1.CreateTokenRequest{TtlSeconds: 10, DisplayName: "short"}, root)
+	if svc.Info.LeaseId != tokenLeasePrefix+svc.Info.Accessor {
+		t.Fatalf("lease id = %q", svc.Info.LeaseId)
+	}
+
+	// Renewing the lease renews the token, and the other way round.
+	res, err := client.RenewLease(ctx, withToken(&vaultv1.RenewLeaseRequest{LeaseId: svc.Info.LeaseId, IncrementSeconds: 120}, root))
+	if err != nil {
+		t.Fatalf("RenewLease: %v", err)
+	}
+	info, _ := client.LookupToken(ctx, withToken(&vaultv1.LookupTokenRequest{}, svc.Token))
+	if info.Msg.ExpireTime != res.Msg.ExpireTime || res.Msg.ExpireTime != clock.Add(120*time.Second).Unix() {
+		t.Errorf("token expires at %d, lease at %d", info.Msg.ExpireTime, res.Msg.ExpireTime)
+	}
+	renewed, _ := client.RenewToken(ctx, withToken(&vaultv1.RenewTokenRequest{IncrementSeconds: 300}, svc.Token))
+	if leases, _ := server.scanLeases(func(id string, _ *leaseEntry) bool { return id == svc.Info.LeaseId }); leases[svc.Info.LeaseId].ExpireTime.Unix() != renewed.Msg.ExpireTime {
+		t.Errorf("lease not moved with RenewToken to %d", renewed.Msg.ExpireTime)
+	}
+	if _, err := client.RenewLease(ctx, withToken(&vaultv1.RenewLeaseRequest{LeaseId: short.Info.LeaseId}, root)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("renewing a non-renewable token's lease: expected FailedPrecondition, got %v", err)
+	}
+
+	// The scheduler revokes expired tokens.
+	clock = clock.Add(10 * time.Second)
+	if n, err := server.RevokeExpiredLeases(ctx); err != nil || n != 1 {
+		t.Fatalf("RevokeExpiredLeases = %d, %v; want 1", n, err)
+	}
+	if _, err := client.LookupToken(ctx, withToken(&vaultv1.LookupTokenRequest{Accessor: short.Info.Accessor}, root)); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("expired token still present: %v", err)
+	}
+
+	// Revoking by prefix takes the children too, whichever lease goes first.
+	revoked, err := client.RevokePrefix(ctx, withToken(&vaultv1.RevokePrefixRequest{Prefix: tokenLeasePrefix}, root))
+	if err != nil || revoked.Msg.Revoked != 2 {
+		t.Fatalf("RevokePrefix = %v, %v; want 2", revoked, err)
+	}
+	if _, err := client.LookupToken(ctx, withToken(&vaultv1.LookupTokenRequest{}, child.Token)); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("child token survived its parent's lease: %v", err)
+	}
+	if leases, _ := server.scanLeases(func(string, *leaseEntry) bool { return true }); len(leases) != 0 {
+		t.Errorf("leases left behind: %v", leases)
+	}
+}
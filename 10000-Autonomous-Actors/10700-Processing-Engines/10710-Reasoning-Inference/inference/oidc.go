@@ -0,0 +1,471 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"cmp"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// OIDCPath is where VaultManager serves the discovery document and
+	// JWKS; configure the issuer as the vault's address plus this path.
+	OIDCPath = "/v1/identity/oidc"
+
+	oidcConfigKey     = "config"
+	oidcKeysKey       = "keys"
+	oidcDefaultAlg    = "RS256"
+	oidcDefaultPeriod = 24 * time.Hour
+	oidcDefaultTTL    = time.Hour
+)
+
+// oidcAlgorithms maps each JWS algorithm to the key type that signs it.
+var oidcAlgorithms = map[string]string{
+	"RS256": "rsa-2048",
+	"ES256": "ecdsa-p256",
+	"EdDSA": "ed25519",
+}
+
+// oidcReservedClaims are set by the vault and cannot come from templates.
+var oidcReservedClaims = []string{"iss", "sub", "aud", "exp", "iat", "nbf", "jti"}
+
+var claimPlaceholder = regexp.MustCompile(`\{\{\s*identity\.(\w+)\s*\}\}`)
+
+// OIDCServer serves OIDCService from the vault's storage.
+type OIDCServer struct {
+	*VaultServer
+}
+
+// OIDC returns the OIDCService handler backed by s.
+func (s *VaultServer) OIDC() *OIDCServer {
+	return &OIDCServer{s}
+}
+
+type oidcConfig struct {
+	Issuer          string `json:"issuer"`
+	Algorithm       string `json:"algorithm"`
+	RotationPeriod  int64  `json:"rotation_period"`
+	VerificationTTL int64  `json:"verification_ttl"`
+}
+
+// oidcKey is a signing key; once rotated out it stays in the JWKS until
+// RetireTime so tokens it signed can still be verified.
+type oidcKey struct {
+	ID         string    `json:"id"`
+	Key        []byte    `json:"key"`
+	CreateTime time.Time `json:"create_time"`
+	RetireTime time.Time `json:"retire_time,omitzero"`
+}
+
+func (k *oidcKey) signer() (crypto.Signer, error) {
+	key, err := x509.ParsePKCS8PrivateKey(k.Key)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return key.(crypto.Signer), nil
+}
+
+type oidcRole struct {
+	ClientID string `json:"client_id"`
+	Template string `json:"template,omitempty"`
+	TTL      int64  `json:"ttl"`
+}
+
+// claimVars are the values claim templates may reference.
+func claimVars(c *Caller) map[string]any {
+	return map[string]any{
+		"name":           c.Identity,
+		"source":         c.Source,
+		"policies":       append([]string{}, c.Policies...),
+		"token_accessor": c.TokenAccessor,
+	}
+}
+
+// renderClaims expands a role's claims template for the caller.
+func renderClaims(tmpl string, c *Caller) (map[string]any, error) {
+	claims := map[string]any{}
+	if tmpl == "" {
+		return claims, nil
+	}
+	if err := json.Unmarshal([]byte(tmpl), &claims); err != nil {
+		return nil, fmt.Errorf("template is not a JSON object: %w", err)
+	}
+	for _, name := range oidcReservedClaims {
+		if _, ok := claims[name]; ok {
+			return nil, fmt.Errorf("template cannot set the %q claim", name)
+		}
+	}
+	vars := claimVars(c)
+	for k, v := range claims {
+		var err error
+		if claims[k], err = renderClaim(v, vars); err != nil {
+			return nil, err
+		}
+	}
+	return claims, nil
+}
+
+func renderClaim(v any, vars map[string]any) (any, error) {
+	switch v := v.(type) {
+	case string:
+		if m := claimPlaceholder.FindStringSubmatch(v); m != nil && m[0] == v {
+			if val, ok := vars[m[1]]; ok {
+				return val, nil
+			}
+		}
+		var err error
+		out := claimPlaceholder.ReplaceAllStringFunc(v, func(p string) string {
+			name := claimPlaceholder.FindStringSubmatch(p)[1]
+			switch val := vars[name].(type) {
+			case string:
+				return val
+			case []string:
+				return strings.Join(val, ",")
+			}
+			err = fmt.Errorf("unknown template variable identity.%s", name)
+			return p
+		})
+		return out, err
+	case map[string]any:
+		for k, e := range v {
+			var err error
+			if v[k], err = renderClaim(e, vars); err != nil {
+				return nil, err
+			}
+		}
+	case []any:
+		for i, e := range v {
+			var err error
+			if v[i], err = renderClaim(e, vars); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return v, nil
+}
+
+func (s *VaultServer) loadOIDCConfig(tx *bbolt.Tx) oidcConfig {
+	cfg := oidcConfig{Algorithm: oidcDefaultAlg}
+	if data := tx.Bucket([]byte(bucketOIDC)).Get([]byte(oidcConfigKey)); data != nil {
+		json.Unmarshal(data, &cfg)
+	}
+	if cfg.RotationPeriod == 0 {
+		cfg.RotationPeriod = int64(oidcDefaultPeriod / time.Second)
+	}
+	if cfg.VerificationTTL == 0 {
+		cfg.VerificationTTL = int64(oidcDefaultPeriod / time.Second)
+	}
+	return cfg
+}
+
+func (s *VaultServer) getOIDCKeys(tx *bbolt.Tx) ([]oidcKey, error) {
+	data := tx.Bucket([]byte(bucketOIDC)).Get([]byte(oidcKeysKey))
+	if data == nil {
+		return nil, nil
+	}
+	plain, err := s.barrier.open(bucketOIDC+"/"+oidcKeysKey, data)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var keys []oidcKey
+	if err := json.Unmarshal(plain, &keys); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return keys, nil
+}
+
+func (s *VaultServer) putOIDCKeys(tx *bbolt.Tx, keys []oidcKey) error {
+	data, _ := json.Marshal(keys)
+	return tx.Bucket([]byte(bucketOIDC)).Put([]byte(oidcKeysKey), s.barrier.seal(bucketOIDC+"/"+oidcKeysKey, data))
+}
+
+// rotateOIDCKey retires the current signing key after the verification
+// TTL, drops keys already retired and appends a new current key.
+func (s *VaultServer) rotateOIDCKey(tx *bbolt.Tx, cfg oidcConfig, keys []oidcKey) ([]oidcKey, error) {
+	_, der, err := newPKIKey(oidcAlgorithms[cfg.Algorithm])
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	if n := len(keys); n > 0 {
+		keys[n-1].RetireTime = now.Add(time.Duration(cfg.VerificationTTL) * time.Second)
+	}
+	keys = slices.DeleteFunc(keys, func(k oidcKey) bool { return !k.RetireTime.IsZero() && !now.Before(k.RetireTime) })
+	keys = append(keys, oidcKey{ID: randomString(12), Key: der, CreateTime: now})
+	if err := s.putOIDCKeys(tx, keys); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return keys, nil
+}
+
+// oidcSigningKey returns the current signing key, rotating first when
+// there is none or the rotation period has passed.
+func (s *VaultServer) oidcSigningKey(tx *bbolt.Tx, cfg oidcConfig) (*oidcKey, error) {
+	keys, err := s.getOIDCKeys(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 || !s.now().Before(keys[len(keys)-1].CreateTime.Add(time.Duration(cfg.RotationPeriod)*time.Second)) {
+		if keys, err = s.rotateOIDCKey(tx, cfg, keys); err != nil {
+			return nil, err
+		}
+	}
+	return &keys[len(keys)-1], nil
+}
+
+func (o *OIDCServer) PutConfig(ctx context.Context, req *connect.Request[vaultv1.OIDCConfig]) (*connect.Response[vaultv1.PutOIDCConfigResponse], error) {
+	m := req.Msg
+	slog.Info("PutOIDCConfig", "issuer", m.Issuer, "algorithm", m.Algorithm)
+	cfg := oidcConfig{
+		Issuer:          strings.TrimSuffix(m.Issuer, "/"),
+		Algorithm:       cmp.Or(m.Algorithm, oidcDefaultAlg),
+		RotationPeriod:  m.RotationPeriodSeconds,
+		VerificationTTL: m.VerificationTtlSeconds,
+	}
+	if !strings.HasPrefix(cfg.Issuer, "https://") && !strings.HasPrefix(cfg.Issuer, "http://") {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("issuer must be an http(s) URL"))
+	}
+	if oidcAlgorithms[cfg.Algorithm] == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported algorithm %q", cfg.Algorithm))
+	}
+	if cfg.RotationPeriod < 0 || cfg.VerificationTTL < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("durations cannot be negative"))
+	}
+	if err := o.authorizeSudo(ctx, "identity/oidc/config"); err != nil {
+		return nil, err
+	}
+
+	data, _ := json.Marshal(cfg)
+	err := o.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketOIDC)).Put([]byte(oidcConfigKey), data)
+	})
+	o.audit(ctx, "PutOIDCConfig", "identity/oidc/config", 0, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutOIDCConfigResponse{}), nil
+}
+
+func (o *OIDCServer) PutRole(ctx context.Context, req *connect.Request[vaultv1.OIDCRole]) (*connect.Response[vaultv1.PutOIDCRoleResponse], error) {
+	r := req.Msg
+	slog.Info("PutOIDCRole", "role", r.Name, "client_id", r.ClientId)
+	if r.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("role name is required"))
+	}
+	if r.TtlSeconds < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ttl_seconds cannot be negative"))
+	}
+	// Render against an empty caller so bad templates fail now, not at
+	// issue time.
+	if _, err := renderClaims(r.Template, &Caller{}); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := o.authorizeSudo(ctx, "identity/oidc/roles/"+r.Name); err != nil {
+		return nil, err
+	}
+
+	role := oidcRole{ClientID: cmp.Or(r.ClientId, r.Name), Template: r.Template, TTL: r.TtlSeconds}
+	if role.TTL == 0 {
+		role.TTL = int64(oidcDefaultTTL / time.Second)
+	}
+	data, _ := json.Marshal(role)
+	err := o.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketOIDCRoles)).Put([]byte(r.Name), data)
+	})
+	o.audit(ctx, "PutOIDCRole", "identity/oidc/roles/"+r.Name, 0, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutOIDCRoleResponse{}), nil
+}
+
+func (o *OIDCServer) GetRole(ctx context.Context, req *connect.Request[vaultv1.GetOIDCRoleRequest]) (*connect.Response[vaultv1.OIDCRole], error) {
+	name := req.Msg.Name
+	slog.Info("GetOIDCRole", "role", name)
+	if err := o.authorize(ctx, "read", "identity/oidc/roles/"+name); err != nil {
+		return nil, err
+	}
+	var role oidcRole
+	err := o.db.View(func(tx *bbolt.Tx) error {
+		return getOIDCRole(tx, name, &role)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.OIDCRole{Name: name, ClientId: role.ClientID, Template: role.Template, TtlSeconds: role.TTL}), nil
+}
+
+func getOIDCRole(tx *bbolt.Tx, name string, role *oidcRole) error {
+	data := tx.Bucket([]byte(bucketOIDCRoles)).Get([]byte(name))
+	if data == nil {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("OIDC role not found: %s", name))
+	}
+	if err := json.Unmarshal(data, role); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// GenerateToken issues an identity token for the caller under the role.
+// The caller must be authenticated, by client certificate or by a token
+// whose entity was fixed when it was issued; "sub" is that certificate
+// identity or entity, never a name the token holder picked.
+func (o *OIDCServer) GenerateToken(ctx context.Context, req *connect.Request[vaultv1.GenerateIdentityTokenRequest]) (*connect.Response[vaultv1.IdentityToken], error) {
+	name := req.Msg.Role
+	path := "identity/oidc/token/" + name
+	slog.Info("GenerateIdentityToken", "role", name)
+	c := CallerFrom(ctx)
+	if c == nil || c.Identity == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("an authenticated identity is required for %s", path))
+	}
+	if err := o.authorize(ctx, "read", path); err != nil {
+		o.audit(ctx, "GenerateIdentityToken", path, 0, err)
+		return nil, err
+	}
+
+	var token string
+	var role oidcRole
+	now := o.now()
+	err := o.db.Update(func(tx *bbolt.Tx) error {
+		if c.TokenID != "" {
+			if e, err := getToken(tx, c.TokenID); err != nil || e.Entity == "" {
+				return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("token has no entity to issue identity tokens for"))
+			}
+		}
+		if err := getOIDCRole(tx, name, &role); err != nil {
+			return err
+		}
+		cfg := o.loadOIDCConfig(tx)
+		if cfg.Issuer == "" {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the OIDC issuer is not configured"))
+		}
+		claims, err := renderClaims(role.Template, c)
+		if err != nil {
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		claims["iss"] = cfg.Issuer
+		claims["sub"] = c.Identity
+		claims["aud"] = role.ClientID
+		claims["iat"] = now.Unix()
+		claims["nbf"] = now.Unix()
+		claims["exp"] = now.Add(time.Duration(role.TTL) * time.Second).Unix()
+		key, err := o.oidcSigningKey(tx, cfg)
+		if err != nil {
+			return err
+		}
+		signer, err := key.signer()
+		if err != nil {
+			return err
+		}
+		if token, err = signJWT(key.ID, signer, claims); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	})
+	o.audit(ctx, "GenerateIdentityToken", path, 0, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.IdentityToken{
+		Token:      token,
+		ClientId:   role.ClientID,
+		TtlSeconds: role.TTL,
+		ExpireTime: now.Add(time.Duration(role.TTL) * time.Second).Unix(),
+	}), nil
+}
+
+// RotateKey replaces the signing key ahead of schedule; the old key stays
+// published for the verification TTL.
+func (o *OIDCServer) RotateKey(ctx context.Context, req *connect.Request[vaultv1.RotateOIDCKeyRequest]) (*connect.Response[vaultv1.RotateOIDCKeyResponse], error) {
+	slog.Info("RotateOIDCKey")
+	if err := o.authorizeSudo(ctx, "identity/oidc/key/rotate"); err != nil {
+		return nil, err
+	}
+	var id string
+	err := o.db.Update(func(tx *bbolt.Tx) error {
+		keys, err := o.getOIDCKeys(tx)
+		if err != nil {
+			return err
+		}
+		if keys, err = o.rotateOIDCKey(tx, o.loadOIDCConfig(tx), keys); err != nil {
+			return err
+		}
+		id = keys[len(keys)-1].ID
+		return nil
+	})
+	o.audit(ctx, "RotateOIDCKey", "identity/oidc/key", 0, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.RotateOIDCKeyResponse{KeyId: id}), nil
+}
+
+// Handler serves the discovery document and JWKS at OIDCPath. Both are
+// public, so the handler does not authenticate.
+func (o *OIDCServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+OIDCPath+"/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		var cfg oidcConfig
+		o.db.View(func(tx *bbolt.Tx) error {
+			cfg = o.loadOIDCConfig(tx)
+			return nil
+		})
+		if cfg.Issuer == "" {
+			http.Error(w, "the OIDC issuer is not configured", http.StatusNotFound)
+			return
+		}
+		writeJSON(w, map[string]any{
+			"issuer":                                cfg.Issuer,
+			"jwks_uri":                              cfg.Issuer + "/.well-known/keys",
+			"response_types_supported":              []string{"id_token"},
+			"subject_types_supported":               []string{"public"},
+			"id_token_signing_alg_values_supported": []string{"RS256", "ES256", "EdDSA"},
+		})
+	})
+	mux.HandleFunc("GET "+OIDCPath+"/.well-known/keys", func(w http.ResponseWriter, r *http.Request) {
+		set := jwkSet{Keys: []jwk{}}
+		now := o.now()
+		err := o.db.View(func(tx *bbolt.Tx) error {
+			keys, err := o.getOIDCKeys(tx)
+			if err != nil {
+				return err
+			}
+			for _, k := range keys {
+				if !k.RetireTime.IsZero() && !now.Before(k.RetireTime) {
+					continue
+				}
+				signer, err := k.signer()
+				if err != nil {
+					return err
+				}
+				set.Keys = append(set.Keys, publicJWK(signer, k.ID))
+			}
+			return nil
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, set)
+	})
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
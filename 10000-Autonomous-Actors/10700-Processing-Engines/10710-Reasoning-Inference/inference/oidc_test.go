@@ -0,0 +1,163 @@
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault/vaultv1connect"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+func newOIDCClient(t *testing.T, server *VaultServer) vaultv1connect.OIDCServiceClient {
+	path, h := vaultv1connect.NewOIDCServiceHandler(server.OIDC(), connect.WithInterceptors(server.AuthInterceptor()))
+	return vaultv1connect.NewOIDCServiceClient(http.DefaultClient, serveTest(t, path, h))
+}
+
+func getJSON(t *testing.T, url string, v any) {
+	t.Helper()
+	res, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("GET %s: %s", url, res.Status)
+	}
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+}
+
+func TestOIDCIdentityTokens(t *testing.T) {
+	server, client, root := newTestClient(t, RequireAuth())
+	oidc := newOIDCClient(t, server)
+	ctx := context.Background()
+	clock := time.Now()
+	server.now = func() time.Time { return clock }
+	provider := httptest.NewServer(server.OIDC().Handler())
+	defer provider.Close()
+	issuer := provider.URL + OIDCPath
+
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name: "agents", Rules: []*vaultv1.PolicyRule{{Path: "identity/oidc/token/agents", Capabilities: []string{"read"}}},
+	}}, root))
+	agent, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{Policies: []string{"agents"}, DisplayName: "planner"}, root))
+	if err != nil {
+		t.Fatalf("CreateToken: %v", err)
+	}
+	token := agent.Msg.Token
+
+	for _, tmpl := range []string{`{"sub": "someone"}`, `{"who": "{{identity.email}}"}`, `["not", "an", "object"]`} {
+		if _, err := oidc.PutRole(ctx, withToken(&vaultv1.OIDCRole{Name: "bad", Template: tmpl}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("PutRole(%s): expected InvalidArgument, got %v", tmpl, err)
+		}
+	}
+	_, err = oidc.PutRole(ctx, withToken(&vaultv1.OIDCRole{
+		Name:       "agents",
+		ClientId:   "olympus-services",
+		Template:   `{"policies": "{{identity.policies}}", "origin": "{{identity.source}}:{{identity.name}}", "vault": {"accessor": "{{ identity.token_accessor }}"}}`,
+		TtlSeconds: 600,
+	}, root))
+	if err != nil {
+		t.Fatalf("PutRole: %v", err)
+	}
+	if _, err := oidc.GenerateToken(ctx, withToken(&vaultv1.GenerateIdentityTokenRequest{Role: "agents"}, token)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("GenerateToken without issuer: expected FailedPrecondition, got %v", err)
+	}
+	if _, err := oidc.PutConfig(ctx, withToken(&vaultv1.OIDCConfig{Issuer: issuer, RotationPeriodSeconds: 3600, VerificationTtlSeconds: 1800}, root)); err != nil {
+		t.Fatalf("PutConfig: %v", err)
+	}
+	if _, err := oidc.GenerateToken(ctx, connect.NewRequest(&vaultv1.GenerateIdentityTokenRequest{Role: "agents"})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("GenerateToken without token: expected Unauthenticated, got %v", err)
+	}
+	if _, err := oidc.GenerateToken(ctx, withToken(&vaultv1.GenerateIdentityTokenRequest{Role: "other"}, token)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("GenerateToken for unpermitted role: expected PermissionDenied, got %v", err)
+	}
+
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	getJSON(t, issuer+"/.well-known/openid-configuration", &discovery)
+	if discovery.Issuer != issuer || discovery.JWKSURI != issuer+"/.well-known/keys" {
+		t.Errorf("discovery = %+v", discovery)
+	}
+	verify := func(jwt string) (jwtClaims, error) {
+		var set jwkSet
+		getJSON(t, discovery.JWKSURI, &set)
+		return verifyJWT(jwt, set.Keys, clock, 0)
+	}
+
+	res, err := oidc.GenerateToken(ctx, withToken(&vaultv1.GenerateIdentityTokenRequest{Role: "agents"}, token))
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	claims, err := verify(res.Msg.Token)
+	if err != nil {
+		t.Fatalf("token does not verify against the JWKS: %v", err)
+	}
+	if claims.str("iss") != issuer || claims.str("sub") != "planner" || claims.str("aud") != "olympus-services" {
+		t.Errorf("registered claims = %v", claims)
+	}
+	if exp, _ := claims.time("exp"); exp.Unix() != clock.Add(600*time.Second).Unix() || res.Msg.ExpireTime != exp.Unix() {
+		t.Errorf("exp = %v", exp)
+	}
+	if p := claims.strings("policies"); len(p) != 2 || p[0] != "agents" || claims.str("origin") != "token:planner" {
+		t.Errorf("templated claims = %v", claims)
+	}
+	if nested, _ := claims["vault"].(map[string]any); nested["accessor"] != agent.Msg.Info.Accessor {
+		t.Errorf("nested claim = %v", claims["vault"])
+	}
+
+	// Child tokens act for their parent's entity whatever they are called.
+	child, _ := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{DisplayName: "payments-service"}, token))
+	if res, err := oidc.GenerateToken(ctx, withToken(&vaultv1.GenerateIdentityTokenRequest{Role: "agents"}, child.Msg.Token)); err != nil {
+		t.Errorf("GenerateToken from child token: %v", err)
+	} else if claims, _ := verify(res.Msg.Token); claims.str("sub") != "planner" {
+		t.Errorf("child token sub = %q, want its parent's entity", claims.str("sub"))
+	}
+	// Tokens issued before entities were recorded get no identity token.
+	server.db.Update(func(tx *bbolt.Tx) error {
+		e, _ := getToken(tx, hashToken(child.Msg.Token))
+		e.Entity = ""
+		return putToken(tx, e)
+	})
+	if _, err := oidc.GenerateToken(ctx, withToken(&vaultv1.GenerateIdentityTokenRequest{Role: "agents"}, child.Msg.Token)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("GenerateToken from token without entity: expected PermissionDenied, got %v", err)
+	}
+
+	// After rotation the old key stays published for the verification TTL.
+	rotated, err := oidc.RotateKey(ctx, withToken(&vaultv1.RotateOIDCKeyRequest{}, root))
+	if err != nil {
+		t.Fatalf("RotateKey: %v", err)
+	}
+	fresh, _ := oidc.GenerateToken(ctx, withToken(&vaultv1.GenerateIdentityTokenRequest{Role: "agents"}, token))
+	if _, err := verify(res.Msg.Token); err != nil {
+		t.Errorf("token from the rotated key no longer verifies: %v", err)
+	}
+	if _, err := verify(fresh.Msg.Token); err != nil {
+		t.Errorf("token from the new key does not verify: %v", err)
+	}
+	var set jwkSet
+	getJSON(t, discovery.JWKSURI, &set)
+	if len(set.Keys) != 2 || set.Keys[1].Kid != rotated.Msg.KeyId {
+		t.Errorf("JWKS after rotation = %+v", set.Keys)
+	}
+
+	// The key rotates on schedule, and retired keys leave the JWKS.
+	clock = clock.Add(time.Hour)
+	oidc.GenerateToken(ctx, withToken(&vaultv1.GenerateIdentityTokenRequest{Role: "agents"}, token))
+	getJSON(t, discovery.JWKSURI, &set)
+	if len(set.Keys) != 2 || set.Keys[0].Kid != rotated.Msg.KeyId {
+		t.Errorf("JWKS after scheduled rotation = %+v", set.Keys)
+	}
+	if _, err := verify(res.Msg.Token); err == nil {
+		t.Error("token from a retired key still verifies")
+	}
+}
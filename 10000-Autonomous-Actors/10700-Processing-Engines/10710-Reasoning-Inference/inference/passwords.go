@@ -0,0 +1,323 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"slices"
+	"strings"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	formatPassword   = "password"
+	formatPassphrase = "passphrase"
+	formatAPIKey     = "api_key"
+
+	// maxGeneratedLength bounds the characters or words of a generated value.
+	maxGeneratedLength = 1024
+
+	charsetBase62 = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+// defaultCharsetRules are used by password policies without rules.
+var defaultCharsetRules = []charsetRule{
+	{Charset: "abcdefghijklmnopqrstuvwxyz", MinChars: 1},
+	{Charset: "ABCDEFGHIJKLMNOPQRSTUVWXYZ", MinChars: 1},
+	{Charset: "0123456789", MinChars: 1},
+	{Charset: "!@#$%^&*-_=+", MinChars: 1},
+}
+
+type charsetRule struct {
+	Charset  string `json:"charset"`
+	MinChars int32  `json:"min_chars,omitempty"`
+}
+
+type passwordPolicy struct {
+	Format    string        `json:"format"`
+	Length    int32         `json:"length,omitempty"`
+	Rules     []charsetRule `json:"rules,omitempty"`
+	Words     []string      `json:"words,omitempty"`
+	Separator string        `json:"separator,omitempty"`
+	Prefix    string        `json:"prefix,omitempty"`
+}
+
+func (p *passwordPolicy) proto(name string) *vaultv1.PasswordPolicy {
+	out := &vaultv1.PasswordPolicy{
+		Name:      name,
+		Format:    p.Format,
+		Length:    p.Length,
+		Words:     p.Words,
+		Separator: p.Separator,
+		Prefix:    p.Prefix,
+	}
+	for _, r := range p.Rules {
+		out.Rules = append(out.Rules, &vaultv1.CharsetRule{Charset: r.Charset, MinChars: r.MinChars})
+	}
+	return out
+}
+
+// randIndex returns a uniform random index in [0, n).
+func randIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(i.Int64()), nil
+}
+
+// randomRunes draws n runes uniformly from alphabet.
+func randomRunes(alphabet []rune, n int) ([]rune, error) {
+	out := make([]rune, n)
+	for i := range out {
+		j, err := randIndex(len(alphabet))
+		if err != nil {
+			return nil, err
+		}
+		out[i] = alphabet[j]
+	}
+	return out, nil
+}
+
+// charsetRules returns p's rules, or the defaults when it has none.
+func (p *passwordPolicy) charsetRules() []charsetRule {
+	if len(p.Rules) == 0 {
+		return defaultCharsetRules
+	}
+	return p.Rules
+}
+
+// validate checks p can generate values without drawing any: lengths are
+// bounded and the rules' minimums fit in the password length.
+func (p *passwordPolicy) validate() error {
+	var length int32
+	switch p.Format {
+	case formatPassword:
+		length = cmp.Or(p.Length, 20)
+		var required int64
+		for _, r := range p.charsetRules() {
+			if r.Charset == "" {
+				return fmt.Errorf("charset rules must not be empty")
+			}
+			if r.MinChars < 0 {
+				return fmt.Errorf("min_chars must not be negative")
+			}
+			required += int64(r.MinChars)
+		}
+		if required > int64(length) {
+			return fmt.Errorf("rules require %d characters but length is %d", required, length)
+		}
+	case formatPassphrase:
+		if len(p.Words) < 2 {
+			return fmt.Errorf("passphrase policies need a word list of at least two words")
+		}
+		length = cmp.Or(p.Length, 6)
+	case formatAPIKey:
+		length = cmp.Or(p.Length, 32)
+	default:
+		return fmt.Errorf("unknown format %q", p.Format)
+	}
+	if length > maxGeneratedLength {
+		return fmt.Errorf("length must be at most %d", maxGeneratedLength)
+	}
+	return nil
+}
+
+// generate builds a value according to p. Invalid policies are reported as
+// plain errors; PutPasswordPolicy rejects them before they are stored.
+func (p *passwordPolicy) generate() (string, error) {
+	if err := p.validate(); err != nil {
+		return "", err
+	}
+	switch p.Format {
+	case formatPassphrase:
+		return p.passphrase()
+	case formatAPIKey:
+		key, err := randomRunes([]rune(charsetBase62), int(cmp.Or(p.Length, 32)))
+		if err != nil {
+			return "", err
+		}
+		return p.Prefix + string(key), nil
+	}
+	return p.password()
+}
+
+// password draws each rule's minimum from its charset, fills the rest from
+// the union of all charsets and shuffles the result.
+func (p *passwordPolicy) password() (string, error) {
+	length := int(cmp.Or(p.Length, 20))
+	var union []rune
+	var out []rune
+	for _, r := range p.charsetRules() {
+		charset := []rune(r.Charset)
+		for _, c := range charset {
+			if !slices.Contains(union, c) {
+				union = append(union, c)
+			}
+		}
+		required, err := randomRunes(charset, int(r.MinChars))
+		if err != nil {
+			return "", err
+		}
+		out = append(out, required...)
+	}
+	rest, err := randomRunes(union, length-len(out))
+	if err != nil {
+		return "", err
+	}
+	out = append(out, rest...)
+	for i := len(out) - 1; i > 0; i-- {
+		j, err := randIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out), nil
+}
+
+func (p *passwordPolicy) passphrase() (string, error) {
+	words := make([]string, cmp.Or(p.Length, 6))
+	for i := range words {
+		j, err := randIndex(len(p.Words))
+		if err != nil {
+			return "", err
+		}
+		words[i] = p.Words[j]
+	}
+	return strings.Join(words, cmp.Or(p.Separator, "-")), nil
+}
+
+func getPasswordPolicy(tx *bbolt.Tx, name string) (*passwordPolicy, error) {
+	data := tx.Bucket([]byte(bucketPasswordPolicy)).Get([]byte(name))
+	if data == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("password policy not found: %s", name))
+	}
+	var p passwordPolicy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &p, nil
+}
+
+func (s *VaultServer) PutPasswordPolicy(ctx context.Context, req *connect.Request[vaultv1.PutPasswordPolicyRequest]) (*connect.Response[vaultv1.PutPasswordPolicyResponse], error) {
+	r := req.Msg.Policy
+	if r == nil || r.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	slog.Info("PutPasswordPolicy", "name", r.Name, "format", r.Format)
+	if r.Length < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("length must not be negative"))
+	}
+	path := "sys/policies/password/" + r.Name
+	if err := s.authorizeSudo(ctx, path); err != nil {
+		return nil, err
+	}
+
+	policy := passwordPolicy{
+		Format:    cmp.Or(r.Format, formatPassword),
+		Length:    r.Length,
+		Words:     r.Words,
+		Separator: r.Separator,
+		Prefix:    r.Prefix,
+	}
+	for _, rule := range r.Rules {
+		policy.Rules = append(policy.Rules, charsetRule{Charset: rule.Charset, MinChars: rule.MinChars})
+	}
+	if err := policy.validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid password policy: %w", err))
+	}
+	data, _ := json.Marshal(policy)
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketPasswordPolicy)).Put([]byte(r.Name), data)
+	})
+	s.audit(ctx, "PutPasswordPolicy", path, 0, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutPasswordPolicyResponse{}), nil
+}
+
+func (s *VaultServer) GetPasswordPolicy(ctx context.Context, req *connect.Request[vaultv1.GetPasswordPolicyRequest]) (*connect.Response[vaultv1.PasswordPolicy], error) {
+	name := req.Msg.Name
+	slog.Info("GetPasswordPolicy", "name", name)
+	if err := s.authorize(ctx, "read", "sys/policies/password/"+name); err != nil {
+		return nil, err
+	}
+	var policy *passwordPolicy
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		policy, err = getPasswordPolicy(tx, name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(policy.proto(name)), nil
+}
+
+// GenerateSecret produces a value from a password policy. With a key, the
+// value is written as the secret's newest version and only the version is
+// returned, so the plaintext never leaves the server.
+func (s *VaultServer) GenerateSecret(ctx context.Context, req *connect.Request[vaultv1.GenerateSecretRequest]) (*connect.Response[vaultv1.GenerateSecretResponse], error) {
+	r := req.Msg
+	path := "sys/policies/password/" + r.Policy + "/generate"
+	slog.Info("GenerateSecret", "policy", r.Policy, "key", r.Key)
+	if r.Key == "" && (r.TtlSeconds != 0 || r.ExpireTime != 0 || r.KmsKeyName != "") {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expiry and kms_key_name apply only when writing to a key"))
+	}
+	if err := s.authorize(ctx, "read", path); err != nil {
+		s.audit(ctx, "GenerateSecret", path, 0, err)
+		return nil, err
+	}
+	if r.Key != "" {
+		if err := s.authorize(ctx, s.writeCapability(r.Key), r.Key); err != nil {
+			s.audit(ctx, "GenerateSecret", r.Key, 0, err)
+			return nil, err
+		}
+		if r.KmsKeyName != "" {
+			if err := s.authorize(ctx, "update", "kms/"+r.KmsKeyName); err != nil {
+				s.audit(ctx, "GenerateSecret", r.Key, 0, err)
+				return nil, err
+			}
+		}
+	}
+	expire, err := expiryFrom(r.TtlSeconds, r.ExpireTime, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	var policy *passwordPolicy
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		policy, err = getPasswordPolicy(tx, r.Policy)
+		return err
+	})
+	if err != nil {
+		s.audit(ctx, "GenerateSecret", path, 0, err)
+		return nil, err
+	}
+	value, err := policy.generate()
+	s.audit(ctx, "GenerateSecret", path, 0, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if r.Key == "" {
+		return connect.NewResponse(&vaultv1.GenerateSecretResponse{Value: value}), nil
+	}
+
+	version, err := s.writeSecret(ctx, r.Key, value, r.KmsKeyName, expire, "")
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.GenerateSecretResponse{Version: version, ExpireTime: unixOrZero(expire)}), nil
+}
@@ -0,0 +1,123 @@
+package inference
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+)
+
+func TestPasswordPolicies(t *testing.T) {
+	_, client, root := newTestClient(t, RequireAuth())
+	ctx := context.Background()
+
+	for _, bad := range []*vaultv1.PasswordPolicy{
+		{Name: "short", Length: 2},
+		{Name: "empty", Rules: []*vaultv1.CharsetRule{{Charset: ""}}},
+		{Name: "words", Format: "passphrase", Words: []string{"one"}},
+		{Name: "format", Format: "uuid"},
+		{Name: "long", Length: maxGeneratedLength + 1},
+		{Name: "huge", Rules: []*vaultv1.CharsetRule{{Charset: "a", MinChars: 1 << 30}, {Charset: "b", MinChars: 1 << 30}}},
+	} {
+		if _, err := client.PutPasswordPolicy(ctx, withToken(&vaultv1.PutPasswordPolicyRequest{Policy: bad}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("PutPasswordPolicy(%s): expected InvalidArgument, got %v", bad.Name, err)
+		}
+	}
+
+	policies := []*vaultv1.PasswordPolicy{
+		{Name: "default"},
+		{Name: "pin", Length: 8, Rules: []*vaultv1.CharsetRule{{Charset: "0123456789", MinChars: 8}}},
+		{Name: "strict", Length: 12, Rules: []*vaultv1.CharsetRule{{Charset: "abc", MinChars: 2}, {Charset: "XYZ", MinChars: 5}, {Charset: "!"}}},
+		{Name: "words", Format: "passphrase", Length: 4, Separator: " ", Words: []string{"olympus", "vault", "zeus", "hermes"}},
+		{Name: "api", Format: "api_key", Prefix: "olp_"},
+	}
+	for _, p := range policies {
+		if _, err := client.PutPasswordPolicy(ctx, withToken(&vaultv1.PutPasswordPolicyRequest{Policy: p}, root)); err != nil {
+			t.Fatalf("PutPasswordPolicy(%s): %v", p.Name, err)
+		}
+	}
+	if got, err := client.GetPasswordPolicy(ctx, withToken(&vaultv1.GetPasswordPolicyRequest{Name: "strict"}, root)); err != nil || got.Msg.Format != "password" || len(got.Msg.Rules) != 3 {
+		t.Errorf("GetPasswordPolicy = %v, %v", got, err)
+	}
+
+	generate := func(policy string) string {
+		t.Helper()
+		res, err := client.GenerateSecret(ctx, withToken(&vaultv1.GenerateSecretRequest{Policy: policy}, root))
+		if err != nil {
+			t.Fatalf("GenerateSecret(%s): %v", policy, err)
+		}
+		return res.Msg.Value
+	}
+	if v := generate("default"); len(v) != 20 || !strings.ContainsAny(v, "0123456789") || !strings.ContainsAny(v, "!@#$%^&*-_=+") || strings.ToLower(v) == v || strings.ToUpper(v) == v {
+		t.Errorf("default password = %q", v)
+	}
+	if v := generate("pin"); len(v) != 8 || strings.Trim(v, "0123456789") != "" {
+		t.Errorf("pin = %q", v)
+	}
+	for range 20 {
+		v := generate("strict")
+		if len(v) != 12 || strings.Trim(v, "abcXYZ!") != "" {
+			t.Fatalf("strict password = %q", v)
+		}
+		lower, upper := 0, 0
+		for _, c := range v {
+			lower += strings.Count("abc", string(c))
+			upper += strings.Count("XYZ", string(c))
+		}
+		if lower < 2 || upper < 5 {
+			t.Fatalf("strict password %q misses its minimums", v)
+		}
+	}
+	if v := strings.Fields(generate("words")); len(v) != 4 {
+		t.Errorf("passphrase = %q", v)
+	}
+	if v := generate("api"); !strings.HasPrefix(v, "olp_") || len(v) != 36 || strings.Trim(v[4:], charsetBase62) != "" {
+		t.Errorf("api key = %q", v)
+	}
+	if generate("api") == generate("api") {
+		t.Error("two API keys are identical")
+	}
+	if _, err := client.GenerateSecret(ctx, withToken(&vaultv1.GenerateSecretRequest{Policy: "missing"}, root)); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("GenerateSecret with unknown policy: expected NotFound, got %v", err)
+	}
+}
+
+func TestGenerateSecretWritesVersion(t *testing.T) {
+	_, client, root := newTestClient(t, RequireAuth())
+	ctx := context.Background()
+
+	client.PutPasswordPolicy(ctx, withToken(&vaultv1.PutPasswordPolicyRequest{Policy: &vaultv1.PasswordPolicy{Name: "db"}}, root))
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "db/password", Value: "hunter2"}, root))
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name: "rotate", Rules: []*vaultv1.PolicyRule{
+			{Path: "sys/policies/password/db/generate", Capabilities: []string{"read"}},
+			{Path: "db/password", Capabilities: []string{"update"}},
+		},
+	}}, root))
+	created, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{Policies: []string{"rotate"}}, root))
+	if err != nil {
+		t.Fatalf("CreateToken: %v", err)
+	}
+	token := created.Msg.Token
+
+	res, err := client.GenerateSecret(ctx, withToken(&vaultv1.GenerateSecretRequest{Policy: "db", Key: "db/password", TtlSeconds: 3600}, token))
+	if err != nil {
+		t.Fatalf("GenerateSecret: %v", err)
+	}
+	if res.Msg.Value != "" || res.Msg.Version != 2 || res.Msg.ExpireTime == 0 {
+		t.Errorf("GenerateSecret response = %v, want only version 2 and its expiry", res.Msg)
+	}
+	read, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "db/password"}, root))
+	if err != nil || read.Msg.Version != 2 || len(read.Msg.Value) != 20 {
+		t.Errorf("VaultRead = %v, %v", read, err)
+	}
+
+	if _, err := client.GenerateSecret(ctx, withToken(&vaultv1.GenerateSecretRequest{Policy: "db", Key: "other/password"}, token)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("GenerateSecret into unpermitted key: expected PermissionDenied, got %v", err)
+	}
+	if _, err := client.GenerateSecret(ctx, withToken(&vaultv1.GenerateSecretRequest{Policy: "db", TtlSeconds: 60}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("GenerateSecret with TTL but no key: expected InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,830 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"cmp"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	pkiRoot         = "root"
+	pkiIntermediate = "intermediate"
+	pkiKeyDefault   = "ecdsa-p256"
+	pkiRootTTL      = 10 * 365 * 24 * time.Hour
+	pkiInterTTL     = 5 * 365 * 24 * time.Hour
+	pkiLeafTTL      = 24 * time.Hour
+	// crlLifetime is the validity of a CRL; it is re-signed once less than
+	// half remains.
+	crlLifetime = 72 * time.Hour
+	// pkiBackdate tolerates clock skew between the vault and relying parties.
+	pkiBackdate = 30 * time.Second
+)
+
+var pkiKeyUsages = map[string]x509.KeyUsage{
+	"digital_signature":  x509.KeyUsageDigitalSignature,
+	"key_encipherment":   x509.KeyUsageKeyEncipherment,
+	"key_agreement":      x509.KeyUsageKeyAgreement,
+	"content_commitment": x509.KeyUsageContentCommitment,
+}
+
+var pkiExtKeyUsages = map[string]x509.ExtKeyUsage{
+	"server_auth":      x509.ExtKeyUsageServerAuth,
+	"client_auth":      x509.ExtKeyUsageClientAuth,
+	"code_signing":     x509.ExtKeyUsageCodeSigning,
+	"email_protection": x509.ExtKeyUsageEmailProtection,
+}
+
+// PKIServer serves PKIService from the vault's storage.
+type PKIServer struct {
+	*VaultServer
+}
+
+// PKI returns the PKIService handler backed by s.
+func (s *VaultServer) PKI() *PKIServer {
+	return &PKIServer{s}
+}
+
+// pkiCA is stored sealed by the barrier under its issuer name, with the
+// latest CRL it signed.
+type pkiCA struct {
+	Cert                  []byte   `json:"cert"`
+	Key                   []byte   `json:"key"`
+	CRLDistributionPoints []string `json:"crl_distribution_points,omitempty"`
+	CRL                   []byte   `json:"crl"`
+	CRLNumber             int64    `json:"crl_number"`
+}
+
+func (ca *pkiCA) parse() (*x509.Certificate, crypto.Signer, error) {
+	cert, err := x509.ParseCertificate(ca.Cert)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(ca.Key)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return cert, key.(crypto.Signer), nil
+}
+
+// pkiCert records an issued certificate, keyed by serial number.
+type pkiCert struct {
+	Issuer     string    `json:"issuer"`
+	Role       string    `json:"role,omitempty"`
+	Cert       []byte    `json:"cert"`
+	NotAfter   time.Time `json:"not_after"`
+	RevokeTime time.Time `json:"revoke_time,omitzero"`
+}
+
+type pkiRole struct {
+	AllowedDomains   []string `json:"allowed_domains"`
+	AllowBareDomains bool     `json:"allow_bare_domains,omitempty"`
+	AllowSubdomains  bool     `json:"allow_subdomains,omitempty"`
+	AllowIPSANs      bool     `json:"allow_ip_sans,omitempty"`
+	TTL              int64    `json:"ttl"`
+	MaxTTL           int64    `json:"max_ttl"`
+	KeyType          string   `json:"key_type"`
+	KeyUsage         []string `json:"key_usage"`
+	ExtKeyUsage      []string `json:"ext_key_usage"`
+}
+
+func (r *pkiRole) proto(name string) *vaultv1.PKIRole {
+	return &vaultv1.PKIRole{
+		Name:             name,
+		AllowedDomains:   r.AllowedDomains,
+		AllowBareDomains: r.AllowBareDomains,
+		AllowSubdomains:  r.AllowSubdomains,
+		AllowIpSans:      r.AllowIPSANs,
+		TtlSeconds:       r.TTL,
+		MaxTtlSeconds:    r.MaxTTL,
+		KeyType:          r.KeyType,
+		KeyUsage:         r.KeyUsage,
+		ExtKeyUsage:      r.ExtKeyUsage,
+	}
+}
+
+// allows reports whether the role may issue for the DNS name.
+func (r *pkiRole) allows(name string) bool {
+	return domainAllowed(name, r.AllowedDomains, r.AllowBareDomains, r.AllowSubdomains)
+}
+
+// domainAllowed reports whether name equals one of domains (bare) or lies
+// below one (subdomains). Subdomains may start with a "*" label.
+func domainAllowed(name string, domains []string, bare, subdomains bool) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	for _, d := range domains {
+		d = strings.ToLower(d)
+		if bare && name == d {
+			return true
+		}
+		if rest, ok := strings.CutSuffix(name, "."+d); ok && subdomains && rest != "" {
+			if rest == "*" || !strings.Contains(strings.TrimPrefix(rest, "*."), "*") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func newPKIKey(typ string) (crypto.Signer, []byte, error) {
+	gen := signingKeyTypes[typ]
+	if gen == nil {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported key type %q", typ))
+	}
+	key, err := gen()
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return key, der, nil
+}
+
+func newSerial() *big.Int {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[0] = b[0]&0x7f | 0x40
+	return new(big.Int).SetBytes(b)
+}
+
+// formatSerial renders a serial number as colon-separated hex bytes.
+func formatSerial(n *big.Int) string {
+	return strings.ReplaceAll(fmt.Sprintf("% x", n.Bytes()), " ", ":")
+}
+
+func parseSerial(s string) (*big.Int, bool) {
+	return new(big.Int).SetString(strings.ReplaceAll(s, ":", ""), 16)
+}
+
+func pemCert(der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func pkiTTL(seconds int64, fallback time.Duration) (time.Duration, error) {
+	if seconds < 0 {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ttl_seconds must not be negative"))
+	}
+	if seconds == 0 {
+		return fallback, nil
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+func caTemplate(cn string, serial *big.Int, notBefore, notAfter time.Time) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             notBefore.Add(-pkiBackdate),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+}
+
+func (s *VaultServer) getPKICA(tx *bbolt.Tx, issuer string) (*pkiCA, error) {
+	data := tx.Bucket([]byte(bucketPKICAs)).Get([]byte(issuer))
+	if data == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no %s CA has been generated", issuer))
+	}
+	plain, err := s.barrier.open(bucketPKICAs+"/"+issuer, data)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var ca pkiCA
+	if err := json.Unmarshal(plain, &ca); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &ca, nil
+}
+
+func (s *VaultServer) putPKICA(tx *bbolt.Tx, issuer string, ca *pkiCA) error {
+	data, _ := json.Marshal(ca)
+	return tx.Bucket([]byte(bucketPKICAs)).Put([]byte(issuer), s.barrier.seal(bucketPKICAs+"/"+issuer, data))
+}
+
+func putPKICert(tx *bbolt.Tx, serial string, c *pkiCert) error {
+	data, _ := json.Marshal(c)
+	return tx.Bucket([]byte(bucketPKICerts)).Put([]byte(serial), data)
+}
+
+// resolveIssuer validates a requested issuer name; empty means the
+// issuing CA, the intermediate once there is one.
+func resolveIssuer(tx *bbolt.Tx, requested string) (string, error) {
+	b := tx.Bucket([]byte(bucketPKICAs))
+	switch requested {
+	case pkiRoot, pkiIntermediate:
+		return requested, nil
+	case "":
+		if b.Get([]byte(pkiIntermediate)) != nil {
+			return pkiIntermediate, nil
+		}
+		if b.Get([]byte(pkiRoot)) != nil {
+			return pkiRoot, nil
+		}
+		return "", connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no CA has been generated"))
+	}
+	return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("issuer must be %q or %q", pkiRoot, pkiIntermediate))
+}
+
+// pkiChain returns the PEM certificates from issuer up to the root.
+func (s *VaultServer) pkiChain(tx *bbolt.Tx, issuer string) ([]string, error) {
+	names := []string{pkiRoot}
+	if issuer == pkiIntermediate {
+		names = []string{pkiIntermediate, pkiRoot}
+	}
+	var chain []string
+	for _, name := range names {
+		ca, err := s.getPKICA(tx, name)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, pemCert(ca.Cert))
+	}
+	return chain, nil
+}
+
+func (s *VaultServer) caProto(tx *bbolt.Tx, issuer string) (*vaultv1.CACertificate, error) {
+	ca, err := s.getPKICA(tx, issuer)
+	if err != nil {
+		return nil, err
+	}
+	cert, _, err := ca.parse()
+	if err != nil {
+		return nil, err
+	}
+	chain, err := s.pkiChain(tx, issuer)
+	if err != nil {
+		return nil, err
+	}
+	return &vaultv1.CACertificate{
+		Issuer:       issuer,
+		Certificate:  pemCert(ca.Cert),
+		SerialNumber: formatSerial(cert.SerialNumber),
+		ExpireTime:   cert.NotAfter.Unix(),
+		CaChain:      chain,
+	}, nil
+}
+
+// buildCRL re-signs the issuer's CRL from the revoked certificates it
+// issued that have not yet expired, and stores it with the CA.
+func (s *VaultServer) buildCRL(tx *bbolt.Tx, issuer string, ca *pkiCA) error {
+	cert, key, err := ca.parse()
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	var entries []x509.RevocationListEntry
+	err = tx.Bucket([]byte(bucketPKICerts)).ForEach(func(k, v []byte) error {
+		var c pkiCert
+		if json.Unmarshal(v, &c) != nil || c.Issuer != issuer || c.RevokeTime.IsZero() || now.After(c.NotAfter) {
+			return nil
+		}
+		if serial, ok := parseSerial(string(k)); ok {
+			entries = append(entries, x509.RevocationListEntry{SerialNumber: serial, RevocationTime: c.RevokeTime})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	ca.CRLNumber++
+	ca.CRL, err = x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(ca.CRLNumber),
+		ThisUpdate:                now,
+		NextUpdate:                now.Add(crlLifetime),
+		RevokedCertificateEntries: entries,
+	}, cert, key)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return s.putPKICA(tx, issuer, ca)
+}
+
+// currentCRL returns the issuer's CRL, re-signing it first when less than
+// half its lifetime remains.
+func (s *VaultServer) currentCRL(requested string) (string, *x509.RevocationList, error) {
+	var issuer string
+	var der []byte
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		if issuer, err = resolveIssuer(tx, requested); err != nil {
+			return err
+		}
+		ca, err := s.getPKICA(tx, issuer)
+		if err != nil {
+			return err
+		}
+		der = ca.CRL
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	crl, err := x509.ParseRevocationList(der)
+	if err == nil && s.now().Before(crl.NextUpdate.Add(-crlLifetime/2)) {
+		return issuer, crl, nil
+	}
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		ca, err := s.getPKICA(tx, issuer)
+		if err != nil {
+			return err
+		}
+		if err := s.buildCRL(tx, issuer, ca); err != nil {
+			return err
+		}
+		der = ca.CRL
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if crl, err = x509.ParseRevocationList(der); err != nil {
+		return "", nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return issuer, crl, nil
+}
+
+func (p *PKIServer) GenerateRoot(ctx context.Context, req *connect.Request[vaultv1.GenerateRootRequest]) (*connect.Response[vaultv1.CACertificate], error) {
+	cn := req.Msg.CommonName
+	slog.Info("GenerateRoot", "common_name", cn, "key_type", req.Msg.KeyType)
+	if cn == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("common_name is required"))
+	}
+	if err := p.authorizeSudo(ctx, "pki/root"); err != nil {
+		return nil, err
+	}
+	ttl, err := pkiTTL(req.Msg.TtlSeconds, pkiRootTTL)
+	if err != nil {
+		return nil, err
+	}
+	key, keyDER, err := newPKIKey(cmp.Or(req.Msg.KeyType, pkiKeyDefault))
+	if err != nil {
+		return nil, err
+	}
+	now := p.now()
+	tmpl := caTemplate(cn, newSerial(), now, now.Add(ttl))
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var res *vaultv1.CACertificate
+	err = p.db.Update(func(tx *bbolt.Tx) error {
+		if tx.Bucket([]byte(bucketPKICAs)).Get([]byte(pkiRoot)) != nil {
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("a root CA already exists"))
+		}
+		ca := &pkiCA{Cert: der, Key: keyDER, CRLDistributionPoints: req.Msg.CrlDistributionPoints}
+		if err := p.buildCRL(tx, pkiRoot, ca); err != nil {
+			return err
+		}
+		var err error
+		res, err = p.caProto(tx, pkiRoot)
+		return err
+	})
+	p.audit(ctx, "GenerateRoot", "pki/root", 0, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GenerateIntermediate creates the intermediate CA, signed by the root,
+// from which leaf certificates are issued from then on.
+func (p *PKIServer) GenerateIntermediate(ctx context.Context, req *connect.Request[vaultv1.GenerateIntermediateRequest]) (*connect.Response[vaultv1.CACertificate], error) {
+	cn := req.Msg.CommonName
+	slog.Info("GenerateIntermediate", "common_name", cn, "key_type", req.Msg.KeyType)
+	if cn == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("common_name is required"))
+	}
+	if err := p.authorizeSudo(ctx, "pki/intermediate"); err != nil {
+		return nil, err
+	}
+	ttl, err := pkiTTL(req.Msg.TtlSeconds, pkiInterTTL)
+	if err != nil {
+		return nil, err
+	}
+	key, keyDER, err := newPKIKey(cmp.Or(req.Msg.KeyType, pkiKeyDefault))
+	if err != nil {
+		return nil, err
+	}
+
+	var res *vaultv1.CACertificate
+	err = p.db.Update(func(tx *bbolt.Tx) error {
+		if tx.Bucket([]byte(bucketPKICAs)).Get([]byte(pkiIntermediate)) != nil {
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("an intermediate CA already exists"))
+		}
+		if tx.Bucket([]byte(bucketPKICAs)).Get([]byte(pkiRoot)) == nil {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("a root CA must be generated first"))
+		}
+		root, err := p.getPKICA(tx, pkiRoot)
+		if err != nil {
+			return err
+		}
+		rootCert, rootKey, err := root.parse()
+		if err != nil {
+			return err
+		}
+		now := p.now()
+		serial := newSerial()
+		tmpl := caTemplate(cn, serial, now, now.Add(ttl))
+		tmpl.NotAfter = minTime(tmpl.NotAfter, rootCert.NotAfter)
+		tmpl.MaxPathLen, tmpl.MaxPathLenZero = 0, true
+		tmpl.CRLDistributionPoints = root.CRLDistributionPoints
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, rootCert, key.Public(), rootKey)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if err := putPKICert(tx, formatSerial(serial), &pkiCert{Issuer: pkiRoot, Cert: der, NotAfter: tmpl.NotAfter}); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		ca := &pkiCA{Cert: der, Key: keyDER, CRLDistributionPoints: req.Msg.CrlDistributionPoints}
+		if err := p.buildCRL(tx, pkiIntermediate, ca); err != nil {
+			return err
+		}
+		res, err = p.caProto(tx, pkiIntermediate)
+		return err
+	})
+	p.audit(ctx, "GenerateIntermediate", "pki/intermediate", 0, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetCA returns a CA certificate and its chain. CA certificates are
+// public, so no capability is required.
+func (p *PKIServer) GetCA(ctx context.Context, req *connect.Request[vaultv1.GetCARequest]) (*connect.Response[vaultv1.CACertificate], error) {
+	slog.Info("GetCA", "issuer", req.Msg.Issuer)
+	var res *vaultv1.CACertificate
+	err := p.db.View(func(tx *bbolt.Tx) error {
+		issuer, err := resolveIssuer(tx, req.Msg.Issuer)
+		if err != nil {
+			return err
+		}
+		res, err = p.caProto(tx, issuer)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (p *PKIServer) PutRole(ctx context.Context, req *connect.Request[vaultv1.PKIRole]) (*connect.Response[vaultv1.PutPKIRoleResponse], error) {
+	r := req.Msg
+	slog.Info("PutPKIRole", "role", r.Name, "allowed_domains", r.AllowedDomains)
+	if r.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("role name is required"))
+	}
+	if r.TtlSeconds < 0 || r.MaxTtlSeconds < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("TTLs cannot be negative"))
+	}
+	role := pkiRole{
+		AllowedDomains:   r.AllowedDomains,
+		AllowBareDomains: r.AllowBareDomains,
+		AllowSubdomains:  r.AllowSubdomains,
+		AllowIPSANs:      r.AllowIpSans,
+		TTL:              r.TtlSeconds,
+		MaxTTL:           r.MaxTtlSeconds,
+		KeyType:          cmp.Or(r.KeyType, pkiKeyDefault),
+		KeyUsage:         r.KeyUsage,
+		ExtKeyUsage:      r.ExtKeyUsage,
+	}
+	if role.TTL == 0 {
+		role.TTL = int64(pkiLeafTTL / time.Second)
+	}
+	if role.MaxTTL == 0 {
+		role.MaxTTL = role.TTL
+	}
+	if role.TTL > role.MaxTTL {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ttl_seconds exceeds max_ttl_seconds"))
+	}
+	if len(role.KeyUsage) == 0 {
+		role.KeyUsage = []string{"digital_signature", "key_encipherment"}
+	}
+	if len(role.ExtKeyUsage) == 0 {
+		role.ExtKeyUsage = []string{"server_auth", "client_auth"}
+	}
+	if signingKeyTypes[role.KeyType] == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported key type %q", role.KeyType))
+	}
+	for _, u := range role.KeyUsage {
+		if _, ok := pkiKeyUsages[u]; !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown key usage %q", u))
+		}
+	}
+	for _, u := range role.ExtKeyUsage {
+		if _, ok := pkiExtKeyUsages[u]; !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown extended key usage %q", u))
+		}
+	}
+	if err := p.authorizeSudo(ctx, "pki/roles/"+r.Name); err != nil {
+		return nil, err
+	}
+
+	data, _ := json.Marshal(role)
+	err := p.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketPKIRoles)).Put([]byte(r.Name), data)
+	})
+	p.audit(ctx, "PutPKIRole", "pki/roles/"+r.Name, 0, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutPKIRoleResponse{}), nil
+}
+
+func (p *PKIServer) GetRole(ctx context.Context, req *connect.Request[vaultv1.GetPKIRoleRequest]) (*connect.Response[vaultv1.PKIRole], error) {
+	name := req.Msg.Name
+	slog.Info("GetPKIRole", "role", name)
+	if err := p.authorize(ctx, "read", "pki/roles/"+name); err != nil {
+		return nil, err
+	}
+	role, err := p.loadPKIRole(name)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(role.proto(name)), nil
+}
+
+func (s *VaultServer) loadPKIRole(name string) (*pkiRole, error) {
+	var role pkiRole
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket([]byte(bucketPKIRoles)).Get([]byte(name))
+		if data == nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("PKI role not found: %s", name))
+		}
+		return json.Unmarshal(data, &role)
+	})
+	return &role, err
+}
+
+// IssueCertificate generates a key pair and a certificate for it. The
+// private key is returned once and not stored.
+func (p *PKIServer) IssueCertificate(ctx context.Context, req *connect.Request[vaultv1.IssueCertificateRequest]) (*connect.Response[vaultv1.IssuedCertificate], error) {
+	m := req.Msg
+	path := "pki/issue/" + m.Role
+	slog.Info("IssueCertificate", "role", m.Role, "common_name", m.CommonName)
+	if err := p.authorize(ctx, "update", path); err != nil {
+		p.audit(ctx, "IssueCertificate", path, 0, err)
+		return nil, err
+	}
+	role, err := p.loadPKIRole(m.Role)
+	if err != nil {
+		return nil, err
+	}
+	key, keyDER, err := newPKIKey(role.KeyType)
+	if err != nil {
+		return nil, err
+	}
+	res, err := p.issue(m.Role, role, m.CommonName, m.AltNames, m.IpSans, m.TtlSeconds, key.Public())
+	p.audit(ctx, "IssueCertificate", path, 0, err)
+	if err != nil {
+		return nil, err
+	}
+	res.PrivateKey = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+	return connect.NewResponse(res), nil
+}
+
+// SignCertificate issues a certificate for the public key of a CSR. Names
+// in the request override those in the CSR.
+func (p *PKIServer) SignCertificate(ctx context.Context, req *connect.Request[vaultv1.SignCertificateRequest]) (*connect.Response[vaultv1.IssuedCertificate], error) {
+	m := req.Msg
+	path := "pki/sign/" + m.Role
+	slog.Info("SignCertificate", "role", m.Role, "common_name", m.CommonName)
+	if err := p.authorize(ctx, "update", path); err != nil {
+		p.audit(ctx, "SignCertificate", path, 0, err)
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(m.Csr))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("csr must be a PEM-encoded CERTIFICATE REQUEST"))
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err == nil {
+		err = csr.CheckSignature()
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid csr: %w", err))
+	}
+	role, err := p.loadPKIRole(m.Role)
+	if err != nil {
+		return nil, err
+	}
+	cn, altNames, ipSANs := cmp.Or(m.CommonName, csr.Subject.CommonName), m.AltNames, m.IpSans
+	if len(altNames) == 0 {
+		altNames = csr.DNSNames
+	}
+	if len(ipSANs) == 0 {
+		for _, ip := range csr.IPAddresses {
+			ipSANs = append(ipSANs, ip.String())
+		}
+	}
+	res, err := p.issue(m.Role, role, cn, altNames, ipSANs, m.TtlSeconds, csr.PublicKey)
+	p.audit(ctx, "SignCertificate", path, 0, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// issue checks the names and lifetime against the role and signs a leaf
+// certificate for pub with the issuing CA. The lifetime is capped at the
+// role's maximum and at the CA's own expiry.
+func (p *PKIServer) issue(roleName string, role *pkiRole, cn string, altNames, ipSANs []string, ttlSeconds int64, pub crypto.PublicKey) (*vaultv1.IssuedCertificate, error) {
+	if cn == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("common_name is required"))
+	}
+	names := []string{cn}
+	for _, n := range altNames {
+		if !slices.Contains(names, n) {
+			names = append(names, n)
+		}
+	}
+	for _, n := range names {
+		if !role.allows(n) {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s is not allowed by role %s", n, roleName))
+		}
+	}
+	var ips []net.IP
+	for _, s := range ipSANs {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid IP SAN %q", s))
+		}
+		ips = append(ips, ip)
+	}
+	if len(ips) > 0 && !role.AllowIPSANs {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("role %s does not allow IP SANs", roleName))
+	}
+	ttl, err := pkiTTL(ttlSeconds, time.Duration(role.TTL)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	ttl = min(ttl, time.Duration(role.MaxTTL)*time.Second)
+	var usage x509.KeyUsage
+	for _, u := range role.KeyUsage {
+		usage |= pkiKeyUsages[u]
+	}
+	var extUsage []x509.ExtKeyUsage
+	for _, u := range role.ExtKeyUsage {
+		extUsage = append(extUsage, pkiExtKeyUsages[u])
+	}
+
+	var res *vaultv1.IssuedCertificate
+	err = p.db.Update(func(tx *bbolt.Tx) error {
+		issuer, err := resolveIssuer(tx, "")
+		if err != nil {
+			return err
+		}
+		ca, err := p.getPKICA(tx, issuer)
+		if err != nil {
+			return err
+		}
+		caCert, caKey, err := ca.parse()
+		if err != nil {
+			return err
+		}
+		now := p.now()
+		serial := newSerial()
+		tmpl := &x509.Certificate{
+			SerialNumber:          serial,
+			Subject:               pkix.Name{CommonName: cn},
+			DNSNames:              names,
+			IPAddresses:           ips,
+			NotBefore:             now.Add(-pkiBackdate),
+			NotAfter:              minTime(now.Add(ttl), caCert.NotAfter),
+			KeyUsage:              usage,
+			ExtKeyUsage:           extUsage,
+			BasicConstraintsValid: true,
+			CRLDistributionPoints: ca.CRLDistributionPoints,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, pub, caKey)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := putPKICert(tx, formatSerial(serial), &pkiCert{Issuer: issuer, Role: roleName, Cert: der, NotAfter: tmpl.NotAfter}); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		chain, err := p.pkiChain(tx, issuer)
+		if err != nil {
+			return err
+		}
+		res = &vaultv1.IssuedCertificate{
+			Certificate:  pemCert(der),
+			IssuingCa:    chain[0],
+			CaChain:      chain,
+			SerialNumber: formatSerial(serial),
+			ExpireTime:   tmpl.NotAfter.Unix(),
+		}
+		return nil
+	})
+	return res, err
+}
+
+// RevokeCertificate revokes a leaf or intermediate certificate and
+// re-signs its issuer's CRL. Revoking twice keeps the first revocation.
+func (p *PKIServer) RevokeCertificate(ctx context.Context, req *connect.Request[vaultv1.RevokeCertificateRequest]) (*connect.Response[vaultv1.RevokeCertificateResponse], error) {
+	serial := strings.ToLower(req.Msg.SerialNumber)
+	slog.Info("RevokeCertificate", "serial_number", serial)
+	if err := p.authorize(ctx, "update", "pki/revoke"); err != nil {
+		p.audit(ctx, "RevokeCertificate", "pki/certs/"+serial, 0, err)
+		return nil, err
+	}
+	var revoked time.Time
+	err := p.db.Update(func(tx *bbolt.Tx) error {
+		data := tx.Bucket([]byte(bucketPKICerts)).Get([]byte(serial))
+		if data == nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("certificate not found: %s", serial))
+		}
+		var c pkiCert
+		if err := json.Unmarshal(data, &c); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if !c.RevokeTime.IsZero() {
+			revoked = c.RevokeTime
+			return nil
+		}
+		c.RevokeTime = p.now()
+		revoked = c.RevokeTime
+		if err := putPKICert(tx, serial, &c); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		ca, err := p.getPKICA(tx, c.Issuer)
+		if err != nil {
+			return err
+		}
+		return p.buildCRL(tx, c.Issuer, ca)
+	})
+	p.audit(ctx, "RevokeCertificate", "pki/certs/"+serial, 0, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.RevokeCertificateResponse{RevocationTime: revoked.Unix()}), nil
+}
+
+// GetCRL returns a CA's current CRL. CRLs are public, so no capability is
+// required.
+func (p *PKIServer) GetCRL(ctx context.Context, req *connect.Request[vaultv1.GetCRLRequest]) (*connect.Response[vaultv1.CRL], error) {
+	slog.Info("GetCRL", "issuer", req.Msg.Issuer)
+	issuer, crl, err := p.currentCRL(req.Msg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.CRL{
+		Issuer:     issuer,
+		Crl:        string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl.Raw})),
+		CrlNumber:  crl.Number.Int64(),
+		ThisUpdate: crl.ThisUpdate.Unix(),
+		NextUpdate: crl.NextUpdate.Unix(),
+	}), nil
+}
+
+// CRLHandler serves the CRL named by the "issuer" query parameter, by
+// default the issuing CA's, as DER for relying parties that fetch it from
+// a certificate's distribution point.
+func (p *PKIServer) CRLHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, crl, err := p.currentCRL(r.URL.Query().Get("issuer"))
+		if err != nil {
+			status := http.StatusInternalServerError
+			switch connect.CodeOf(err) {
+			case connect.CodeInvalidArgument:
+				status = http.StatusBadRequest
+			case connect.CodeNotFound, connect.CodeFailedPrecondition:
+				status = http.StatusNotFound
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/pkix-crl")
+		w.Write(crl.Raw)
+	})
+}
+
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
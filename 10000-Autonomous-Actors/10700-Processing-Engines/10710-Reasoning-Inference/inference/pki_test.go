@@ -0,0 +1,222 @@
+package inference
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault/vaultv1connect"
+	"connectrpc.com/connect"
+)
+
+func newPKIClient(t *testing.T, server *VaultServer) vaultv1connect.PKIServiceClient {
+	path, h := vaultv1connect.NewPKIServiceHandler(server.PKI(), connect.WithInterceptors(server.AuthInterceptor()))
+	return vaultv1connect.NewPKIServiceClient(http.DefaultClient, serveTest(t, path, h))
+}
+
+func parsePEMCert(t *testing.T, s string) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		t.Fatalf("no PEM block in %q", s)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("ParseCertificate: %v", err)
+	}
+	return cert
+}
+
+func TestPKIIssueAndVerify(t *testing.T) {
+	server, _, root := newTestClient(t, RequireAuth())
+	pki := newPKIClient(t, server)
+	ctx := context.Background()
+
+	if _, err := pki.IssueCertificate(ctx, withToken(&vaultv1.IssueCertificateRequest{Role: "svc", CommonName: "api.svc.olympus"}, root)); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("IssueCertificate without role: expected NotFound, got %v", err)
+	}
+	if _, err := pki.GenerateIntermediate(ctx, withToken(&vaultv1.GenerateIntermediateRequest{CommonName: "Olympus Issuing CA"}, root)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("GenerateIntermediate without root: expected FailedPrecondition, got %v", err)
+	}
+	rootCA, err := pki.GenerateRoot(ctx, withToken(&vaultv1.GenerateRootRequest{CommonName: "Olympus Root CA"}, root))
+	if err != nil {
+		t.Fatalf("GenerateRoot: %v", err)
+	}
+	if _, err := pki.GenerateRoot(ctx, withToken(&vaultv1.GenerateRootRequest{CommonName: "Again"}, root)); connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Errorf("second GenerateRoot: expected AlreadyExists, got %v", err)
+	}
+	inter, err := pki.GenerateIntermediate(ctx, withToken(&vaultv1.GenerateIntermediateRequest{CommonName: "Olympus Issuing CA", KeyType: "ed25519", CrlDistributionPoints: []string{"https://vault.olympus/v1/pki/crl"}}, root))
+	if err != nil {
+		t.Fatalf("GenerateIntermediate: %v", err)
+	}
+	if len(inter.Msg.CaChain) != 2 || inter.Msg.CaChain[1] != rootCA.Msg.Certificate {
+		t.Errorf("intermediate chain does not end at the root")
+	}
+	if ca, _ := pki.GetCA(ctx, connect.NewRequest(&vaultv1.GetCARequest{})); ca == nil || ca.Msg.Issuer != pkiIntermediate {
+		t.Errorf("GetCA = %v, want the intermediate", ca)
+	}
+
+	_, err = pki.PutRole(ctx, withToken(&vaultv1.PKIRole{
+		Name:            "svc",
+		AllowedDomains:  []string{"svc.olympus"},
+		AllowSubdomains: true,
+		TtlSeconds:      3600,
+		MaxTtlSeconds:   7200,
+		ExtKeyUsage:     []string{"client_auth"},
+	}, root))
+	if err != nil {
+		t.Fatalf("PutRole: %v", err)
+	}
+	if _, err := pki.PutRole(ctx, withToken(&vaultv1.PKIRole{Name: "bad", KeyUsage: []string{"everything"}}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("PutRole with unknown key usage: expected InvalidArgument, got %v", err)
+	}
+
+	issued, err := pki.IssueCertificate(ctx, withToken(&vaultv1.IssueCertificateRequest{Role: "svc", CommonName: "api.svc.olympus", AltNames: []string{"*.api.svc.olympus"}, TtlSeconds: 86400}, root))
+	if err != nil {
+		t.Fatalf("IssueCertificate: %v", err)
+	}
+	leaf := parsePEMCert(t, issued.Msg.Certificate)
+	if got := leaf.NotAfter.Sub(leaf.NotBefore); got > 7200*time.Second+pkiBackdate {
+		t.Errorf("lifetime = %v, want it capped at the role's max TTL", got)
+	}
+	if len(leaf.DNSNames) != 2 || leaf.CRLDistributionPoints[0] != "https://vault.olympus/v1/pki/crl" {
+		t.Errorf("DNSNames = %v, CRLDistributionPoints = %v", leaf.DNSNames, leaf.CRLDistributionPoints)
+	}
+	if issued.Msg.PrivateKey == "" || formatSerial(leaf.SerialNumber) != issued.Msg.SerialNumber {
+		t.Errorf("IssueCertificate response incomplete: %+v", issued.Msg)
+	}
+	roots, inters := x509.NewCertPool(), x509.NewCertPool()
+	roots.AddCert(parsePEMCert(t, rootCA.Msg.Certificate))
+	inters.AddCert(parsePEMCert(t, issued.Msg.IssuingCa))
+	verify := func(usage x509.ExtKeyUsage) error {
+		_, err := leaf.Verify(x509.VerifyOptions{DNSName: "api.svc.olympus", Roots: roots, Intermediates: inters, KeyUsages: []x509.ExtKeyUsage{usage}})
+		return err
+	}
+	if err := verify(x509.ExtKeyUsageClientAuth); err != nil {
+		t.Errorf("client certificate does not verify: %v", err)
+	}
+	if err := verify(x509.ExtKeyUsageServerAuth); err == nil {
+		t.Error("client-only certificate verified for server auth")
+	}
+
+	for _, req := range []*vaultv1.IssueCertificateRequest{
+		{Role: "svc", CommonName: "svc.olympus"},
+		{Role: "svc", CommonName: "api.svc.olympus.evil.com"},
+		{Role: "svc", CommonName: "a.svc.olympus", AltNames: []string{"x*.svc.olympus"}},
+		{Role: "svc", CommonName: "a.svc.olympus", IpSans: []string{"10.0.0.1"}},
+	} {
+		if _, err := pki.IssueCertificate(ctx, withToken(req, root)); connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("IssueCertificate(%s %v %v): expected PermissionDenied, got %v", req.CommonName, req.AltNames, req.IpSans, err)
+		}
+	}
+
+	// A CSR keeps its key; names come from the CSR unless overridden.
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	csrDER, _ := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: "db.svc.olympus"}}, key)
+	signed, err := pki.SignCertificate(ctx, withToken(&vaultv1.SignCertificateRequest{Role: "svc", Csr: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}))}, root))
+	if err != nil {
+		t.Fatalf("SignCertificate: %v", err)
+	}
+	if cert := parsePEMCert(t, signed.Msg.Certificate); cert.Subject.CommonName != "db.svc.olympus" || !key.PublicKey.Equal(cert.PublicKey) || signed.Msg.PrivateKey != "" {
+		t.Errorf("signed certificate = %v for %v", cert.Subject, cert.PublicKey)
+	}
+}
+
+func TestPKIRevocation(t *testing.T) {
+	server, _, root := newTestClient(t)
+	pki := newPKIClient(t, server)
+	ctx := context.Background()
+	clock := time.Now()
+	server.now = func() time.Time { return clock }
+
+	pki.GenerateRoot(ctx, withToken(&vaultv1.GenerateRootRequest{CommonName: "Root"}, root))
+	inter, _ := pki.GenerateIntermediate(ctx, withToken(&vaultv1.GenerateIntermediateRequest{CommonName: "Issuing"}, root))
+	pki.PutRole(ctx, withToken(&vaultv1.PKIRole{Name: "svc", AllowedDomains: []string{"svc.olympus"}, AllowSubdomains: true}, root))
+	issued, err := pki.IssueCertificate(ctx, withToken(&vaultv1.IssueCertificateRequest{Role: "svc", CommonName: "api.svc.olympus"}, root))
+	if err != nil {
+		t.Fatalf("IssueCertificate: %v", err)
+	}
+	crlOf := func(issuer string) (*vaultv1.CRL, *x509.RevocationList) {
+		t.Helper()
+		res, err := pki.GetCRL(ctx, connect.NewRequest(&vaultv1.GetCRLRequest{Issuer: issuer}))
+		if err != nil {
+			t.Fatalf("GetCRL(%q): %v", issuer, err)
+		}
+		block, _ := pem.Decode([]byte(res.Msg.Crl))
+		crl, err := x509.ParseRevocationList(block.Bytes)
+		if err != nil {
+			t.Fatalf("ParseRevocationList: %v", err)
+		}
+		return res.Msg, crl
+	}
+	revoked := func(crl *x509.RevocationList, serial string) bool {
+		for _, e := range crl.RevokedCertificateEntries {
+			if formatSerial(e.SerialNumber) == serial {
+				return true
+			}
+		}
+		return false
+	}
+
+	before, crl := crlOf("")
+	if before.Issuer != pkiIntermediate || len(crl.RevokedCertificateEntries) != 0 {
+		t.Fatalf("initial CRL = %+v with %d entries", before, len(crl.RevokedCertificateEntries))
+	}
+
+	clock = clock.Add(time.Minute)
+	res, err := pki.RevokeCertificate(ctx, withToken(&vaultv1.RevokeCertificateRequest{SerialNumber: issued.Msg.SerialNumber}, root))
+	if err != nil || res.Msg.RevocationTime != clock.Unix() {
+		t.Fatalf("RevokeCertificate = %v, %v", res, err)
+	}
+	after, crl := crlOf("")
+	if !revoked(crl, issued.Msg.SerialNumber) || after.CrlNumber <= before.CrlNumber {
+		t.Errorf("CRL after revocation = %+v, revoked = %v", after, revoked(crl, issued.Msg.SerialNumber))
+	}
+	if err := crl.CheckSignatureFrom(parsePEMCert(t, inter.Msg.Certificate)); err != nil {
+		t.Errorf("CRL is not signed by the intermediate: %v", err)
+	}
+	clock = clock.Add(time.Minute)
+	if again, _ := pki.RevokeCertificate(ctx, withToken(&vaultv1.RevokeCertificateRequest{SerialNumber: issued.Msg.SerialNumber}, root)); again.Msg.RevocationTime != res.Msg.RevocationTime {
+		t.Errorf("second revocation moved the revocation time")
+	}
+	if _, err := pki.RevokeCertificate(ctx, withToken(&vaultv1.RevokeCertificateRequest{SerialNumber: "01:02"}, root)); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("RevokeCertificate of unknown serial: expected NotFound, got %v", err)
+	}
+
+	// Revoking the intermediate lands on the root's CRL.
+	pki.RevokeCertificate(ctx, withToken(&vaultv1.RevokeCertificateRequest{SerialNumber: inter.Msg.SerialNumber}, root))
+	if _, crl := crlOf(pkiRoot); !revoked(crl, inter.Msg.SerialNumber) || revoked(crl, issued.Msg.SerialNumber) {
+		t.Errorf("root CRL does not list exactly the intermediate")
+	}
+
+	ts := httptest.NewServer(server.PKI().CRLHandler())
+	defer ts.Close()
+	httpRes, err := http.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("GET CRL: %v", err)
+	}
+	defer httpRes.Body.Close()
+	der, _ := io.ReadAll(httpRes.Body)
+	if httpRes.Header.Get("Content-Type") != "application/pkix-crl" {
+		t.Errorf("Content-Type = %q", httpRes.Header.Get("Content-Type"))
+	}
+	if crl, err := x509.ParseRevocationList(der); err != nil || !revoked(crl, issued.Msg.SerialNumber) {
+		t.Errorf("served CRL = %v, %v", crl, err)
+	}
+
+	// The CRL is re-signed once half its lifetime has passed.
+	clock = clock.Add(crlLifetime/2 + time.Minute)
+	if later, _ := crlOf(""); later.CrlNumber <= after.CrlNumber || later.ThisUpdate != clock.Unix() {
+		t.Errorf("stale CRL was not re-signed: %+v", later)
+	}
+}
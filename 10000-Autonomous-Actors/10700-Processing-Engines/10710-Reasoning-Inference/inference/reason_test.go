@@ -0,0 +1,63 @@
+package inference
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+func TestAccessReasons(t *testing.T) {
+	server, client, root := newTestClient(t)
+	ctx := context.Background()
+
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "customer/pii", Value: "v"}, root))
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name:  "support",
+		Rules: []*vaultv1.PolicyRule{{Path: "customer/*", Capabilities: []string{"read"}, RequireReason: true}},
+	}}, root))
+	support, _ := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{Policies: []string{"support"}, DisplayName: "support"}, root))
+
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "customer/pii"}, support.Msg.Token)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Expected read without reason to be refused, got %v", err)
+	}
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "customer/pii", AccessReason: "CASE-42 customer request"}, support.Msg.Token)); err != nil {
+		t.Fatalf("Expected read with reason to succeed: %v", err)
+	}
+	if _, err := client.GetSecretVersion(ctx, withToken(&vaultv1.GetSecretVersionRequest{Key: "customer/pii", Version: 1}, root)); err != nil {
+		t.Fatalf("Expected root read without reason to succeed: %v", err)
+	}
+
+	if _, err := client.GetAccessHistory(ctx, withToken(&vaultv1.GetAccessHistoryRequest{Key: "customer/pii"}, support.Msg.Token)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected history to need its own grant, got %v", err)
+	}
+	res, err := client.GetAccessHistory(ctx, withToken(&vaultv1.GetAccessHistoryRequest{Key: "customer/pii"}, root))
+	if err != nil {
+		t.Fatalf("GetAccessHistory failed: %v", err)
+	}
+	records := res.Msg.Records
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 read records, got %d", len(records))
+	}
+	if records[0].Operation != "GetSecretVersion" || records[1].Reason != "CASE-42 customer request" || records[1].Identity != "support" || records[2].Outcome != "error" {
+		t.Errorf("Unexpected history: %v", records)
+	}
+	if res, _ := client.GetAccessHistory(ctx, withToken(&vaultv1.GetAccessHistoryRequest{Key: "customer"}, root)); len(res.Msg.Records) != 0 {
+		t.Errorf("Expected no history for an unread key, got %v", res.Msg.Records)
+	}
+
+	// A log written before the index existed is indexed on open.
+	server.db.Update(func(tx *bbolt.Tx) error { return tx.DeleteBucket([]byte(bucketAuditIndex)) })
+	dir := filepath.Dir(server.db.Path())
+	server.Close()
+	server = NewVaultServer(dir)
+	t.Cleanup(func() { server.Close() })
+	client = serveTestClient(t, server)
+	res, err = client.GetAccessHistory(ctx, withToken(&vaultv1.GetAccessHistoryRequest{Key: "customer/pii", Limit: 2}, root))
+	if err != nil || len(res.Msg.Records) != 2 || res.Msg.Records[0].Operation != "GetSecretVersion" {
+		t.Errorf("Expected history rebuilt on open, got %v %v", res, err)
+	}
+}
@@ -0,0 +1,52 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/410-Interaction-Models/410-100-Resonance-Bus"
+	"go.etcd.io/bbolt"
+)
+
+// WithBus broadcasts vault pulses (secret changes, policy reloads,
+// seal state and replication lag) on the Resonance Bus.
+func WithBus(b *resonance.Bus) Option {
+	return func(s *VaultServer) { s.bus = b }
+}
+
+// ReplicationLag reports how far event subscribers trail the vault: the
+// number of undelivered outbox entries and the age of the oldest one.
+func (s *VaultServer) ReplicationLag() (pending int, lag time.Duration, err error) {
+	now := s.now()
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketOutbox)).ForEach(func(_, v []byte) error {
+			var e outboxEntry
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			pending++
+			lag = max(lag, now.Sub(e.Event.PublishTime))
+			return nil
+		})
+	})
+	return pending, lag, err
+}
+
+// pulseReplicationLag emits a REPLICATION-LAG pulse while subscribers are
+// behind, and once more when they catch up. It returns the pending count
+// for the next call.
+func (s *VaultServer) pulseReplicationLag(last int) int {
+	pending, lag, err := s.ReplicationLag()
+	if err != nil {
+		slog.Error("Replication lag check failed", "error", err)
+		return last
+	}
+	if pending > 0 || last > 0 {
+		s.bus.Emit(resonance.ReplicationLag, "outbox", fmt.Sprintf("lag=%s pending=%d", lag.Truncate(time.Second), pending))
+	}
+	return pending
+}
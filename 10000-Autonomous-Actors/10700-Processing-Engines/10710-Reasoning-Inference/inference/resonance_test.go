@@ -0,0 +1,87 @@
+package inference
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/410-Interaction-Models/410-100-Resonance-Bus"
+)
+
+func TestResonancePulses(t *testing.T) {
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	lb := resonance.NewLoopback()
+	pulses := lb.Subscribe(32)
+	bus := resonance.NewBus("test", lb)
+	server, client, root := newTestClient(t, WithBus(bus), WithClock(func() time.Time { return clock }))
+	ctx := context.Background()
+
+	next := func(want resonance.Kind) resonance.Pulse {
+		t.Helper()
+		select {
+		case p := <-pulses:
+			if p.Kind != want {
+				t.Fatalf("pulse kind = %s (%q %q), want %s", p.Kind, p.Subject, p.Detail, want)
+			}
+			return p
+		case <-time.After(2 * time.Second):
+			t.Fatalf("no %s pulse", want)
+		}
+		return resonance.Pulse{}
+	}
+
+	next(resonance.Unsealed)
+
+	client.PutWebhook(ctx, withToken(&vaultv1.PutWebhookRequest{Webhook: &vaultv1.Webhook{Name: "deploy", Url: "http://127.0.0.1:1", Secret: "s"}}, root))
+	if _, err := client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "app/db", Value: "pw"}, root)); err != nil {
+		t.Fatalf("VaultWrite: %v", err)
+	}
+	if p := next(resonance.SecretChanged); p.Subject != "app/db" || p.Detail != "version=1" {
+		t.Errorf("unexpected secret pulse %+v", p)
+	}
+
+	_, err := client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name:  "ops",
+		Rules: []*vaultv1.PolicyRule{{Path: "app/*", Capabilities: []string{"read"}}},
+	}}, root))
+	if err != nil {
+		t.Fatalf("PutPolicy: %v", err)
+	}
+	if p := next(resonance.PolicyReloaded); p.Subject != "ops" {
+		t.Errorf("unexpected policy pulse %+v", p)
+	}
+
+	clock = clock.Add(90 * time.Second)
+	pending, lag, err := server.ReplicationLag()
+	if err != nil || pending != 2 || lag != 90*time.Second {
+		t.Fatalf("ReplicationLag = %d, %s, %v; want 2 events 90s behind", pending, lag, err)
+	}
+	if server.pulseReplicationLag(0) != 2 {
+		t.Error("pending count not carried forward")
+	}
+	if p := next(resonance.ReplicationLag); p.Detail != "lag=1m30s pending=2" {
+		t.Errorf("unexpected lag pulse %+v", p)
+	}
+
+	// Versions destroyed by retention are announced like reaped ones.
+	client.PutRetentionPolicy(ctx, withToken(&vaultv1.PutRetentionPolicyRequest{Key: "app/db", Policy: &vaultv1.RetentionPolicy{MaxAgeSeconds: 60}}, root))
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "app/db", Value: "pw2"}, root))
+	if p := next(resonance.SecretChanged); p.Detail != "destroyed=1" {
+		t.Errorf("unexpected prune pulse %+v", p)
+	}
+	next(resonance.SecretChanged)
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "app/db", Value: "pw3"}, root))
+	next(resonance.SecretChanged)
+	clock = clock.Add(2 * time.Minute)
+	if n, err := server.Compact(ctx); err != nil || n != 1 {
+		t.Fatalf("Compact = %d, %v; want 1", n, err)
+	}
+	if p := next(resonance.SecretChanged); p.Subject != "app/db" || p.Detail != "destroyed=2" {
+		t.Errorf("unexpected compaction pulse %+v", p)
+	}
+
+	server.Close()
+	next(resonance.Sealed)
+	bus.Close()
+}
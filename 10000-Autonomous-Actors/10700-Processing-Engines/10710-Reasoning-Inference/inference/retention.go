@@ -0,0 +1,180 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/410-Interaction-Models/410-100-Resonance-Bus"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+const defaultRetentionKey = "default"
+
+type retentionPolicy struct {
+	MaxVersions int32 `json:"max_versions,omitempty"`
+	MaxAge      int64 `json:"max_age,omitempty"`
+	KeepLast    int32 `json:"keep_last,omitempty"`
+}
+
+func (p *retentionPolicy) proto() *vaultv1.RetentionPolicy {
+	if p == nil {
+		return nil
+	}
+	return &vaultv1.RetentionPolicy{MaxVersions: p.MaxVersions, MaxAgeSeconds: p.MaxAge, KeepLast: p.KeepLast}
+}
+
+// effectiveRetention returns the secret's own policy, else the default.
+func effectiveRetention(tx *bbolt.Tx, m *secretMeta) *retentionPolicy {
+	if m.Retention != nil {
+		return m.Retention
+	}
+	data := tx.Bucket([]byte(bucketRetention)).Get([]byte(defaultRetentionKey))
+	if data == nil {
+		return nil
+	}
+	var p retentionPolicy
+	if json.Unmarshal(data, &p) != nil {
+		return nil
+	}
+	return &p
+}
+
+// prune destroys the payloads of versions that fall outside p, oldest
+// first, and returns their numbers. history and m are updated in place;
+// numbering and metadata are preserved.
+func (p *retentionPolicy) prune(history []string, m *secretMeta, now time.Time) []int32 {
+	if p == nil {
+		return nil
+	}
+	for len(m.Versions) < len(history) {
+		m.Versions = append(m.Versions, versionMeta{})
+	}
+	var live []int
+	for i := range history {
+		if !m.Versions[i].Destroyed {
+			live = append(live, i)
+		}
+	}
+	keep := max(int(p.KeepLast), 1)
+	remaining := len(live)
+
+	var destroyed []int32
+	for n, i := range live {
+		if len(live)-n <= keep {
+			break
+		}
+		v := &m.Versions[i]
+		tooMany := p.MaxVersions > 0 && remaining > int(p.MaxVersions)
+		tooOld := p.MaxAge > 0 && !v.CreateTime.IsZero() && now.Sub(v.CreateTime) > time.Duration(p.MaxAge)*time.Second
+		if !tooMany && !tooOld {
+			continue
+		}
+		history[i] = ""
+		v.Destroyed = true
+		remaining--
+		destroyed = append(destroyed, int32(i+1))
+	}
+	return destroyed
+}
+
+func (s *VaultServer) PutRetentionPolicy(ctx context.Context, req *connect.Request[vaultv1.PutRetentionPolicyRequest]) (*connect.Response[vaultv1.PutRetentionPolicyResponse], error) {
+	key := req.Msg.Key
+	slog.Info("PutRetentionPolicy", "key", key)
+	resource := "sys/retention/" + defaultRetentionKey
+	if key != "" {
+		resource = "sys/retention/" + key
+	}
+	if err := s.authorizeSudo(ctx, resource); err != nil {
+		return nil, err
+	}
+	var policy *retentionPolicy
+	if p := req.Msg.Policy; p != nil {
+		if p.MaxVersions < 0 || p.MaxAgeSeconds < 0 || p.KeepLast < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("retention limits must not be negative"))
+		}
+		policy = &retentionPolicy{MaxVersions: p.MaxVersions, MaxAge: p.MaxAgeSeconds, KeepLast: p.KeepLast}
+	}
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		if key == "" {
+			b := tx.Bucket([]byte(bucketRetention))
+			if policy == nil {
+				return b.Delete([]byte(defaultRetentionKey))
+			}
+			data, _ := json.Marshal(policy)
+			return b.Put([]byte(defaultRetentionKey), data)
+		}
+		if _, ok := historyLen(tx, key); !ok {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("secret not found: %s", key))
+		}
+		m := getSecretMeta(tx, key)
+		m.Retention = policy
+		if err := s.enqueueEvent(tx, m, key, eventUpdate, 0); err != nil {
+			return err
+		}
+		return putSecretMeta(tx, key, m)
+	})
+	s.audit(ctx, "PutRetentionPolicy", key, 0, err)
+	if err != nil {
+		return nil, readError(err)
+	}
+	return connect.NewResponse(&vaultv1.PutRetentionPolicyResponse{}), nil
+}
+
+// Compact applies retention policies to every secret, catching versions
+// that have aged out since their last write. It returns how many
+// versions were destroyed.
+func (s *VaultServer) Compact(ctx context.Context) (int, error) {
+	now := s.now()
+	pruned := map[string][]int32{}
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		h := tx.Bucket([]byte(bucketHistory))
+		var keys []string
+		h.ForEach(func(k, _ []byte) error {
+			keys = append(keys, string(k))
+			return nil
+		})
+		for _, key := range keys {
+			m := getSecretMeta(tx, key)
+			var history []string
+			json.Unmarshal(h.Get([]byte(key)), &history)
+			destroyed := effectiveRetention(tx, m).prune(history, m, now)
+			if len(destroyed) == 0 {
+				continue
+			}
+			data, _ := json.Marshal(history)
+			if err := h.Put([]byte(key), data); err != nil {
+				return err
+			}
+			for _, v := range destroyed {
+				if err := s.enqueueEvent(tx, m, key, eventVersionDestroy, v); err != nil {
+					return err
+				}
+			}
+			if err := putSecretMeta(tx, key, m); err != nil {
+				return err
+			}
+			pruned[key] = destroyed
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for key, versions := range pruned {
+		for _, v := range versions {
+			s.audit(ctx, "PruneVersion", key, v, nil)
+			s.bus.Emit(resonance.SecretChanged, key, fmt.Sprintf("destroyed=%d", v))
+			n++
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,67 @@
+package inference
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+)
+
+func TestRetentionPolicies(t *testing.T) {
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	server, client, root := newTestClient(t, WithClock(func() time.Time { return clock }))
+	ctx := context.Background()
+	write := func(key, val string) {
+		if _, err := client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: key, Value: val}, root)); err != nil {
+			t.Fatalf("VaultWrite failed: %v", err)
+		}
+	}
+	destroyed := func(key string) []int32 {
+		res, err := client.GetSecretMetadata(ctx, withToken(&vaultv1.GetSecretMetadataRequest{Key: key}, root))
+		if err != nil {
+			t.Fatalf("GetSecretMetadata failed: %v", err)
+		}
+		var out []int32
+		for _, v := range res.Msg.Versions {
+			if v.Destroyed {
+				out = append(out, v.Version)
+			}
+		}
+		return out
+	}
+
+	// A default of three versions is enforced on write.
+	if _, err := client.PutRetentionPolicy(ctx, withToken(&vaultv1.PutRetentionPolicyRequest{Policy: &vaultv1.RetentionPolicy{MaxVersions: 3}}, root)); err != nil {
+		t.Fatalf("PutRetentionPolicy failed: %v", err)
+	}
+	for _, v := range []string{"a", "b", "c", "d", "e"} {
+		write("rotating", v)
+	}
+	if got := destroyed("rotating"); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("Expected versions 1 and 2 destroyed, got %v", got)
+	}
+	if _, err := client.GetSecretVersion(ctx, withToken(&vaultv1.GetSecretVersionRequest{Key: "rotating", Version: 1}, root)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Expected pruned version to be unreadable, got %v", err)
+	}
+	if res, _ := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "rotating"}, root)); res.Msg.Version != 5 {
+		t.Errorf("Expected numbering preserved at version 5, got %d", res.Msg.Version)
+	}
+
+	// A per-secret age limit is applied by compaction, keeping the last two.
+	write("aging", "1")
+	write("aging", "2")
+	write("aging", "3")
+	client.PutRetentionPolicy(ctx, withToken(&vaultv1.PutRetentionPolicyRequest{Key: "aging", Policy: &vaultv1.RetentionPolicy{MaxAgeSeconds: 3600, KeepLast: 2}}, root))
+	clock = clock.Add(2 * time.Hour)
+	if got := destroyed("aging"); len(got) != 0 {
+		t.Errorf("Expected nothing destroyed before compaction, got %v", got)
+	}
+	if n, err := server.Compact(ctx); err != nil || n != 1 {
+		t.Fatalf("Expected compaction to prune one version, got %d %v", n, err)
+	}
+	if got := destroyed("aging"); len(got) != 1 || got[0] != 1 {
+		t.Errorf("Expected version 1 destroyed, got %v", got)
+	}
+}
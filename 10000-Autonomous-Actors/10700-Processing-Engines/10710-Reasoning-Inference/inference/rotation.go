@@ -0,0 +1,255 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault/vaultv1connect"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	rotatorTimeout     = 30 * time.Second
+	rotationBackoff    = 30 * time.Second
+	maxRotationBackoff = time.Hour
+)
+
+// rotationCaller is the identity rotation writes are recorded under.
+var rotationCaller = &Caller{Identity: "rotation", Source: "system"}
+
+type rotationEntry struct {
+	Period       int64     `json:"period"`
+	NextRotation time.Time `json:"next_rotation"`
+	Command      []string  `json:"command,omitempty"`
+	Endpoint     string    `json:"endpoint,omitempty"`
+	LastRotation time.Time `json:"last_rotation,omitzero"`
+	LastError    string    `json:"last_error,omitempty"`
+	Failures     int32     `json:"failures,omitempty"`
+}
+
+func (r *rotationEntry) proto() *vaultv1.Rotation {
+	return &vaultv1.Rotation{
+		PeriodSeconds:       r.Period,
+		NextRotationTime:    r.NextRotation.Unix(),
+		Rotator:             &vaultv1.Rotator{Command: r.Command, Endpoint: r.Endpoint},
+		LastRotationTime:    unixOrZero(r.LastRotation),
+		LastError:           r.LastError,
+		ConsecutiveFailures: r.Failures,
+	}
+}
+
+// backoff doubles from rotationBackoff per consecutive failure, capped by
+// the period and by maxRotationBackoff.
+func (r *rotationEntry) backoff() time.Duration {
+	d := rotationBackoff << min(r.Failures-1, 16)
+	return min(d, time.Duration(r.Period)*time.Second, maxRotationBackoff)
+}
+
+// rotate asks the configured rotator for the next value of key.
+func (r *rotationEntry) rotate(ctx context.Context, key string, version int32) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, rotatorTimeout)
+	defer cancel()
+	if len(r.Command) > 0 {
+		cmd := exec.CommandContext(ctx, r.Command[0], r.Command[1:]...)
+		cmd.Env = append(os.Environ(), "VAULT_SECRET_KEY="+key, "VAULT_SECRET_VERSION="+strconv.Itoa(int(version)))
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("rotator %s: %w: %s", r.Command[0], err, strings.TrimSpace(stderr.String()))
+		}
+		return strings.TrimRight(string(out), "\r\n"), nil
+	}
+	client := vaultv1connect.NewRotatorServiceClient(http.DefaultClient, r.Endpoint)
+	res, err := client.Rotate(ctx, connect.NewRequest(&vaultv1.RotateRequest{Key: key, CurrentVersion: version}))
+	if err != nil {
+		return "", fmt.Errorf("rotator %s: %w", r.Endpoint, err)
+	}
+	return res.Msg.Value, nil
+}
+
+func getRotation(tx *bbolt.Tx, key string) (*rotationEntry, error) {
+	data := tx.Bucket([]byte(bucketRotations)).Get([]byte(key))
+	if data == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no rotation configured for %s", key))
+	}
+	var r rotationEntry
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &r, nil
+}
+
+func putRotation(tx *bbolt.Tx, key string, r *rotationEntry) error {
+	data, _ := json.Marshal(r)
+	return tx.Bucket([]byte(bucketRotations)).Put([]byte(key), data)
+}
+
+func (s *VaultServer) PutRotation(ctx context.Context, req *connect.Request[vaultv1.PutRotationRequest]) (*connect.Response[vaultv1.Rotation], error) {
+	key := req.Msg.Key
+	slog.Info("PutRotation", "key", key)
+	if key == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key is required"))
+	}
+	// Rotators run code on the vault host, so configuring one is an
+	// administrative act.
+	if err := s.authorizeSudo(ctx, "sys/rotation/"+key); err != nil {
+		return nil, err
+	}
+	rot := req.Msg.Rotation
+	if rot == nil {
+		err := s.db.Update(func(tx *bbolt.Tx) error {
+			return tx.Bucket([]byte(bucketRotations)).Delete([]byte(key))
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return connect.NewResponse(&vaultv1.Rotation{}), nil
+	}
+
+	if rot.PeriodSeconds <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("period_seconds must be positive"))
+	}
+	rotator := rot.Rotator
+	if rotator == nil || (len(rotator.Command) == 0) == (rotator.Endpoint == "") {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("exactly one of rotator command or endpoint is required"))
+	}
+	r := &rotationEntry{Period: rot.PeriodSeconds, Command: rotator.Command, Endpoint: rotator.Endpoint}
+	if rot.NextRotationTime != 0 {
+		r.NextRotation = time.Unix(rot.NextRotationTime, 0)
+	} else {
+		r.NextRotation = s.now().Add(time.Duration(r.Period) * time.Second)
+	}
+	err := s.db.Update(func(tx *bbolt.Tx) error { return putRotation(tx, key, r) })
+	s.audit(ctx, "PutRotation", key, 0, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(r.proto()), nil
+}
+
+func (s *VaultServer) GetRotation(ctx context.Context, req *connect.Request[vaultv1.GetRotationRequest]) (*connect.Response[vaultv1.Rotation], error) {
+	slog.Info("GetRotation", "key", req.Msg.Key)
+	if err := s.authorize(ctx, "read", "sys/rotation/"+req.Msg.Key); err != nil {
+		return nil, err
+	}
+	var r *rotationEntry
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		r, err = getRotation(tx, req.Msg.Key)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(r.proto()), nil
+}
+
+// RotateDue rotates every secret whose next rotation time has passed. A
+// failed rotation is retried with exponential backoff. It returns the
+// number of secrets rotated.
+func (s *VaultServer) RotateDue(ctx context.Context) (int, error) {
+	now := s.now()
+	due := map[string]*rotationEntry{}
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketRotations)).ForEach(func(k, v []byte) error {
+			var r rotationEntry
+			if err := json.Unmarshal(v, &r); err != nil {
+				return err
+			}
+			if !now.Before(r.NextRotation) {
+				due[string(k)] = &r
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	ctx = WithCaller(ctx, rotationCaller)
+	rotated := 0
+	for key, r := range due {
+		if err := s.rotateSecret(ctx, key, r); err != nil {
+			slog.Warn("Rotation failed", "key", key, "failures", r.Failures, "retry_at", r.NextRotation, "error", err)
+			continue
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
+// rotateSecret runs one rotation attempt and records its outcome on r.
+func (s *VaultServer) rotateSecret(ctx context.Context, key string, r *rotationEntry) error {
+	var current int32
+	s.db.View(func(tx *bbolt.Tx) error {
+		current, _ = historyLen(tx, key)
+		return nil
+	})
+
+	val, err := r.rotate(ctx, key, current)
+	var version int32
+	if err == nil {
+		version, err = s.writeSecret(ctx, key, val, "", time.Time{}, eventRotate)
+	}
+	now := s.now()
+	if err != nil {
+		r.Failures++
+		r.LastError = err.Error()
+		r.NextRotation = now.Add(r.backoff())
+	} else {
+		r.Failures, r.LastError = 0, ""
+		r.LastRotation = now
+		r.NextRotation = now.Add(time.Duration(r.Period) * time.Second)
+	}
+	s.audit(ctx, "RotateSecret", key, version, err)
+
+	if perr := s.db.Update(func(tx *bbolt.Tx) error {
+		// The schedule may have been removed or reconfigured while the
+		// rotator ran; only the outcome of this attempt is recorded.
+		if tx.Bucket([]byte(bucketRotations)).Get([]byte(key)) == nil {
+			return nil
+		}
+		cur, err := getRotation(tx, key)
+		if err != nil {
+			return err
+		}
+		cur.Failures, cur.LastError = r.Failures, r.LastError
+		cur.LastRotation, cur.NextRotation = r.LastRotation, r.NextRotation
+		return putRotation(tx, key, cur)
+	}); perr != nil {
+		slog.Error("Failed to record rotation", "key", key, "error", perr)
+	}
+	return err
+}
+
+// RunRotations calls RotateDue every interval until ctx is done.
+func (s *VaultServer) RunRotations(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if n, err := s.RotateDue(ctx); err != nil {
+				slog.Error("Rotation scan failed", "error", err)
+			} else if n > 0 {
+				slog.Info("Rotated secrets", "count", n)
+			}
+		}
+	}
+}
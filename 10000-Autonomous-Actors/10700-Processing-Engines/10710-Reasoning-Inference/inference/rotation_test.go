@@ -0,0 +1,117 @@
+package inference
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault/vaultv1connect"
+	"connectrpc.com/connect"
+)
+
+type fakeRotator struct{}
+
+func (fakeRotator) Rotate(_ context.Context, req *connect.Request[vaultv1.RotateRequest]) (*connect.Response[vaultv1.RotateResponse], error) {
+	return connect.NewResponse(&vaultv1.RotateResponse{Value: "from-endpoint-" + req.Msg.Key}), nil
+}
+
+// reconfiguringRotator replaces the schedule it is serving mid-rotation.
+type reconfiguringRotator struct {
+	reconfigure func()
+}
+
+func (r reconfiguringRotator) Rotate(_ context.Context, req *connect.Request[vaultv1.RotateRequest]) (*connect.Response[vaultv1.RotateResponse], error) {
+	r.reconfigure()
+	return connect.NewResponse(&vaultv1.RotateResponse{Value: "rotated"}), nil
+}
+
+func TestScheduledRotation(t *testing.T) {
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	server, client, root := newTestClient(t, WithClock(func() time.Time { return clock }))
+	ctx := context.Background()
+
+	path, h := vaultv1connect.NewRotatorServiceHandler(fakeRotator{})
+	rotator := serveTest(t, path, h)
+
+	for _, key := range []string{"exec/key", "rpc/key", "broken/key"} {
+		client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: key, Value: "initial"}, root))
+	}
+	put := func(key string, r *vaultv1.Rotator) {
+		if _, err := client.PutRotation(ctx, withToken(&vaultv1.PutRotationRequest{Key: key, Rotation: &vaultv1.Rotation{PeriodSeconds: 3600, Rotator: r}}, root)); err != nil {
+			t.Fatalf("PutRotation failed: %v", err)
+		}
+	}
+	put("exec/key", &vaultv1.Rotator{Command: []string{"sh", "-c", "echo rotated-$VAULT_SECRET_VERSION"}})
+	put("rpc/key", &vaultv1.Rotator{Endpoint: rotator})
+	put("broken/key", &vaultv1.Rotator{Command: []string{"false"}})
+
+	if n, _ := server.RotateDue(ctx); n != 0 {
+		t.Fatalf("Expected nothing due yet, rotated %d", n)
+	}
+	clock = clock.Add(time.Hour)
+	if n, err := server.RotateDue(ctx); err != nil || n != 2 {
+		t.Fatalf("Expected two rotations, got %d %v", n, err)
+	}
+
+	read := func(key string) string {
+		res, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: key}, root))
+		if err != nil {
+			t.Fatalf("VaultRead failed: %v", err)
+		}
+		return res.Msg.Value
+	}
+	if v := read("exec/key"); v != "rotated-1" {
+		t.Errorf("Expected exec rotator value, got %q", v)
+	}
+	if v := read("rpc/key"); v != "from-endpoint-rpc/key" {
+		t.Errorf("Expected endpoint rotator value, got %q", v)
+	}
+
+	res, _ := client.GetRotation(ctx, withToken(&vaultv1.GetRotationRequest{Key: "broken/key"}, root))
+	if r := res.Msg; r.ConsecutiveFailures != 1 || r.LastError == "" || r.NextRotationTime != clock.Add(rotationBackoff).Unix() {
+		t.Errorf("Expected failure recorded with backoff, got %v", r)
+	}
+	clock = clock.Add(rotationBackoff)
+	server.RotateDue(ctx)
+	res, _ = client.GetRotation(ctx, withToken(&vaultv1.GetRotationRequest{Key: "broken/key"}, root))
+	if r := res.Msg; r.ConsecutiveFailures != 2 || r.NextRotationTime != clock.Add(2*rotationBackoff).Unix() {
+		t.Errorf("Expected backoff to double, got %v", r)
+	}
+	res, _ = client.GetRotation(ctx, withToken(&vaultv1.GetRotationRequest{Key: "exec/key"}, root))
+	if r := res.Msg; r.NextRotationTime != clock.Add(-rotationBackoff).Add(time.Hour).Unix() || r.ConsecutiveFailures != 0 {
+		t.Errorf("Expected next rotation one period after success, got %v", r)
+	}
+}
+
+func TestRotationKeepsConcurrentReconfiguration(t *testing.T) {
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	server, client, root := newTestClient(t, WithClock(func() time.Time { return clock }))
+	ctx := context.Background()
+
+	replacement := &vaultv1.Rotator{Command: []string{"sh", "-c", "echo replaced"}}
+	path, h := vaultv1connect.NewRotatorServiceHandler(reconfiguringRotator{reconfigure: func() {
+		if _, err := client.PutRotation(ctx, withToken(&vaultv1.PutRotationRequest{Key: "app/key", Rotation: &vaultv1.Rotation{PeriodSeconds: 60, Rotator: replacement}}, root)); err != nil {
+			t.Errorf("PutRotation during rotation failed: %v", err)
+		}
+	}})
+	rotator := serveTest(t, path, h)
+
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "app/key", Value: "initial"}, root))
+	client.PutRotation(ctx, withToken(&vaultv1.PutRotationRequest{Key: "app/key", Rotation: &vaultv1.Rotation{PeriodSeconds: 3600, Rotator: &vaultv1.Rotator{Endpoint: rotator}}}, root))
+	clock = clock.Add(time.Hour)
+	if n, err := server.RotateDue(ctx); err != nil || n != 1 {
+		t.Fatalf("Expected one rotation, got %d %v", n, err)
+	}
+
+	res, err := client.GetRotation(ctx, withToken(&vaultv1.GetRotationRequest{Key: "app/key"}, root))
+	if err != nil {
+		t.Fatalf("GetRotation failed: %v", err)
+	}
+	if r := res.Msg; r.PeriodSeconds != 60 || r.Rotator.Endpoint != "" || len(r.Rotator.Command) != 3 {
+		t.Errorf("Expected the new schedule to survive the rotation, got %v", r)
+	}
+	if r := res.Msg; r.LastRotationTime != clock.Unix() || r.ConsecutiveFailures != 0 {
+		t.Errorf("Expected the rotation outcome to be recorded, got %v", r)
+	}
+}
@@ -0,0 +1,267 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"hash"
+	"log/slog"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+)
+
+// signingKeyTypes generates a private key for each transit key type that
+// supports Sign and Verify.
+var signingKeyTypes = map[string]func() (crypto.Signer, error){
+	"ed25519": func() (crypto.Signer, error) {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	},
+	"ecdsa-p256": func() (crypto.Signer, error) { return ecdsa.GenerateKey(elliptic.P256(), rand.Reader) },
+	"rsa-2048":   func() (crypto.Signer, error) { return rsa.GenerateKey(rand.Reader, 2048) },
+	"rsa-4096":   func() (crypto.Signer, error) { return rsa.GenerateKey(rand.Reader, 4096) },
+}
+
+var transitHashes = map[string]crypto.Hash{
+	"":         crypto.SHA256,
+	"sha2-256": crypto.SHA256,
+	"sha2-384": crypto.SHA384,
+	"sha2-512": crypto.SHA512,
+}
+
+func transitHash(name string) (crypto.Hash, error) {
+	h, ok := transitHashes[name]
+	if !ok {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported hash algorithm %q", name))
+	}
+	return h, nil
+}
+
+func newHash(h crypto.Hash) func() hash.Hash {
+	switch h {
+	case crypto.SHA384:
+		return sha512.New384
+	case crypto.SHA512:
+		return sha512.New
+	}
+	return sha256.New
+}
+
+func digest(h crypto.Hash, input []byte) []byte {
+	d := newHash(h)()
+	d.Write(input)
+	return d.Sum(nil)
+}
+
+func generateSigningKey(typ string) ([]byte, error) {
+	key, err := signingKeyTypes[typ]()
+	if err != nil {
+		return nil, err
+	}
+	return x509.MarshalPKCS8PrivateKey(key)
+}
+
+func (k *transitKey) signer(version int32) (crypto.Signer, error) {
+	if signingKeyTypes[k.Type] == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key type %s does not support signing", k.Type))
+	}
+	key, err := x509.ParsePKCS8PrivateKey(k.Versions[version-1].Key)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return key.(crypto.Signer), nil
+}
+
+func (k *transitKey) sign(version int32, input []byte, hashAlg, sigAlg string) ([]byte, error) {
+	signer, err := k.signer(version)
+	if err != nil {
+		return nil, err
+	}
+	h, err := transitHash(hashAlg)
+	if err != nil {
+		return nil, err
+	}
+	var sig []byte
+	switch key := signer.(type) {
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(key, input)
+	case *ecdsa.PrivateKey:
+		sig, err = ecdsa.SignASN1(rand.Reader, key, digest(h, input))
+	case *rsa.PrivateKey:
+		switch sigAlg {
+		case "", "pss":
+			sig, err = rsa.SignPSS(rand.Reader, key, h, digest(h, input), &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		case "pkcs1v15":
+			sig, err = rsa.SignPKCS1v15(rand.Reader, key, h, digest(h, input))
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported signature algorithm %q", sigAlg))
+		}
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return sig, nil
+}
+
+func (k *transitKey) verify(version int32, input, sig []byte, hashAlg, sigAlg string) (bool, error) {
+	signer, err := k.signer(version)
+	if err != nil {
+		return false, err
+	}
+	h, err := transitHash(hashAlg)
+	if err != nil {
+		return false, err
+	}
+	switch pub := signer.Public().(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(pub, input, sig), nil
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(pub, digest(h, input), sig), nil
+	case *rsa.PublicKey:
+		switch sigAlg {
+		case "", "pss":
+			return rsa.VerifyPSS(pub, h, digest(h, input), sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil, nil
+		case "pkcs1v15":
+			return rsa.VerifyPKCS1v15(pub, h, digest(h, input), sig) == nil, nil
+		}
+		return false, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported signature algorithm %q", sigAlg))
+	}
+	return false, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected key for type %s", k.Type))
+}
+
+func (k *transitKey) hmac(version int32, input []byte, alg string) ([]byte, error) {
+	h, err := transitHash(alg)
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(newHash(h), k.Versions[version-1].HMACKey)
+	mac.Write(input)
+	return mac.Sum(nil), nil
+}
+
+func (t *TransitServer) Sign(ctx context.Context, req *connect.Request[vaultv1.TransitSignRequest]) (*connect.Response[vaultv1.TransitSignResponse], error) {
+	name := req.Msg.Name
+	slog.Info("TransitSign", "name", name, "hash", req.Msg.HashAlgorithm)
+	if err := t.authorize(ctx, "update", "transit/sign/"+name); err != nil {
+		return nil, err
+	}
+	k, err := t.loadTransitKey(name)
+	if err != nil {
+		return nil, err
+	}
+	version, err := k.encryptionVersion(req.Msg.KeyVersion)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := k.sign(version, req.Msg.Input, req.Msg.HashAlgorithm, req.Msg.SignatureAlgorithm)
+	t.audit(ctx, "TransitSign", "transit/keys/"+name, version, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.TransitSignResponse{Signature: versioned(version, sig), KeyVersion: version}), nil
+}
+
+// Verify checks a signature or HMAC produced by Sign or HMAC. A mismatch
+// is reported as valid=false rather than an error.
+func (t *TransitServer) Verify(ctx context.Context, req *connect.Request[vaultv1.TransitVerifyRequest]) (*connect.Response[vaultv1.TransitVerifyResponse], error) {
+	name := req.Msg.Name
+	slog.Info("TransitVerify", "name", name)
+	if (req.Msg.Signature == "") == (req.Msg.Hmac == "") {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("exactly one of signature or hmac is required"))
+	}
+	if err := t.authorize(ctx, "update", "transit/verify/"+name); err != nil {
+		return nil, err
+	}
+	k, err := t.loadTransitKey(name)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		version int32
+		valid   bool
+	)
+	if req.Msg.Hmac != "" {
+		var got []byte
+		if version, got, err = k.parseVersioned("hmac", req.Msg.Hmac); err == nil {
+			var want []byte
+			if want, err = k.hmac(version, req.Msg.Input, req.Msg.HashAlgorithm); err == nil {
+				valid = hmac.Equal(got, want)
+			}
+		}
+	} else {
+		var sig []byte
+		if version, sig, err = k.parseVersioned("signature", req.Msg.Signature); err == nil {
+			valid, err = k.verify(version, req.Msg.Input, sig, req.Msg.HashAlgorithm, req.Msg.SignatureAlgorithm)
+		}
+	}
+	t.audit(ctx, "TransitVerify", "transit/keys/"+name, version, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.TransitVerifyResponse{Valid: valid}), nil
+}
+
+func (t *TransitServer) HMAC(ctx context.Context, req *connect.Request[vaultv1.TransitHMACRequest]) (*connect.Response[vaultv1.TransitHMACResponse], error) {
+	name := req.Msg.Name
+	slog.Info("TransitHMAC", "name", name, "algorithm", req.Msg.Algorithm)
+	if err := t.authorize(ctx, "update", "transit/hmac/"+name); err != nil {
+		return nil, err
+	}
+	k, err := t.loadTransitKey(name)
+	if err != nil {
+		return nil, err
+	}
+	version, err := k.encryptionVersion(req.Msg.KeyVersion)
+	if err != nil {
+		return nil, err
+	}
+	mac, err := k.hmac(version, req.Msg.Input, req.Msg.Algorithm)
+	t.audit(ctx, "TransitHMAC", "transit/keys/"+name, version, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.TransitHMACResponse{Hmac: versioned(version, mac), KeyVersion: version}), nil
+}
+
+func (t *TransitServer) GetPublicKey(ctx context.Context, req *connect.Request[vaultv1.GetTransitPublicKeyRequest]) (*connect.Response[vaultv1.TransitPublicKey], error) {
+	name := req.Msg.Name
+	slog.Info("GetTransitPublicKey", "name", name, "version", req.Msg.KeyVersion)
+	if err := t.authorize(ctx, "read", "transit/keys/"+name); err != nil {
+		return nil, err
+	}
+	k, err := t.loadTransitKey(name)
+	if err != nil {
+		return nil, err
+	}
+	version, err := k.encryptionVersion(req.Msg.KeyVersion)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := k.signer(version)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.TransitPublicKey{
+		Name:      name,
+		Type:      k.Type,
+		Version:   version,
+		PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}), nil
+}
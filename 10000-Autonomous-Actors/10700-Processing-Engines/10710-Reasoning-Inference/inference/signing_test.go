@@ -0,0 +1,130 @@
+package inference
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+)
+
+func TestTransitSignVerify(t *testing.T) {
+	server, _, root := newTestClient(t)
+	transit := newTransitClient(t, server)
+	ctx := context.Background()
+	artifact := []byte("sovereign-seal attestation")
+
+	for _, typ := range []string{"ed25519", "ecdsa-p256", "rsa-2048"} {
+		t.Run(typ, func(t *testing.T) {
+			if _, err := transit.CreateKey(ctx, withToken(&vaultv1.CreateTransitKeyRequest{Name: typ, Type: typ}, root)); err != nil {
+				t.Fatalf("CreateKey: %v", err)
+			}
+			sign := func() string {
+				t.Helper()
+				res, err := transit.Sign(ctx, withToken(&vaultv1.TransitSignRequest{Name: typ, Input: artifact}, root))
+				if err != nil {
+					t.Fatalf("Sign: %v", err)
+				}
+				return res.Msg.Signature
+			}
+			verify := func(input []byte, sig string) (bool, error) {
+				res, err := transit.Verify(ctx, withToken(&vaultv1.TransitVerifyRequest{Name: typ, Input: input, Signature: sig}, root))
+				if err != nil {
+					return false, err
+				}
+				return res.Msg.Valid, nil
+			}
+			v1 := sign()
+
+			// The published public key verifies signatures outside the vault.
+			pub, err := transit.GetPublicKey(ctx, withToken(&vaultv1.GetTransitPublicKeyRequest{Name: typ}, root))
+			if err != nil {
+				t.Fatalf("GetPublicKey: %v", err)
+			}
+			block, _ := pem.Decode([]byte(pub.Msg.PublicKey))
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				t.Fatalf("parsing public key: %v", err)
+			}
+			raw, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(v1, "vault:v1:"))
+			sum := sha256.Sum256(artifact)
+			var ok bool
+			switch key := key.(type) {
+			case ed25519.PublicKey:
+				ok = ed25519.Verify(key, artifact, raw)
+			case *ecdsa.PublicKey:
+				ok = ecdsa.VerifyASN1(key, sum[:], raw)
+			case *rsa.PublicKey:
+				ok = rsa.VerifyPSS(key, crypto.SHA256, sum[:], raw, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil
+			}
+			if !ok {
+				t.Error("signature does not verify against the published public key")
+			}
+
+			if valid, err := verify(artifact, v1); err != nil || !valid {
+				t.Errorf("Verify = %v, %v", valid, err)
+			}
+			if valid, err := verify([]byte("tampered"), v1); err != nil || valid {
+				t.Errorf("Verify(tampered) = %v, %v; want false", valid, err)
+			}
+
+			// Old signatures verify after rotation until their version is retired.
+			transit.RotateKey(ctx, withToken(&vaultv1.RotateTransitKeyRequest{Name: typ}, root))
+			if v2 := sign(); !strings.HasPrefix(v2, "vault:v2:") {
+				t.Errorf("signature after rotation = %q", v2)
+			}
+			if valid, _ := verify(artifact, v1); !valid {
+				t.Error("v1 signature rejected after rotation")
+			}
+			transit.UpdateKeyConfig(ctx, withToken(&vaultv1.UpdateTransitKeyConfigRequest{Name: typ, MinDecryptionVersion: 2}, root))
+			if _, err := verify(artifact, v1); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+				t.Errorf("Verify retired version: expected FailedPrecondition, got %v", err)
+			}
+
+			if _, err := transit.Encrypt(ctx, withToken(&vaultv1.TransitEncryptRequest{Name: typ, Plaintext: artifact}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("Encrypt with signing key: expected InvalidArgument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTransitHMAC(t *testing.T) {
+	server, _, root := newTestClient(t)
+	transit := newTransitClient(t, server)
+	ctx := context.Background()
+
+	transit.CreateKey(ctx, withToken(&vaultv1.CreateTransitKeyRequest{Name: "webhooks"}, root))
+	if _, err := transit.Sign(ctx, withToken(&vaultv1.TransitSignRequest{Name: "webhooks", Input: []byte("x")}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("Sign with AES key: expected InvalidArgument, got %v", err)
+	}
+	res, err := transit.HMAC(ctx, withToken(&vaultv1.TransitHMACRequest{Name: "webhooks", Input: []byte("payload"), Algorithm: "sha2-512"}, root))
+	if err != nil {
+		t.Fatalf("HMAC: %v", err)
+	}
+	verify := func(input, alg string) bool {
+		t.Helper()
+		v, err := transit.Verify(ctx, withToken(&vaultv1.TransitVerifyRequest{Name: "webhooks", Input: []byte(input), Hmac: res.Msg.Hmac, HashAlgorithm: alg}, root))
+		if err != nil {
+			t.Fatalf("Verify: %v", err)
+		}
+		return v.Msg.Valid
+	}
+	if !verify("payload", "sha2-512") {
+		t.Error("HMAC did not verify")
+	}
+	if verify("payload!", "sha2-512") || verify("payload", "sha2-256") {
+		t.Error("HMAC verified against the wrong input or algorithm")
+	}
+	if _, err := transit.Verify(ctx, withToken(&vaultv1.TransitVerifyRequest{Name: "webhooks", Input: []byte("payload")}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("Verify without signature or hmac: expected InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,356 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"cmp"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	sshCAKey        = "ca"
+	sshKeyDefault   = "ed25519"
+	sshCertUser     = "user"
+	sshCertHost     = "host"
+	sshDefaultTTL   = time.Hour
+	sshAnyPrincipal = "*"
+)
+
+// SSHServer serves SSHService from the vault's storage.
+type SSHServer struct {
+	*VaultServer
+}
+
+// SSH returns the SSHService handler backed by s.
+func (s *VaultServer) SSH() *SSHServer {
+	return &SSHServer{s}
+}
+
+// sshCA is stored sealed by the barrier; Key is a PKCS #8 private key.
+type sshCA struct {
+	Key []byte `json:"key"`
+}
+
+type sshRole struct {
+	CertType          string            `json:"cert_type"`
+	AllowedUsers      []string          `json:"allowed_users,omitempty"`
+	DefaultUser       string            `json:"default_user,omitempty"`
+	AllowedDomains    []string          `json:"allowed_domains,omitempty"`
+	AllowSubdomains   bool              `json:"allow_subdomains,omitempty"`
+	AllowedExtensions []string          `json:"allowed_extensions,omitempty"`
+	DefaultExtensions map[string]string `json:"default_extensions,omitempty"`
+	CriticalOptions   map[string]string `json:"critical_options,omitempty"`
+	TTL               int64             `json:"ttl"`
+	MaxTTL            int64             `json:"max_ttl"`
+}
+
+func (r *sshRole) proto(name string) *vaultv1.SSHRole {
+	return &vaultv1.SSHRole{
+		Name:              name,
+		CertType:          r.CertType,
+		AllowedUsers:      r.AllowedUsers,
+		DefaultUser:       r.DefaultUser,
+		AllowedDomains:    r.AllowedDomains,
+		AllowSubdomains:   r.AllowSubdomains,
+		AllowedExtensions: r.AllowedExtensions,
+		DefaultExtensions: r.DefaultExtensions,
+		CriticalOptions:   r.CriticalOptions,
+		TtlSeconds:        r.TTL,
+		MaxTtlSeconds:     r.MaxTTL,
+	}
+}
+
+// allowsPrincipal checks a principal against the role's users, or for
+// host roles its domains. The default user is always allowed.
+func (r *sshRole) allowsPrincipal(p string) bool {
+	if r.CertType == sshCertHost {
+		return domainAllowed(p, r.AllowedDomains, true, r.AllowSubdomains)
+	}
+	return p == r.DefaultUser || slices.Contains(r.AllowedUsers, sshAnyPrincipal) || slices.Contains(r.AllowedUsers, p)
+}
+
+func (r *sshRole) allowsExtension(name string) bool {
+	return slices.Contains(r.AllowedExtensions, sshAnyPrincipal) || slices.Contains(r.AllowedExtensions, name)
+}
+
+// sshSigner wraps the CA key. RSA keys sign with SHA-512, since OpenSSH
+// rejects SHA-1 "ssh-rsa" certificate signatures.
+func sshSigner(der []byte) (ssh.Signer, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	signer, err := ssh.NewSignerFromSigner(key.(crypto.Signer))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if _, ok := key.(*rsa.PrivateKey); ok {
+		if signer, err = ssh.NewSignerWithAlgorithms(signer.(ssh.AlgorithmSigner), []string{ssh.KeyAlgoRSASHA512}); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	return signer, nil
+}
+
+func (s *VaultServer) loadSSHSigner() (ssh.Signer, error) {
+	var ca sshCA
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket([]byte(bucketSSHCA)).Get([]byte(sshCAKey))
+		if data == nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("no SSH CA has been generated"))
+		}
+		plain, err := s.barrier.open(bucketSSHCA+"/"+sshCAKey, data)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return json.Unmarshal(plain, &ca)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sshSigner(ca.Key)
+}
+
+func sshPublicKey(signer ssh.Signer) string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey())))
+}
+
+func (h *SSHServer) GenerateCA(ctx context.Context, req *connect.Request[vaultv1.GenerateSSHCARequest]) (*connect.Response[vaultv1.SSHCAPublicKey], error) {
+	slog.Info("GenerateSSHCA", "key_type", req.Msg.KeyType)
+	if err := h.authorizeSudo(ctx, "ssh/ca"); err != nil {
+		return nil, err
+	}
+	_, der, err := newPKIKey(cmp.Or(req.Msg.KeyType, sshKeyDefault))
+	if err != nil {
+		return nil, err
+	}
+	signer, err := sshSigner(der)
+	if err != nil {
+		return nil, err
+	}
+	data, _ := json.Marshal(sshCA{Key: der})
+	err = h.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucketSSHCA))
+		if b.Get([]byte(sshCAKey)) != nil {
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("an SSH CA already exists"))
+		}
+		if err := b.Put([]byte(sshCAKey), h.barrier.seal(bucketSSHCA+"/"+sshCAKey, data)); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	})
+	h.audit(ctx, "GenerateSSHCA", "ssh/ca", 0, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.SSHCAPublicKey{PublicKey: sshPublicKey(signer)}), nil
+}
+
+// GetCAPublicKey returns the CA public key. It is public, so no capability
+// is required.
+func (h *SSHServer) GetCAPublicKey(ctx context.Context, req *connect.Request[vaultv1.GetSSHCAPublicKeyRequest]) (*connect.Response[vaultv1.SSHCAPublicKey], error) {
+	slog.Info("GetSSHCAPublicKey")
+	signer, err := h.loadSSHSigner()
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.SSHCAPublicKey{PublicKey: sshPublicKey(signer)}), nil
+}
+
+// PublicKeyHandler serves the CA public key as a single authorized_keys
+// line, ready to be fetched into sshd's TrustedUserCAKeys file.
+func (h *SSHServer) PublicKeyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signer, err := h.loadSSHSigner()
+		if err != nil {
+			status := http.StatusInternalServerError
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				status = http.StatusNotFound
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write(ssh.MarshalAuthorizedKey(signer.PublicKey()))
+	})
+}
+
+func (h *SSHServer) PutRole(ctx context.Context, req *connect.Request[vaultv1.SSHRole]) (*connect.Response[vaultv1.PutSSHRoleResponse], error) {
+	r := req.Msg
+	slog.Info("PutSSHRole", "role", r.Name, "cert_type", r.CertType)
+	if r.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("role name is required"))
+	}
+	if r.TtlSeconds < 0 || r.MaxTtlSeconds < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("TTLs cannot be negative"))
+	}
+	role := sshRole{
+		CertType:          cmp.Or(r.CertType, sshCertUser),
+		AllowedUsers:      r.AllowedUsers,
+		DefaultUser:       r.DefaultUser,
+		AllowedDomains:    r.AllowedDomains,
+		AllowSubdomains:   r.AllowSubdomains,
+		AllowedExtensions: r.AllowedExtensions,
+		DefaultExtensions: r.DefaultExtensions,
+		CriticalOptions:   r.CriticalOptions,
+		TTL:               r.TtlSeconds,
+		MaxTTL:            r.MaxTtlSeconds,
+	}
+	if role.CertType != sshCertUser && role.CertType != sshCertHost {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cert_type must be %q or %q", sshCertUser, sshCertHost))
+	}
+	if role.TTL == 0 {
+		role.TTL = int64(sshDefaultTTL / time.Second)
+	}
+	if role.MaxTTL == 0 {
+		role.MaxTTL = role.TTL
+	}
+	if role.TTL > role.MaxTTL {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ttl_seconds exceeds max_ttl_seconds"))
+	}
+	if err := h.authorizeSudo(ctx, "ssh/roles/"+r.Name); err != nil {
+		return nil, err
+	}
+
+	data, _ := json.Marshal(role)
+	err := h.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketSSHRoles)).Put([]byte(r.Name), data)
+	})
+	h.audit(ctx, "PutSSHRole", "ssh/roles/"+r.Name, 0, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutSSHRoleResponse{}), nil
+}
+
+func (h *SSHServer) GetRole(ctx context.Context, req *connect.Request[vaultv1.GetSSHRoleRequest]) (*connect.Response[vaultv1.SSHRole], error) {
+	name := req.Msg.Name
+	slog.Info("GetSSHRole", "role", name)
+	if err := h.authorize(ctx, "read", "ssh/roles/"+name); err != nil {
+		return nil, err
+	}
+	role, err := h.loadSSHRole(name)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(role.proto(name)), nil
+}
+
+func (s *VaultServer) loadSSHRole(name string) (*sshRole, error) {
+	var role sshRole
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket([]byte(bucketSSHRoles)).Get([]byte(name))
+		if data == nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("SSH role not found: %s", name))
+		}
+		return json.Unmarshal(data, &role)
+	})
+	return &role, err
+}
+
+// SignSSHKey signs a public key as a user or host certificate according
+// to the role. The lifetime is capped at the role's maximum.
+func (h *SSHServer) SignSSHKey(ctx context.Context, req *connect.Request[vaultv1.SignSSHKeyRequest]) (*connect.Response[vaultv1.SignedSSHKey], error) {
+	m := req.Msg
+	path := "ssh/sign/" + m.Role
+	slog.Info("SignSSHKey", "role", m.Role, "principals", m.ValidPrincipals)
+	if err := h.authorize(ctx, "update", path); err != nil {
+		h.audit(ctx, "SignSSHKey", path, 0, err)
+		return nil, err
+	}
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(m.PublicKey))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid public_key: %w", err))
+	}
+	if _, ok := pub.(*ssh.Certificate); ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("public_key must be a key, not a certificate"))
+	}
+	role, err := h.loadSSHRole(m.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	principals := m.ValidPrincipals
+	if len(principals) == 0 && role.DefaultUser != "" {
+		principals = []string{role.DefaultUser}
+	}
+	if len(principals) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("valid_principals is required"))
+	}
+	for _, p := range principals {
+		if !role.allowsPrincipal(p) {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("principal %q is not allowed by role %s", p, m.Role))
+		}
+	}
+	certType, extensions := uint32(ssh.UserCert), role.DefaultExtensions
+	if len(m.Extensions) > 0 {
+		for name := range m.Extensions {
+			if !role.allowsExtension(name) {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("extension %q is not allowed by role %s", name, m.Role))
+			}
+		}
+		extensions = m.Extensions
+	}
+	if role.CertType == sshCertHost {
+		// OpenSSH defines no host certificate extensions.
+		certType, extensions = ssh.HostCert, nil
+	}
+	ttl, err := pkiTTL(m.TtlSeconds, time.Duration(role.TTL)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	ttl = min(ttl, time.Duration(role.MaxTTL)*time.Second)
+	keyID := m.KeyId
+	if keyID == "" {
+		keyID = "vault-" + m.Role
+		if c := CallerFrom(ctx); c != nil && c.Identity != "" {
+			keyID += "-" + c.Identity
+		}
+	}
+
+	signer, err := h.loadSSHSigner()
+	if err != nil {
+		return nil, err
+	}
+	var serial [8]byte
+	rand.Read(serial[:])
+	now := h.now()
+	cert := &ssh.Certificate{
+		Key:             pub,
+		Serial:          binary.BigEndian.Uint64(serial[:]),
+		CertType:        certType,
+		KeyId:           keyID,
+		ValidPrincipals: principals,
+		ValidAfter:      uint64(now.Add(-pkiBackdate).Unix()),
+		ValidBefore:     uint64(now.Add(ttl).Unix()),
+		Permissions:     ssh.Permissions{CriticalOptions: role.CriticalOptions, Extensions: extensions},
+	}
+	if err := cert.SignCert(rand.Reader, signer); err != nil {
+		err = connect.NewError(connect.CodeInternal, err)
+		h.audit(ctx, "SignSSHKey", path, 0, err)
+		return nil, err
+	}
+	h.audit(ctx, "SignSSHKey", path, 0, nil)
+	return connect.NewResponse(&vaultv1.SignedSSHKey{
+		SignedKey:    strings.TrimSpace(string(ssh.MarshalAuthorizedKey(cert))),
+		SerialNumber: cert.Serial,
+		ExpireTime:   int64(cert.ValidBefore),
+	}), nil
+}
@@ -0,0 +1,178 @@
+package inference
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault/vaultv1connect"
+	"connectrpc.com/connect"
+	"golang.org/x/crypto/ssh"
+)
+
+func newSSHClient(t *testing.T, server *VaultServer) vaultv1connect.SSHServiceClient {
+	path, h := vaultv1connect.NewSSHServiceHandler(server.SSH(), connect.WithInterceptors(server.AuthInterceptor()))
+	return vaultv1connect.NewSSHServiceClient(http.DefaultClient, serveTest(t, path, h))
+}
+
+func newSSHUserKey(t *testing.T) ssh.PublicKey {
+	t.Helper()
+	pub, _, _ := ed25519.GenerateKey(rand.Reader)
+	key, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatalf("NewPublicKey: %v", err)
+	}
+	return key
+}
+
+func parseSSHCert(t *testing.T, s string) *ssh.Certificate {
+	t.Helper()
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(s))
+	if err != nil {
+		t.Fatalf("ParseAuthorizedKey: %v", err)
+	}
+	cert, ok := key.(*ssh.Certificate)
+	if !ok {
+		t.Fatalf("signed key is a %T, not a certificate", key)
+	}
+	return cert
+}
+
+func TestSSHSignUserAndHostKeys(t *testing.T) {
+	server, _, root := newTestClient(t, RequireAuth())
+	sshc := newSSHClient(t, server)
+	ctx := context.Background()
+
+	if _, err := sshc.GetCAPublicKey(ctx, connect.NewRequest(&vaultv1.GetSSHCAPublicKeyRequest{})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("GetCAPublicKey before GenerateCA: expected NotFound, got %v", err)
+	}
+	ca, err := sshc.GenerateCA(ctx, withToken(&vaultv1.GenerateSSHCARequest{}, root))
+	if err != nil {
+		t.Fatalf("GenerateCA: %v", err)
+	}
+	if _, err := sshc.GenerateCA(ctx, withToken(&vaultv1.GenerateSSHCARequest{}, root)); connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Errorf("second GenerateCA: expected AlreadyExists, got %v", err)
+	}
+	caKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(ca.Msg.PublicKey))
+	if err != nil {
+		t.Fatalf("CA public key: %v", err)
+	}
+	if got, err := sshc.GetCAPublicKey(ctx, connect.NewRequest(&vaultv1.GetSSHCAPublicKeyRequest{})); err != nil || got.Msg.PublicKey != ca.Msg.PublicKey {
+		t.Errorf("GetCAPublicKey without token = %v, %v", got, err)
+	}
+	ts := httptest.NewServer(server.SSH().PublicKeyHandler())
+	defer ts.Close()
+	if res, err := http.Get(ts.URL); err == nil {
+		body, _ := io.ReadAll(res.Body)
+		res.Body.Close()
+		if strings.TrimSpace(string(body)) != ca.Msg.PublicKey {
+			t.Errorf("served public key = %q", body)
+		}
+	}
+
+	_, err = sshc.PutRole(ctx, withToken(&vaultv1.SSHRole{
+		Name:              "engineers",
+		AllowedUsers:      []string{"ops"},
+		DefaultUser:       "deploy",
+		AllowedExtensions: []string{"permit-pty", "permit-port-forwarding"},
+		DefaultExtensions: map[string]string{"permit-pty": ""},
+		CriticalOptions:   map[string]string{"source-address": "10.0.0.0/8"},
+		TtlSeconds:        600,
+		MaxTtlSeconds:     1200,
+	}, root))
+	if err != nil {
+		t.Fatalf("PutRole: %v", err)
+	}
+	checker := &ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool { return bytes.Equal(auth.Marshal(), caKey.Marshal()) },
+		IsHostAuthority: func(auth ssh.PublicKey, _ string) bool { return bytes.Equal(auth.Marshal(), caKey.Marshal()) },
+	}
+
+	userKey := newSSHUserKey(t)
+	signed, err := sshc.SignSSHKey(ctx, withToken(&vaultv1.SignSSHKeyRequest{Role: "engineers", PublicKey: string(ssh.MarshalAuthorizedKey(userKey)), TtlSeconds: 86400}, root))
+	if err != nil {
+		t.Fatalf("SignSSHKey: %v", err)
+	}
+	cert := parseSSHCert(t, signed.Msg.SignedKey)
+	if err := checker.CheckCert("deploy", cert); err != nil {
+		t.Errorf("user certificate rejected: %v", err)
+	}
+	if cert.CertType != ssh.UserCert || !bytes.Equal(cert.Key.Marshal(), userKey.Marshal()) || cert.Serial != signed.Msg.SerialNumber {
+		t.Errorf("certificate = %+v", cert)
+	}
+	if _, ok := cert.Extensions["permit-pty"]; !ok || cert.CriticalOptions["source-address"] != "10.0.0.0/8" {
+		t.Errorf("permissions = %+v", cert.Permissions)
+	}
+	if lifetime := time.Duration(cert.ValidBefore-cert.ValidAfter) * time.Second; lifetime > 1200*time.Second+pkiBackdate {
+		t.Errorf("lifetime = %v, want it capped at the role's max TTL", lifetime)
+	}
+	if !strings.HasPrefix(cert.KeyId, "vault-engineers") {
+		t.Errorf("KeyId = %q", cert.KeyId)
+	}
+
+	signed, err = sshc.SignSSHKey(ctx, withToken(&vaultv1.SignSSHKeyRequest{
+		Role:            "engineers",
+		PublicKey:       string(ssh.MarshalAuthorizedKey(userKey)),
+		ValidPrincipals: []string{"ops"},
+		Extensions:      map[string]string{"permit-port-forwarding": ""},
+		KeyId:           "alice@laptop",
+	}, root))
+	if err != nil {
+		t.Fatalf("SignSSHKey with principals: %v", err)
+	}
+	if cert := parseSSHCert(t, signed.Msg.SignedKey); cert.KeyId != "alice@laptop" || len(cert.Extensions) != 1 || checker.CheckCert("deploy", cert) == nil {
+		t.Errorf("certificate for ops = %+v", cert)
+	}
+
+	for _, req := range []*vaultv1.SignSSHKeyRequest{
+		{ValidPrincipals: []string{"root"}},
+		{Extensions: map[string]string{"permit-agent-forwarding": ""}},
+	} {
+		req.Role, req.PublicKey = "engineers", string(ssh.MarshalAuthorizedKey(userKey))
+		if _, err := sshc.SignSSHKey(ctx, withToken(req, root)); connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("SignSSHKey(%v %v): expected PermissionDenied, got %v", req.ValidPrincipals, req.Extensions, err)
+		}
+	}
+	if _, err := sshc.SignSSHKey(ctx, withToken(&vaultv1.SignSSHKeyRequest{Role: "engineers", PublicKey: signed.Msg.SignedKey}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("SignSSHKey of a certificate: expected InvalidArgument, got %v", err)
+	}
+
+	sshc.PutRole(ctx, withToken(&vaultv1.SSHRole{Name: "hosts", CertType: "host", AllowedDomains: []string{"hosts.olympus"}, AllowSubdomains: true, TtlSeconds: 86400}, root))
+	hostKey := newSSHUserKey(t)
+	if _, err := sshc.SignSSHKey(ctx, withToken(&vaultv1.SignSSHKeyRequest{Role: "hosts", PublicKey: string(ssh.MarshalAuthorizedKey(hostKey)), ValidPrincipals: []string{"web1.evil.com"}}, root)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("SignSSHKey for foreign host: expected PermissionDenied, got %v", err)
+	}
+	signed, err = sshc.SignSSHKey(ctx, withToken(&vaultv1.SignSSHKeyRequest{Role: "hosts", PublicKey: string(ssh.MarshalAuthorizedKey(hostKey)), ValidPrincipals: []string{"web1.hosts.olympus"}}, root))
+	if err != nil {
+		t.Fatalf("SignSSHKey for host: %v", err)
+	}
+	if cert := parseSSHCert(t, signed.Msg.SignedKey); cert.CertType != ssh.HostCert || len(cert.Extensions) != 0 || checker.CheckCert("web1.hosts.olympus", cert) != nil {
+		t.Errorf("host certificate = %+v", cert)
+	}
+}
+
+func TestSSHRSACA(t *testing.T) {
+	server, _, root := newTestClient(t)
+	sshc := newSSHClient(t, server)
+	ctx := context.Background()
+
+	if _, err := sshc.GenerateCA(ctx, withToken(&vaultv1.GenerateSSHCARequest{KeyType: "rsa-2048"}, root)); err != nil {
+		t.Fatalf("GenerateCA: %v", err)
+	}
+	sshc.PutRole(ctx, withToken(&vaultv1.SSHRole{Name: "ops", DefaultUser: "ops"}, root))
+	signed, err := sshc.SignSSHKey(ctx, withToken(&vaultv1.SignSSHKeyRequest{Role: "ops", PublicKey: string(ssh.MarshalAuthorizedKey(newSSHUserKey(t)))}, root))
+	if err != nil {
+		t.Fatalf("SignSSHKey: %v", err)
+	}
+	if format := parseSSHCert(t, signed.Msg.SignedKey).Signature.Format; format != ssh.KeyAlgoRSASHA512 {
+		t.Errorf("signature format = %s, want %s", format, ssh.KeyAlgoRSASHA512)
+	}
+}
@@ -0,0 +1,280 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+// NextOpenHeader is set on PermissionDenied errors caused by a closed
+// access window, carrying the next opening time in RFC 3339.
+const NextOpenHeader = "X-Vault-Next-Open"
+
+// maxWindowSearch bounds the search for the next opening time.
+const maxWindowSearch = 366 * 24 * time.Hour
+
+// WithClock replaces the server's clock, so that TTLs and access windows
+// can be exercised in tests.
+func WithClock(now func() time.Time) Option {
+	return func(s *VaultServer) { s.now = now }
+}
+
+type accessWindow struct {
+	Schedule  string `json:"schedule,omitempty"`
+	TimeZone  string `json:"time_zone,omitempty"`
+	NotBefore int64  `json:"not_before,omitempty"`
+	NotAfter  int64  `json:"not_after,omitempty"`
+}
+
+// cronSpec is a parsed five-field cron expression; each field is the set
+// of permitted values.
+type cronSpec struct {
+	minute, hour, dom, month, dow map[int]bool
+	domAny, dowAny                bool
+}
+
+func parseCronField(field string, lo, hi int) (map[int]bool, error) {
+	set := map[int]bool{}
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if base, s, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(s)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			part, step = base, n
+		}
+		from, to := lo, hi
+		if part != "*" {
+			a, b, isRange := strings.Cut(part, "-")
+			var err error
+			if from, err = strconv.Atoi(a); err != nil {
+				return nil, fmt.Errorf("invalid value %q", part)
+			}
+			to = from
+			if isRange {
+				if to, err = strconv.Atoi(b); err != nil {
+					return nil, fmt.Errorf("invalid range %q", part)
+				}
+			} else if step > 1 {
+				to = hi
+			}
+		}
+		if from < lo || to > hi || from > to {
+			return nil, fmt.Errorf("%q out of range %d-%d", part, lo, hi)
+		}
+		for v := from; v <= to; v += step {
+			set[v] = true
+		}
+	}
+	return set, nil
+}
+
+func parseCron(expr string) (*cronSpec, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule %q must have 5 fields", expr)
+	}
+	var c cronSpec
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if c.dow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+	if c.dow[7] {
+		c.dow[0] = true
+	}
+	c.domAny, c.dowAny = fields[2] == "*", fields[4] == "*"
+	return &c, nil
+}
+
+// dayMatches applies cron's rule that a restricted day-of-month and
+// day-of-week are alternatives rather than both being required.
+func (c *cronSpec) dayMatches(t time.Time) bool {
+	dom, dow := c.dom[t.Day()], c.dow[int(t.Weekday())]
+	switch {
+	case c.domAny && c.dowAny:
+		return true
+	case c.domAny:
+		return dow
+	case c.dowAny:
+		return dom
+	}
+	return dom || dow
+}
+
+func (c *cronSpec) matches(t time.Time) bool {
+	return c.month[int(t.Month())] && c.dayMatches(t) && c.hour[t.Hour()] && c.minute[t.Minute()]
+}
+
+// next returns the first matching minute at or after t.
+func (c *cronSpec) next(t time.Time, limit time.Time) (time.Time, bool) {
+	t = t.Truncate(time.Minute)
+	for !t.After(limit) {
+		switch {
+		case !c.month[int(t.Month())]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !c.hour[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !c.minute[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (w *accessWindow) validate() error {
+	if w.Schedule != "" {
+		if _, err := parseCron(w.Schedule); err != nil {
+			return err
+		}
+	}
+	if w.TimeZone != "" {
+		if _, err := time.LoadLocation(w.TimeZone); err != nil {
+			return err
+		}
+	}
+	if w.NotBefore != 0 && w.NotAfter != 0 && w.NotAfter <= w.NotBefore {
+		return fmt.Errorf("not_after must be later than not_before")
+	}
+	return nil
+}
+
+// check reports whether the window is open at now. When it is closed, the
+// next opening time is returned if there is one.
+func (w *accessWindow) check(now time.Time) (open bool, next time.Time) {
+	if w.NotAfter != 0 && !now.Before(time.Unix(w.NotAfter, 0)) {
+		return false, time.Time{}
+	}
+	start := now
+	if w.NotBefore != 0 && now.Before(time.Unix(w.NotBefore, 0)) {
+		start = time.Unix(w.NotBefore, 0)
+	}
+	if w.Schedule == "" {
+		if start.Equal(now) {
+			return true, time.Time{}
+		}
+		return false, start
+	}
+
+	loc := time.UTC
+	if w.TimeZone != "" {
+		if l, err := time.LoadLocation(w.TimeZone); err == nil {
+			loc = l
+		}
+	}
+	spec, err := parseCron(w.Schedule)
+	if err != nil {
+		return false, time.Time{}
+	}
+	if start.Equal(now) && spec.matches(now.In(loc)) {
+		return true, time.Time{}
+	}
+	from := start.In(loc)
+	if from.Truncate(time.Minute).Before(from) {
+		from = from.Truncate(time.Minute).Add(time.Minute)
+	}
+	n, ok := spec.next(from, now.Add(maxWindowSearch).In(loc))
+	if !ok || (w.NotAfter != 0 && !n.Before(time.Unix(w.NotAfter, 0))) {
+		return false, time.Time{}
+	}
+	return false, n
+}
+
+func windowFromProto(w *vaultv1.AccessWindow) *accessWindow {
+	if w == nil {
+		return nil
+	}
+	return &accessWindow{Schedule: w.Schedule, TimeZone: w.TimeZone, NotBefore: w.NotBefore, NotAfter: w.NotAfter}
+}
+
+func (w *accessWindow) proto() *vaultv1.AccessWindow {
+	if w == nil {
+		return nil
+	}
+	return &vaultv1.AccessWindow{Schedule: w.Schedule, TimeZone: w.TimeZone, NotBefore: w.NotBefore, NotAfter: w.NotAfter}
+}
+
+func windowClosedError(what string, next time.Time) error {
+	msg := fmt.Sprintf("access window for %s is closed", what)
+	if !next.IsZero() {
+		msg += "; next opening " + next.UTC().Format(time.RFC3339)
+	}
+	err := connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s", msg))
+	if !next.IsZero() {
+		err.Meta().Set(NextOpenHeader, next.UTC().Format(time.RFC3339))
+	}
+	return err
+}
+
+func (s *VaultServer) PutAccessWindow(ctx context.Context, req *connect.Request[vaultv1.PutAccessWindowRequest]) (*connect.Response[vaultv1.PutAccessWindowResponse], error) {
+	slog.Info("PutAccessWindow", "key", req.Msg.Key)
+	if req.Msg.Key == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key is required"))
+	}
+	if err := s.authorizeSudo(ctx, "sys/windows/"+req.Msg.Key); err != nil {
+		return nil, err
+	}
+	w := windowFromProto(req.Msg.Window)
+	if w != nil {
+		if err := w.validate(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucketWindows))
+		if w == nil {
+			return b.Delete([]byte(req.Msg.Key))
+		}
+		data, _ := json.Marshal(w)
+		return b.Put([]byte(req.Msg.Key), data)
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&vaultv1.PutAccessWindowResponse{}), nil
+}
+
+// checkSecretWindow enforces the per-secret access window on reads.
+func (s *VaultServer) checkSecretWindow(key string) error {
+	var w *accessWindow
+	s.db.View(func(tx *bbolt.Tx) error {
+		if data := tx.Bucket([]byte(bucketWindows)).Get([]byte(key)); data != nil {
+			w = &accessWindow{}
+			return json.Unmarshal(data, w)
+		}
+		return nil
+	})
+	if w == nil {
+		return nil
+	}
+	if open, next := w.check(s.now()); !open {
+		return windowClosedError(key, next)
+	}
+	return nil
+}
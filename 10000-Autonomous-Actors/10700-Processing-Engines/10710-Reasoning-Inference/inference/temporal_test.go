@@ -0,0 +1,81 @@
+package inference
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+)
+
+func TestAccessWindowCheck(t *testing.T) {
+	// Business hours, Monday to Friday.
+	w := &accessWindow{Schedule: "* 9-17 * * 1-5", TimeZone: "UTC"}
+	if err := w.validate(); err != nil {
+		t.Fatalf("validate failed: %v", err)
+	}
+
+	monday := time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC)
+	if open, _ := w.check(monday); !open {
+		t.Error("Expected window open on Monday morning")
+	}
+	open, next := w.check(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+	if open || !next.Equal(monday.Add(-90*time.Minute)) {
+		t.Errorf("Expected closed on Saturday reopening Monday 09:00, got %v %v", open, next)
+	}
+
+	expired := &accessWindow{NotAfter: monday.Add(-time.Hour).Unix()}
+	if open, next := expired.check(monday); open || !next.IsZero() {
+		t.Errorf("Expected expired window never to reopen, got %v %v", open, next)
+	}
+	if err := (&accessWindow{Schedule: "61 * * * *"}).validate(); err == nil {
+		t.Error("Expected invalid schedule to be rejected")
+	}
+}
+
+func TestSecretAndPolicyWindows(t *testing.T) {
+	clock := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC) // Saturday
+	_, client, root := newTestClient(t, WithClock(func() time.Time { return clock }))
+	ctx := context.Background()
+	weekdays := &vaultv1.AccessWindow{Schedule: "* * * * 1-5"}
+
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "batch/key", Value: "v"}, root))
+	if _, err := client.PutAccessWindow(ctx, withToken(&vaultv1.PutAccessWindowRequest{Key: "batch/key", Window: weekdays}, root)); err != nil {
+		t.Fatalf("PutAccessWindow failed: %v", err)
+	}
+
+	_, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "batch/key"}, root))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("Expected PermissionDenied outside the window, got %v", err)
+	}
+	var ce *connect.Error
+	if !errors.As(err, &ce) || ce.Meta().Get(NextOpenHeader) != "2026-10-19T00:00:00Z" {
+		t.Errorf("Expected next opening header, got %v", err)
+	}
+
+	clock = clock.Add(48 * time.Hour)
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "batch/key"}, root)); err != nil {
+		t.Errorf("Expected read inside the window to succeed: %v", err)
+	}
+
+	// A policy window only limits the tokens holding that policy.
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "ops/key", Value: "v"}, root))
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name:   "ops-weekend",
+		Rules:  []*vaultv1.PolicyRule{{Path: "ops/*", Capabilities: []string{"read"}}},
+		Window: &vaultv1.AccessWindow{Schedule: "* * * * 0,6"},
+	}}, root))
+	tok, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{Policies: []string{"ops-weekend"}}, root))
+	if err != nil {
+		t.Fatalf("CreateToken failed: %v", err)
+	}
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "ops/key"}, tok.Msg.Token)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected policy window to deny a Monday read, got %v", err)
+	}
+	clock = clock.Add(-48 * time.Hour)
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "ops/key"}, tok.Msg.Token)); err != nil {
+		t.Errorf("Expected weekend read to succeed: %v", err)
+	}
+}
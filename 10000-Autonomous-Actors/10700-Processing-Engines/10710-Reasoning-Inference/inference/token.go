@@ -0,0 +1,585 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault/vaultv1connect"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	tokenPrefix     = "ovs."
+	defaultTokenTTL = 24 * time.Hour
+	maxTokenTTL     = 30 * 24 * time.Hour
+	rootTokenFile   = "root.token"
+	// tokenLeasePrefix is where the leases of expiring tokens live, so
+	// they can be revoked together with RevokePrefix.
+	tokenLeasePrefix = "auth/token/create/"
+)
+
+var errTokenNotFound = errors.New("token not found or expired")
+
+// tokenEntry is the persisted form of a token. Tokens themselves are never
+// stored; entries are keyed by the SHA-256 of the token.
+type tokenEntry struct {
+	ID          string    `json:"id"`
+	Accessor    string    `json:"accessor"`
+	Parent      string    `json:"parent,omitempty"`
+	Policies    []string  `json:"policies"`
+	DisplayName string    `json:"display_name"`
+	Entity      string    `json:"entity,omitempty"`
+	CreateTime  time.Time `json:"create_time"`
+	ExpireTime  time.Time `json:"expire_time"`
+	TTL         int64     `json:"ttl"`
+	ExplicitMax int64     `json:"explicit_max_ttl"`
+	Renewable   bool      `json:"renewable"`
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (e *tokenEntry) expired(now time.Time) bool {
+	return !e.ExpireTime.IsZero() && !now.Before(e.ExpireTime)
+}
+
+// identity is the principal the token acts for. It is fixed when an auth
+// method or a sudo caller issues the token and inherited by every child
+// created without sudo, so token holders cannot choose it. Tokens issued
+// before entities were recorded fall back to their accessor rather than
+// their caller-chosen display name.
+func (e *tokenEntry) identity() string {
+	if e.Entity != "" {
+		return e.Entity
+	}
+	return "token-" + e.Accessor[:8]
+}
+
+// maxExpireTime is the latest the token can be renewed to.
+func (e *tokenEntry) maxExpireTime() time.Time {
+	if !e.Renewable && !e.ExpireTime.IsZero() {
+		return e.ExpireTime
+	}
+	maxTTL := maxTokenTTL
+	if e.ExplicitMax > 0 {
+		maxTTL = min(maxTTL, time.Duration(e.ExplicitMax)*time.Second)
+	}
+	return e.CreateTime.Add(maxTTL)
+}
+
+func (e *tokenEntry) leaseID() string {
+	return tokenLeasePrefix + e.Accessor
+}
+
+func (e *tokenEntry) info(tx *bbolt.Tx) *vaultv1.TokenInfo {
+	info := &vaultv1.TokenInfo{
+		Accessor:              e.Accessor,
+		Policies:              e.Policies,
+		DisplayName:           e.DisplayName,
+		Entity:                e.identity(),
+		CreateTime:            e.CreateTime.Unix(),
+		TtlSeconds:            e.TTL,
+		ExplicitMaxTtlSeconds: e.ExplicitMax,
+		Renewable:             e.Renewable,
+	}
+	if !e.ExpireTime.IsZero() {
+		info.ExpireTime = e.ExpireTime.Unix()
+	}
+	if tx.Bucket([]byte(bucketLeases)).Get([]byte(e.leaseID())) != nil {
+		info.LeaseId = e.leaseID()
+	}
+	if e.Parent != "" {
+		if parent, err := getToken(tx, e.Parent); err == nil {
+			info.ParentAccessor = parent.Accessor
+		}
+	}
+	return info
+}
+
+func getToken(tx *bbolt.Tx, id string) (*tokenEntry, error) {
+	data := tx.Bucket([]byte(bucketTokens)).Get([]byte(id))
+	if data == nil {
+		return nil, errTokenNotFound
+	}
+	var e tokenEntry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func putToken(tx *bbolt.Tx, e *tokenEntry) error {
+	data, _ := json.Marshal(e)
+	return tx.Bucket([]byte(bucketTokens)).Put([]byte(e.ID), data)
+}
+
+// issueToken persists a new token and returns its plaintext. A nil parent
+// makes an orphan; otherwise the token is clamped to the parent's lifetime
+// so that it never outlives it. An empty entity gives the token its own,
+// derived from the accessor. Tokens that expire get a lease, so callers
+// should wakeLeases once their transaction commits. Callers are
+// responsible for validating policies and TTLs.
+func (s *VaultServer) issueToken(tx *bbolt.Tx, parent *tokenEntry, entity string, policies []string, displayName string, ttl, explicitMax time.Duration, renewable bool) (string, *tokenEntry, error) {
+	token := tokenPrefix + randomString(24)
+	now := s.now()
+	e := &tokenEntry{
+		ID:          hashToken(token),
+		Accessor:    randomString(18),
+		Policies:    policies,
+		DisplayName: displayName,
+		Entity:      entity,
+		CreateTime:  now,
+		Renewable:   renewable,
+	}
+	if e.Entity == "" {
+		e.Entity = "token-" + e.Accessor[:8]
+	}
+	if parent != nil {
+		e.Parent = parent.ID
+		if !parent.ExpireTime.IsZero() {
+			if renewable && !parent.Renewable {
+				return "", nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("children of a non-renewable token cannot be renewable"))
+			}
+			if remaining := parent.ExpireTime.Sub(now); ttl <= 0 || ttl > remaining {
+				ttl = remaining
+			}
+			if limit := parent.maxExpireTime().Sub(now); explicitMax <= 0 || explicitMax > limit {
+				explicitMax = limit
+			}
+		}
+	}
+	e.TTL = int64(ttl / time.Second)
+	e.ExplicitMax = int64(explicitMax / time.Second)
+	if ttl > 0 {
+		e.ExpireTime = now.Add(ttl)
+	}
+	if err := putToken(tx, e); err != nil {
+		return "", nil, err
+	}
+	if err := tx.Bucket([]byte(bucketTokenAccessors)).Put([]byte(e.Accessor), []byte(e.ID)); err != nil {
+		return "", nil, err
+	}
+	if parent != nil {
+		if err := tx.Bucket([]byte(bucketTokenChildren)).Put([]byte(parent.ID+"/"+e.ID), nil); err != nil {
+			return "", nil, err
+		}
+	}
+	if ttl > 0 {
+		if _, err := s.createLease(tx, e.leaseID(), "token", tokenLease{Accessor: e.Accessor}, ttl, e.maxExpireTime().Sub(now), e.Renewable); err != nil {
+			return "", nil, err
+		}
+	}
+	return token, e, nil
+}
+
+// tokenLease is the lease data of an expiring token.
+type tokenLease struct {
+	Accessor string `json:"accessor"`
+}
+
+// revokeTokenLease revokes the leased token and its children. A token
+// that is already gone counts as revoked.
+func (s *VaultServer) revokeTokenLease(_ context.Context, data json.RawMessage) error {
+	var l tokenLease
+	if err := json.Unmarshal(data, &l); err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		id := tx.Bucket([]byte(bucketTokenAccessors)).Get([]byte(l.Accessor))
+		if id == nil {
+			return nil
+		}
+		_, err := revokeTokenTree(tx, string(id))
+		if errors.Is(err, errTokenNotFound) {
+			return nil
+		}
+		return err
+	})
+}
+
+// renewTokenLease extends the leased token to expire, or to its parent's
+// expiry if that comes first.
+func (s *VaultServer) renewTokenLease(_ context.Context, data json.RawMessage, expire time.Time) error {
+	var l tokenLease
+	if err := json.Unmarshal(data, &l); err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		e, err := getToken(tx, string(tx.Bucket([]byte(bucketTokenAccessors)).Get([]byte(l.Accessor))))
+		if err != nil {
+			return err
+		}
+		e.ExpireTime = parentExpiry(tx, e, expire)
+		return putToken(tx, e)
+	})
+}
+
+// parentExpiry clamps expire to the expiry of e's parent, if it has one.
+func parentExpiry(tx *bbolt.Tx, e *tokenEntry, expire time.Time) time.Time {
+	if e.Parent != "" {
+		if parent, err := getToken(tx, e.Parent); err == nil && !parent.ExpireTime.IsZero() {
+			expire = minTime(expire, parent.ExpireTime)
+		}
+	}
+	return expire
+}
+
+// revokeTokenTree deletes a token and, recursively, every child it created.
+func revokeTokenTree(tx *bbolt.Tx, id string) (int32, error) {
+	e, err := getToken(tx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	children := tx.Bucket([]byte(bucketTokenChildren))
+	var kids []string
+	prefix := []byte(id + "/")
+	c := children.Cursor()
+	for k, _ := c.Seek(prefix); k != nil && strings.HasPrefix(string(k), string(prefix)); k, _ = c.Next() {
+		kids = append(kids, strings.TrimPrefix(string(k), string(prefix)))
+	}
+
+	var revoked int32
+	for _, kid := range kids {
+		children.Delete([]byte(id + "/" + kid))
+		n, err := revokeTokenTree(tx, kid)
+		if err != nil && !errors.Is(err, errTokenNotFound) {
+			return revoked, err
+		}
+		revoked += n
+	}
+
+	if e.Parent != "" {
+		children.Delete([]byte(e.Parent + "/" + id))
+	}
+	tx.Bucket([]byte(bucketLeases)).Delete([]byte(e.leaseID()))
+	tx.Bucket([]byte(bucketTokenAccessors)).Delete([]byte(e.Accessor))
+	if err := tx.Bucket([]byte(bucketTokens)).Delete([]byte(id)); err != nil {
+		return revoked, err
+	}
+	return revoked + 1, nil
+}
+
+// bootstrapRootToken mints the initial root token the first time the vault
+// is opened and leaves it in the storage directory for the operator.
+func (s *VaultServer) bootstrapRootToken(storageDir string) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		if k, _ := tx.Bucket([]byte(bucketTokens)).Cursor().First(); k != nil {
+			return nil
+		}
+		token, _, err := s.issueToken(tx, nil, "root", []string{policyRoot}, "root", 0, 0, false)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(storageDir, rootTokenFile)
+		if err := os.WriteFile(path, []byte(token+"\n"), 0600); err != nil {
+			return err
+		}
+		slog.Warn("Generated initial root token", "path", path)
+		return nil
+	})
+}
+
+// lookupCaller resolves a presented token into a Caller.
+func (s *VaultServer) lookupCaller(token string) (*Caller, error) {
+	var c *Caller
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		e, err := getToken(tx, hashToken(token))
+		if err != nil {
+			return err
+		}
+		if e.expired(s.now()) || !s.ancestorsLive(tx, e) {
+			return errTokenNotFound
+		}
+		c = &Caller{Identity: e.identity(), Source: "token", TokenID: e.ID, TokenAccessor: e.Accessor, Policies: e.Policies}
+		return nil
+	})
+	return c, err
+}
+
+// ancestorsLive reports whether every token above e is still valid; a
+// token stops working as soon as any of its ancestors expires.
+func (s *VaultServer) ancestorsLive(tx *bbolt.Tx, e *tokenEntry) bool {
+	for id := e.Parent; id != ""; {
+		p, err := getToken(tx, id)
+		if err != nil || p.expired(s.now()) {
+			return false
+		}
+		id = p.Parent
+	}
+	return true
+}
+
+func bearerToken(h interface{ Get(string) string }) string {
+	if t := h.Get("X-Vault-Token"); t != "" {
+		return t
+	}
+	auth := h.Get("Authorization")
+	if t, ok := strings.CutPrefix(auth, "Bearer "); ok {
+		return strings.TrimSpace(t)
+	}
+	return ""
+}
+
+// publicProcedures are reachable without credentials: logins issue them,
+// and CA certificates, CRLs and the SSH CA key are public.
+var publicProcedures = map[string]bool{
+	vaultv1connect.VaultServiceLoginProcedure:        true,
+	vaultv1connect.VaultServiceJWTLoginProcedure:     true,
+	vaultv1connect.PKIServiceGetCAProcedure:          true,
+	vaultv1connect.PKIServiceGetCRLProcedure:         true,
+	vaultv1connect.SSHServiceGetCAPublicKeyProcedure: true,
+}
+
+// AuthInterceptor resolves the bearer token on each request into the
+// caller identity used for policy evaluation.
+func (s *VaultServer) AuthInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if token := bearerToken(req.Header()); token != "" {
+				c, err := s.lookupCaller(token)
+				if err != nil {
+					return nil, connect.NewError(connect.CodeUnauthenticated, err)
+				}
+				ctx = WithCaller(ctx, c)
+			} else if s.requireAuth && CallerFrom(ctx) == nil && !publicProcedures[req.Spec().Procedure] {
+				return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("missing bearer token"))
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+func requireTokenCaller(ctx context.Context) (*Caller, error) {
+	c := CallerFrom(ctx)
+	if c == nil || c.TokenID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("a vault token is required"))
+	}
+	return c, nil
+}
+
+// targetToken authorizes and resolves the token an RPC operates on. An
+// empty token and accessor mean the caller's own token, which the default
+// policy allows; anything else requires the capability on auth/token/<op>.
+func (s *VaultServer) targetToken(ctx context.Context, token, accessor, capability, op string) (string, error) {
+	c, err := requireTokenCaller(ctx)
+	if err != nil {
+		return "", err
+	}
+	id := c.TokenID
+	switch {
+	case token != "":
+		id = hashToken(token)
+	case accessor != "":
+		s.db.View(func(tx *bbolt.Tx) error {
+			id = string(tx.Bucket([]byte(bucketTokenAccessors)).Get([]byte(accessor)))
+			return nil
+		})
+		if id == "" {
+			return "", connect.NewError(connect.CodeNotFound, errTokenNotFound)
+		}
+	}
+	resource := "auth/token/" + op
+	if id == c.TokenID {
+		resource = "auth/token/self"
+	}
+	if err := s.checkACL(c, capability, resource); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (s *VaultServer) liveToken(tx *bbolt.Tx, id string) (*tokenEntry, error) {
+	e, err := getToken(tx, id)
+	if err != nil || e.expired(s.now()) {
+		return nil, connect.NewError(connect.CodeNotFound, errTokenNotFound)
+	}
+	return e, nil
+}
+
+func (s *VaultServer) CreateToken(ctx context.Context, req *connect.Request[vaultv1.CreateTokenRequest]) (*connect.Response[vaultv1.CreateTokenResponse], error) {
+	slog.Info("CreateToken", "display_name", req.Msg.DisplayName, "policies", req.Msg.Policies)
+	c, err := requireTokenCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	privileged := s.checkACL(c, "sudo", "auth/token/create") == nil
+	policies := req.Msg.Policies
+	if len(policies) == 0 {
+		policies = slices.Clone(c.Policies)
+	}
+	if !privileged {
+		for _, p := range policies {
+			if !slices.Contains(c.Policies, p) {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("child token cannot be granted policy %q", p))
+			}
+		}
+		if req.Msg.NoParent {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("orphan tokens require sudo on auth/token/create"))
+		}
+	}
+	if !slices.Contains(policies, policyDefault) && !slices.Contains(policies, policyRoot) {
+		policies = append(policies, policyDefault)
+	}
+
+	ttl := time.Duration(req.Msg.TtlSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = defaultTokenTTL
+	}
+	maxTTL := maxTokenTTL
+	if req.Msg.ExplicitMaxTtlSeconds > 0 {
+		maxTTL = min(maxTTL, time.Duration(req.Msg.ExplicitMaxTtlSeconds)*time.Second)
+	}
+	ttl = min(ttl, maxTTL)
+
+	var token string
+	var info *vaultv1.TokenInfo
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		var parent *tokenEntry
+		if !req.Msg.NoParent {
+			p, err := s.liveToken(tx, c.TokenID)
+			if err != nil {
+				return err
+			}
+			parent = p
+		}
+		// Sudo callers name the principal a token acts for; everyone else
+		// mints children of their own principal.
+		entity, displayName := req.Msg.DisplayName, req.Msg.DisplayName
+		if !privileged {
+			entity = parent.identity()
+			if displayName != "" {
+				displayName = entity + "/" + displayName
+			}
+		}
+		var e *tokenEntry
+		var err error
+		token, e, err = s.issueToken(tx, parent, entity, policies, displayName, ttl, time.Duration(req.Msg.ExplicitMaxTtlSeconds)*time.Second, req.Msg.Renewable)
+		if err != nil {
+			return err
+		}
+		info = e.info(tx)
+		return nil
+	})
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeUnknown {
+			err = connect.NewError(connect.CodeInternal, err)
+		}
+		return nil, err
+	}
+	s.wakeLeases()
+	return connect.NewResponse(&vaultv1.CreateTokenResponse{Token: token, Info: info}), nil
+}
+
+func (s *VaultServer) LookupToken(ctx context.Context, req *connect.Request[vaultv1.LookupTokenRequest]) (*connect.Response[vaultv1.TokenInfo], error) {
+	slog.Info("LookupToken", "accessor", req.Msg.Accessor)
+	id, err := s.targetToken(ctx, req.Msg.Token, req.Msg.Accessor, "read", "lookup")
+	if err != nil {
+		return nil, err
+	}
+	var info *vaultv1.TokenInfo
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		e, err := s.liveToken(tx, id)
+		if err != nil {
+			return err
+		}
+		info = e.info(tx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(info), nil
+}
+
+func (s *VaultServer) RenewToken(ctx context.Context, req *connect.Request[vaultv1.RenewTokenRequest]) (*connect.Response[vaultv1.TokenInfo], error) {
+	slog.Info("RenewToken", "increment", req.Msg.IncrementSeconds)
+	id, err := s.targetToken(ctx, req.Msg.Token, "", "update", "renew")
+	if err != nil {
+		return nil, err
+	}
+	var info *vaultv1.TokenInfo
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		e, err := s.liveToken(tx, id)
+		if err != nil {
+			return err
+		}
+		if e.ExpireTime.IsZero() {
+			info = e.info(tx)
+			return nil
+		}
+		if !e.Renewable {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("token is not renewable"))
+		}
+
+		increment := time.Duration(req.Msg.IncrementSeconds) * time.Second
+		if increment <= 0 {
+			increment = time.Duration(e.TTL) * time.Second
+		}
+		e.ExpireTime = parentExpiry(tx, e, minTime(s.now().Add(increment), e.maxExpireTime()))
+		if err := putToken(tx, e); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if l, err := getLease(tx, e.leaseID()); err == nil {
+			l.ExpireTime = e.ExpireTime
+			if err := putLease(tx, e.leaseID(), l); err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+		}
+		info = e.info(tx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(info), nil
+}
+
+func (s *VaultServer) RevokeToken(ctx context.Context, req *connect.Request[vaultv1.RevokeTokenRequest]) (*connect.Response[vaultv1.RevokeTokenResponse], error) {
+	slog.Info("RevokeToken", "accessor", req.Msg.Accessor)
+	id, err := s.targetToken(ctx, req.Msg.Token, req.Msg.Accessor, "update", "revoke")
+	if err != nil {
+		return nil, err
+	}
+	var revoked int32
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		e, err := s.liveToken(tx, id)
+		if err != nil {
+			return err
+		}
+		revoked, err = revokeTokenTree(tx, e.ID)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.RevokeTokenResponse{Revoked: revoked}), nil
+}
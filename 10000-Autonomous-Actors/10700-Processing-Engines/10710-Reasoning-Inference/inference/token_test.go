@@ -0,0 +1,212 @@
+package inference
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault/vaultv1connect"
+	"connectrpc.com/connect"
+)
+
+// newTestClient serves the vault over HTTP with the auth interceptor and
+// returns a client plus the bootstrap root token.
+func newTestClient(t *testing.T, opts ...Option) (*VaultServer, vaultv1connect.VaultServiceClient, string) {
+	dir := t.TempDir()
+	server := NewVaultServer(dir, opts...)
+	t.Cleanup(func() { server.Close() })
+
+	root, err := os.ReadFile(filepath.Join(dir, rootTokenFile))
+	if err != nil {
+		t.Fatalf("root token not written: %v", err)
+	}
+	return server, serveTestClient(t, server), strings.TrimSpace(string(root))
+}
+
+// serveTestClient serves server over HTTP for the duration of the test.
+func serveTestClient(t *testing.T, server *VaultServer) vaultv1connect.VaultServiceClient {
+	path, h := vaultv1connect.NewVaultServiceHandler(server, connect.WithInterceptors(server.AuthInterceptor()))
+	return vaultv1connect.NewVaultServiceClient(http.DefaultClient, serveTest(t, path, h))
+}
+
+// serveTest serves h at path for the duration of the test and returns
+// the server's URL.
+func serveTest(t *testing.T, path string, h http.Handler) string {
+	mux := http.NewServeMux()
+	mux.Handle(path, h)
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts.URL
+}
+
+func withToken[T any](msg *T, token string) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func TestTokenLifecycle(t *testing.T) {
+	server, client, root := newTestClient(t, RequireAuth())
+	ctx := context.Background()
+	clock := time.Now()
+	server.now = func() time.Time { return clock }
+
+	if _, err := client.VaultRead(ctx, connect.NewRequest(&vaultv1.VaultReadRequest{Key: "app/db"})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("Expected Unauthenticated without a token, got %v", err)
+	}
+
+	_, err := client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name:  "app-reader",
+		Rules: []*vaultv1.PolicyRule{{Path: "app/*", Capabilities: []string{"read"}}},
+	}}, root))
+	if err != nil {
+		t.Fatalf("PutPolicy failed: %v", err)
+	}
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "app/db", Value: "pw"}, root))
+
+	parent, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{Policies: []string{"app-reader"}, TtlSeconds: 60, Renewable: true, DisplayName: "ci"}, root))
+	if err != nil {
+		t.Fatalf("CreateToken failed: %v", err)
+	}
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "app/db"}, parent.Msg.Token)); err != nil {
+		t.Errorf("Expected app-reader token to read app/db: %v", err)
+	}
+	if _, err := client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "app/db", Value: "x"}, parent.Msg.Token)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected PermissionDenied writing with read-only token, got %v", err)
+	}
+	if _, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{Policies: []string{"root"}}, parent.Msg.Token)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected child token policy escalation to be denied, got %v", err)
+	}
+
+	child, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{}, parent.Msg.Token))
+	if err != nil {
+		t.Fatalf("CreateToken child failed: %v", err)
+	}
+	if child.Msg.Info.ParentAccessor != parent.Msg.Info.Accessor {
+		t.Errorf("Expected parent accessor %s, got %s", parent.Msg.Info.Accessor, child.Msg.Info.ParentAccessor)
+	}
+
+	// Renewal extends the TTL from now.
+	clock = clock.Add(50 * time.Second)
+	renewed, err := client.RenewToken(ctx, withToken(&vaultv1.RenewTokenRequest{}, parent.Msg.Token))
+	if err != nil {
+		t.Fatalf("RenewToken failed: %v", err)
+	}
+	if renewed.Msg.ExpireTime != clock.Add(60*time.Second).Unix() {
+		t.Errorf("Expected renewed expiry %d, got %d", clock.Add(60*time.Second).Unix(), renewed.Msg.ExpireTime)
+	}
+
+	// Revoking the parent takes the child with it.
+	rev, err := client.RevokeToken(ctx, withToken(&vaultv1.RevokeTokenRequest{Accessor: parent.Msg.Info.Accessor}, root))
+	if err != nil {
+		t.Fatalf("RevokeToken failed: %v", err)
+	}
+	if rev.Msg.Revoked != 2 {
+		t.Errorf("Expected 2 tokens revoked, got %d", rev.Msg.Revoked)
+	}
+	if _, err := client.LookupToken(ctx, withToken(&vaultv1.LookupTokenRequest{}, child.Msg.Token)); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("Expected revoked child token to be rejected, got %v", err)
+	}
+
+	// Expired tokens stop authenticating.
+	short, _ := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{TtlSeconds: 5}, root))
+	clock = clock.Add(10 * time.Second)
+	if _, err := client.LookupToken(ctx, withToken(&vaultv1.LookupTokenRequest{}, short.Msg.Token)); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("Expected expired token to be rejected, got %v", err)
+	}
+}
+
+func TestChildTokensBoundToParent(t *testing.T) {
+	server, client, root := newTestClient(t, RequireAuth())
+	ctx := context.Background()
+	clock := time.Now()
+	server.now = func() time.Time { return clock }
+
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name: "payments", Rules: []*vaultv1.PolicyRule{{Path: "payments/*", Capabilities: []string{"read"}}},
+	}}, root))
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "payments/api", Value: "pw"}, root))
+	parent, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{Policies: []string{"payments"}, TtlSeconds: 4 * 3600, DisplayName: "oncall"}, root))
+	if err != nil {
+		t.Fatalf("CreateToken: %v", err)
+	}
+	if parent.Msg.Info.Entity != "oncall" {
+		t.Errorf("sudo-issued token entity = %q, want its display name", parent.Msg.Info.Entity)
+	}
+
+	if _, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{Renewable: true}, parent.Msg.Token)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("renewable child of a non-renewable token: expected InvalidArgument, got %v", err)
+	}
+	child, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{TtlSeconds: 30 * 86400, ExplicitMaxTtlSeconds: 30 * 86400, DisplayName: "payments-service"}, parent.Msg.Token))
+	if err != nil {
+		t.Fatalf("CreateToken child: %v", err)
+	}
+	if info := child.Msg.Info; info.Entity != "oncall" || info.DisplayName != "oncall/payments-service" {
+		t.Errorf("child entity = %q, display name = %q; want the parent's principal", info.Entity, info.DisplayName)
+	}
+	if info := child.Msg.Info; info.ExpireTime != parent.Msg.Info.ExpireTime || info.ExplicitMaxTtlSeconds > 4*3600 {
+		t.Errorf("child expires at %d (max %ds), want it clamped to the parent's %d", info.ExpireTime, info.ExplicitMaxTtlSeconds, parent.Msg.Info.ExpireTime)
+	}
+
+	clock = clock.Add(5 * time.Hour)
+	if _, err := client.VaultRead(ctx, withToken(&vaultv1.VaultReadRequest{Key: "payments/api"}, child.Msg.Token)); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("child read after the parent expired: expected Unauthenticated, got %v", err)
+	}
+
+	// A renewable child cannot be renewed past its parent either.
+	renewable, _ := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{TtlSeconds: 60, Renewable: true}, root))
+	grandchild, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{TtlSeconds: 30, Renewable: true}, renewable.Msg.Token))
+	if err != nil {
+		t.Fatalf("CreateToken renewable child: %v", err)
+	}
+	clock = clock.Add(20 * time.Second)
+	renewed, err := client.RenewToken(ctx, withToken(&vaultv1.RenewTokenRequest{IncrementSeconds: 3600}, grandchild.Msg.Token))
+	if err != nil || renewed.Msg.ExpireTime != renewable.Msg.Info.ExpireTime {
+		t.Errorf("RenewToken = %v, %v; want expiry capped at the parent's %d", renewed, err, renewable.Msg.Info.ExpireTime)
+	}
+}
+
+func TestCreateAndUpdateCapabilities(t *testing.T) {
+	_, client, root := newTestClient(t, RequireAuth())
+	ctx := context.Background()
+
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name:  "creator",
+		Rules: []*vaultv1.PolicyRule{{Path: "app/*", Capabilities: []string{"create"}}},
+	}}, root))
+	client.PutPolicy(ctx, withToken(&vaultv1.PutPolicyRequest{Policy: &vaultv1.Policy{
+		Name:  "updater",
+		Rules: []*vaultv1.PolicyRule{{Path: "app/*", Capabilities: []string{"update"}}},
+	}}, root))
+	token := func(policy string) string {
+		res, err := client.CreateToken(ctx, withToken(&vaultv1.CreateTokenRequest{Policies: []string{policy}}, root))
+		if err != nil {
+			t.Fatalf("CreateToken failed: %v", err)
+		}
+		return res.Msg.Token
+	}
+	creator, updater := token("creator"), token("updater")
+	write := func(key, token string) error {
+		_, err := client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: key, Value: "v"}, token))
+		return err
+	}
+
+	if err := write("app/new", updater); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected update alone not to create a secret, got %v", err)
+	}
+	if err := write("app/new", creator); err != nil {
+		t.Fatalf("Expected create to write a new secret, got %v", err)
+	}
+	if err := write("app/new", creator); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("Expected create alone not to overwrite a secret, got %v", err)
+	}
+	if err := write("app/new", updater); err != nil {
+		t.Errorf("Expected update to overwrite an existing secret, got %v", err)
+	}
+}
@@ -0,0 +1,406 @@
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	transitKeyAES256 = "aes256-gcm96"
+	ciphertextPrefix = "vault:v"
+)
+
+// TransitServer serves TransitService from the vault's storage.
+type TransitServer struct {
+	*VaultServer
+}
+
+// Transit returns the TransitService handler backed by s.
+func (s *VaultServer) Transit() *TransitServer {
+	return &TransitServer{s}
+}
+
+// transitKeyVersion holds the raw AES key, or the PKCS #8 private key for
+// signing types, plus an independent key for HMACs.
+type transitKeyVersion struct {
+	Key        []byte    `json:"key"`
+	HMACKey    []byte    `json:"hmac_key"`
+	CreateTime time.Time `json:"create_time"`
+}
+
+// transitKey is stored sealed by the barrier. Versions[i] is version i+1.
+type transitKey struct {
+	Type          string              `json:"type"`
+	Derived       bool                `json:"derived,omitempty"`
+	Convergent    bool                `json:"convergent,omitempty"`
+	MinDecryption int32               `json:"min_decryption_version"`
+	Versions      []transitKeyVersion `json:"versions"`
+}
+
+func (k *transitKey) latest() int32 { return int32(len(k.Versions)) }
+
+func (k *transitKey) proto(name string) *vaultv1.TransitKey {
+	res := &vaultv1.TransitKey{
+		Name:                 name,
+		Type:                 k.Type,
+		LatestVersion:        k.latest(),
+		MinDecryptionVersion: k.MinDecryption,
+		Derived:              k.Derived,
+		ConvergentEncryption: k.Convergent,
+	}
+	for i, v := range k.Versions {
+		res.Versions = append(res.Versions, &vaultv1.TransitKeyVersion{Version: int32(i + 1), CreateTime: v.CreateTime.Unix()})
+	}
+	return res
+}
+
+func (k *transitKey) addVersion(now time.Time) error {
+	var key []byte
+	if k.Type == transitKeyAES256 {
+		key = make([]byte, 32)
+		rand.Read(key)
+	} else {
+		var err error
+		if key, err = generateSigningKey(k.Type); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	hmacKey := make([]byte, 32)
+	rand.Read(hmacKey)
+	k.Versions = append(k.Versions, transitKeyVersion{Key: key, HMACKey: hmacKey, CreateTime: now})
+	return nil
+}
+
+// cipher returns the AEAD for version and the key it was built from; for
+// derived keys that key is derived from the version key and context.
+func (k *transitKey) cipher(version int32, kctx []byte) (cipher.AEAD, []byte, error) {
+	if k.Type != transitKeyAES256 {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key type %s does not support encryption", k.Type))
+	}
+	key := k.Versions[version-1].Key
+	if k.Derived {
+		if len(kctx) == 0 {
+			return nil, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("context is required for derived keys"))
+		}
+		var err error
+		if key, err = hkdf.Key(sha256.New, key, nil, string(kctx), 32); err != nil {
+			return nil, nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return aead, key, nil
+}
+
+// encryptionVersion resolves a requested version, 0 meaning latest.
+func (k *transitKey) encryptionVersion(v int32) (int32, error) {
+	if v == 0 {
+		return k.latest(), nil
+	}
+	if v < k.MinDecryption || v > k.latest() {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key version %d is outside %d..%d", v, k.MinDecryption, k.latest()))
+	}
+	return v, nil
+}
+
+func (k *transitKey) encrypt(version int32, plaintext, kctx []byte) (string, error) {
+	aead, key, err := k.cipher(version, kctx)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if k.Convergent {
+		// The nonce is a MAC of the plaintext, so equal plaintexts under
+		// the same derived key encrypt identically.
+		mac := hmac.New(sha256.New, key)
+		mac.Write(plaintext)
+		copy(nonce, mac.Sum(nil))
+	} else {
+		rand.Read(nonce)
+	}
+	sealed := aead.Seal(nonce, nonce, plaintext, nil)
+	return versioned(version, sealed), nil
+}
+
+// parseVersioned splits a "vault:v<N>:<base64>" value produced by key k,
+// checking N against the key's usable versions. what names the value in
+// errors.
+func (k *transitKey) parseVersioned(what, value string) (int32, []byte, error) {
+	rest, ok := strings.CutPrefix(value, ciphertextPrefix)
+	ver, payload, ok2 := strings.Cut(rest, ":")
+	n, err := strconv.Atoi(ver)
+	raw, err2 := base64.StdEncoding.DecodeString(payload)
+	if !ok || !ok2 || err != nil || err2 != nil || n <= 0 {
+		return 0, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("malformed %s", what))
+	}
+	version := int32(n)
+	if version > k.latest() {
+		return 0, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s key version %d does not exist", what, version))
+	}
+	if version < k.MinDecryption {
+		return 0, nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s key version %d is below the minimum decryption version %d", what, version, k.MinDecryption))
+	}
+	return version, raw, nil
+}
+
+func versioned(version int32, raw []byte) string {
+	return ciphertextPrefix + strconv.Itoa(int(version)) + ":" + base64.StdEncoding.EncodeToString(raw)
+}
+
+func (k *transitKey) decrypt(ciphertext string, kctx []byte) ([]byte, int32, error) {
+	version, raw, err := k.parseVersioned("ciphertext", ciphertext)
+	if err != nil {
+		return nil, 0, err
+	}
+	aead, _, err := k.cipher(version, kctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(raw) < aead.NonceSize() {
+		return nil, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("malformed ciphertext"))
+	}
+	plaintext, err := aead.Open(nil, raw[:aead.NonceSize()], raw[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ciphertext could not be decrypted"))
+	}
+	return plaintext, version, nil
+}
+
+func (s *VaultServer) getTransitKey(tx *bbolt.Tx, name string) (*transitKey, error) {
+	data := tx.Bucket([]byte(bucketTransitKeys)).Get([]byte(name))
+	if data == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("transit key not found: %s", name))
+	}
+	plain, err := s.barrier.open(bucketTransitKeys+"/"+name, data)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var k transitKey
+	if err := json.Unmarshal(plain, &k); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &k, nil
+}
+
+func (s *VaultServer) putTransitKey(tx *bbolt.Tx, name string, k *transitKey) error {
+	data, _ := json.Marshal(k)
+	return tx.Bucket([]byte(bucketTransitKeys)).Put([]byte(name), s.barrier.seal(bucketTransitKeys+"/"+name, data))
+}
+
+func (s *VaultServer) loadTransitKey(name string) (*transitKey, error) {
+	var k *transitKey
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		k, err = s.getTransitKey(tx, name)
+		return err
+	})
+	return k, err
+}
+
+// updateTransitKey applies fn to the stored key under a write transaction.
+func (s *VaultServer) updateTransitKey(name string, fn func(k *transitKey) error) (*transitKey, error) {
+	var k *transitKey
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		var err error
+		if k, err = s.getTransitKey(tx, name); err != nil {
+			return err
+		}
+		if err := fn(k); err != nil {
+			return err
+		}
+		if err := s.putTransitKey(tx, name, k); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	})
+	return k, err
+}
+
+func (t *TransitServer) CreateKey(ctx context.Context, req *connect.Request[vaultv1.CreateTransitKeyRequest]) (*connect.Response[vaultv1.TransitKey], error) {
+	name := req.Msg.Name
+	slog.Info("CreateTransitKey", "name", name, "type", req.Msg.Type)
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key name is required"))
+	}
+	if err := t.authorize(ctx, "create", "transit/keys/"+name); err != nil {
+		return nil, err
+	}
+	k := &transitKey{Type: req.Msg.Type, Derived: req.Msg.Derived, Convergent: req.Msg.ConvergentEncryption, MinDecryption: 1}
+	if k.Type == "" {
+		k.Type = transitKeyAES256
+	}
+	if k.Type != transitKeyAES256 && signingKeyTypes[k.Type] == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported key type %q", k.Type))
+	}
+	if k.Derived && k.Type != transitKeyAES256 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key type %s cannot be derived", k.Type))
+	}
+	if k.Convergent && !k.Derived {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("convergent encryption requires a derived key"))
+	}
+	if err := k.addVersion(t.now()); err != nil {
+		return nil, err
+	}
+
+	err := t.db.Update(func(tx *bbolt.Tx) error {
+		if tx.Bucket([]byte(bucketTransitKeys)).Get([]byte(name)) != nil {
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("transit key already exists: %s", name))
+		}
+		if err := t.putTransitKey(tx, name, k); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		return nil
+	})
+	t.audit(ctx, "CreateTransitKey", "transit/keys/"+name, 1, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(k.proto(name)), nil
+}
+
+func (t *TransitServer) GetKey(ctx context.Context, req *connect.Request[vaultv1.GetTransitKeyRequest]) (*connect.Response[vaultv1.TransitKey], error) {
+	name := req.Msg.Name
+	slog.Info("GetTransitKey", "name", name)
+	if err := t.authorize(ctx, "read", "transit/keys/"+name); err != nil {
+		return nil, err
+	}
+	k, err := t.loadTransitKey(name)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(k.proto(name)), nil
+}
+
+func (t *TransitServer) RotateKey(ctx context.Context, req *connect.Request[vaultv1.RotateTransitKeyRequest]) (*connect.Response[vaultv1.TransitKey], error) {
+	name := req.Msg.Name
+	slog.Info("RotateTransitKey", "name", name)
+	if err := t.authorize(ctx, "update", "transit/keys/"+name+"/rotate"); err != nil {
+		return nil, err
+	}
+	k, err := t.updateTransitKey(name, func(k *transitKey) error {
+		return k.addVersion(t.now())
+	})
+	var version int32
+	if k != nil {
+		version = k.latest()
+	}
+	t.audit(ctx, "RotateTransitKey", "transit/keys/"+name, version, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(k.proto(name)), nil
+}
+
+func (t *TransitServer) UpdateKeyConfig(ctx context.Context, req *connect.Request[vaultv1.UpdateTransitKeyConfigRequest]) (*connect.Response[vaultv1.TransitKey], error) {
+	name := req.Msg.Name
+	slog.Info("UpdateTransitKeyConfig", "name", name, "min_decryption_version", req.Msg.MinDecryptionVersion)
+	if err := t.authorize(ctx, "update", "transit/keys/"+name+"/config"); err != nil {
+		return nil, err
+	}
+	k, err := t.updateTransitKey(name, func(k *transitKey) error {
+		if v := req.Msg.MinDecryptionVersion; v != 0 {
+			if v < 1 || v > k.latest() {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("min_decryption_version must be between 1 and %d", k.latest()))
+			}
+			k.MinDecryption = v
+		}
+		return nil
+	})
+	t.audit(ctx, "UpdateTransitKeyConfig", "transit/keys/"+name, 0, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(k.proto(name)), nil
+}
+
+func (t *TransitServer) Encrypt(ctx context.Context, req *connect.Request[vaultv1.TransitEncryptRequest]) (*connect.Response[vaultv1.TransitEncryptResponse], error) {
+	name := req.Msg.Name
+	slog.Info("TransitEncrypt", "name", name)
+	if err := t.authorize(ctx, "update", "transit/encrypt/"+name); err != nil {
+		return nil, err
+	}
+	k, err := t.loadTransitKey(name)
+	if err != nil {
+		return nil, err
+	}
+	version, err := k.encryptionVersion(req.Msg.KeyVersion)
+	if err != nil {
+		return nil, err
+	}
+	ct, err := k.encrypt(version, req.Msg.Plaintext, req.Msg.Context)
+	t.audit(ctx, "TransitEncrypt", "transit/keys/"+name, version, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.TransitEncryptResponse{Ciphertext: ct, KeyVersion: version}), nil
+}
+
+func (t *TransitServer) Decrypt(ctx context.Context, req *connect.Request[vaultv1.TransitDecryptRequest]) (*connect.Response[vaultv1.TransitDecryptResponse], error) {
+	name := req.Msg.Name
+	slog.Info("TransitDecrypt", "name", name)
+	if err := t.authorize(ctx, "update", "transit/decrypt/"+name); err != nil {
+		return nil, err
+	}
+	k, err := t.loadTransitKey(name)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, version, err := k.decrypt(req.Msg.Ciphertext, req.Msg.Context)
+	t.audit(ctx, "TransitDecrypt", "transit/keys/"+name, version, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.TransitDecryptResponse{Plaintext: plaintext}), nil
+}
+
+// Rewrap re-encrypts ciphertext under the latest (or requested) key
+// version without revealing the plaintext to the caller.
+func (t *TransitServer) Rewrap(ctx context.Context, req *connect.Request[vaultv1.TransitRewrapRequest]) (*connect.Response[vaultv1.TransitEncryptResponse], error) {
+	name := req.Msg.Name
+	slog.Info("TransitRewrap", "name", name)
+	if err := t.authorize(ctx, "update", "transit/rewrap/"+name); err != nil {
+		return nil, err
+	}
+	k, err := t.loadTransitKey(name)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, _, err := k.decrypt(req.Msg.Ciphertext, req.Msg.Context)
+	if err != nil {
+		return nil, err
+	}
+	version, err := k.encryptionVersion(req.Msg.KeyVersion)
+	if err != nil {
+		return nil, err
+	}
+	ct, err := k.encrypt(version, plaintext, req.Msg.Context)
+	t.audit(ctx, "TransitRewrap", "transit/keys/"+name, version, err)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vaultv1.TransitEncryptResponse{Ciphertext: ct, KeyVersion: version}), nil
+}
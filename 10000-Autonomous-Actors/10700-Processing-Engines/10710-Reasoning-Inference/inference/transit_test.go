@@ -0,0 +1,123 @@
+package inference
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault/vaultv1connect"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+func newTransitClient(t *testing.T, server *VaultServer) vaultv1connect.TransitServiceClient {
+	path, h := vaultv1connect.NewTransitServiceHandler(server.Transit(), connect.WithInterceptors(server.AuthInterceptor()))
+	return vaultv1connect.NewTransitServiceClient(http.DefaultClient, serveTest(t, path, h))
+}
+
+func TestTransitEncryptDecrypt(t *testing.T) {
+	server, _, root := newTestClient(t, RequireAuth())
+	transit := newTransitClient(t, server)
+	ctx := context.Background()
+
+	if _, err := transit.CreateKey(ctx, connect.NewRequest(&vaultv1.CreateTransitKeyRequest{Name: "orders"})); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("CreateKey without token: expected Unauthenticated, got %v", err)
+	}
+	key, err := transit.CreateKey(ctx, withToken(&vaultv1.CreateTransitKeyRequest{Name: "orders"}, root))
+	if err != nil {
+		t.Fatalf("CreateKey: %v", err)
+	}
+	if key.Msg.Type != transitKeyAES256 || key.Msg.LatestVersion != 1 {
+		t.Errorf("created key = %+v", key.Msg)
+	}
+
+	enc, err := transit.Encrypt(ctx, withToken(&vaultv1.TransitEncryptRequest{Name: "orders", Plaintext: []byte("4111-1111")}, root))
+	if err != nil {
+		t.Fatalf("Encrypt: %v", err)
+	}
+	v1 := enc.Msg.Ciphertext
+	if !strings.HasPrefix(v1, "vault:v1:") {
+		t.Errorf("ciphertext = %q, want vault:v1: prefix", v1)
+	}
+
+	// Rotation keeps old ciphertext readable and rewrap moves it forward.
+	if _, err := transit.RotateKey(ctx, withToken(&vaultv1.RotateTransitKeyRequest{Name: "orders"}, root)); err != nil {
+		t.Fatalf("RotateKey: %v", err)
+	}
+	rw, err := transit.Rewrap(ctx, withToken(&vaultv1.TransitRewrapRequest{Name: "orders", Ciphertext: v1}, root))
+	if err != nil || rw.Msg.KeyVersion != 2 || !strings.HasPrefix(rw.Msg.Ciphertext, "vault:v2:") {
+		t.Fatalf("Rewrap = %+v, %v", rw, err)
+	}
+	for _, ct := range []string{v1, rw.Msg.Ciphertext} {
+		dec, err := transit.Decrypt(ctx, withToken(&vaultv1.TransitDecryptRequest{Name: "orders", Ciphertext: ct}, root))
+		if err != nil || string(dec.Msg.Plaintext) != "4111-1111" {
+			t.Errorf("Decrypt(%s) = %v, %v", ct[:9], dec, err)
+		}
+	}
+
+	// Raising the minimum decryption version retires v1 ciphertext.
+	if _, err := transit.UpdateKeyConfig(ctx, withToken(&vaultv1.UpdateTransitKeyConfigRequest{Name: "orders", MinDecryptionVersion: 2}, root)); err != nil {
+		t.Fatalf("UpdateKeyConfig: %v", err)
+	}
+	if _, err := transit.Decrypt(ctx, withToken(&vaultv1.TransitDecryptRequest{Name: "orders", Ciphertext: v1}, root)); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("Decrypt below min version: expected FailedPrecondition, got %v", err)
+	}
+	if _, err := transit.Encrypt(ctx, withToken(&vaultv1.TransitEncryptRequest{Name: "orders", Plaintext: []byte("x"), KeyVersion: 1}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("Encrypt with retired version: expected InvalidArgument, got %v", err)
+	}
+	if _, err := transit.Decrypt(ctx, withToken(&vaultv1.TransitDecryptRequest{Name: "orders", Ciphertext: "vault:v2:!!"}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("Decrypt malformed: expected InvalidArgument, got %v", err)
+	}
+
+	// Key material is sealed by the barrier, never stored in the clear.
+	server.db.View(func(tx *bbolt.Tx) error {
+		raw := tx.Bucket([]byte(bucketTransitKeys)).Get([]byte("orders"))
+		k, _ := server.getTransitKey(tx, "orders")
+		if bytes.Contains(raw, k.Versions[0].Key) || bytes.Contains(raw, []byte("aes256")) {
+			t.Error("transit key stored unsealed")
+		}
+		return nil
+	})
+}
+
+func TestTransitDerivedConvergent(t *testing.T) {
+	server, _, root := newTestClient(t)
+	transit := newTransitClient(t, server)
+	ctx := context.Background()
+
+	if _, err := transit.CreateKey(ctx, withToken(&vaultv1.CreateTransitKeyRequest{Name: "bad", ConvergentEncryption: true}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("convergent without derivation: expected InvalidArgument, got %v", err)
+	}
+	if _, err := transit.CreateKey(ctx, withToken(&vaultv1.CreateTransitKeyRequest{Name: "ssn", Derived: true, ConvergentEncryption: true}, root)); err != nil {
+		t.Fatalf("CreateKey: %v", err)
+	}
+	encrypt := func(kctx string) (string, error) {
+		res, err := transit.Encrypt(ctx, withToken(&vaultv1.TransitEncryptRequest{Name: "ssn", Plaintext: []byte("078-05-1120"), Context: []byte(kctx)}, root))
+		if err != nil {
+			return "", err
+		}
+		return res.Msg.Ciphertext, nil
+	}
+	if _, err := encrypt(""); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("derived key without context: expected InvalidArgument, got %v", err)
+	}
+	a1, _ := encrypt("tenant-a")
+	a2, _ := encrypt("tenant-a")
+	b, _ := encrypt("tenant-b")
+	if a1 != a2 {
+		t.Error("convergent encryption is not deterministic")
+	}
+	if a1 == b {
+		t.Error("different contexts produced the same ciphertext")
+	}
+	if _, err := transit.Decrypt(ctx, withToken(&vaultv1.TransitDecryptRequest{Name: "ssn", Ciphertext: a1, Context: []byte("tenant-b")}, root)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("decrypt under wrong context: expected InvalidArgument, got %v", err)
+	}
+	dec, err := transit.Decrypt(ctx, withToken(&vaultv1.TransitDecryptRequest{Name: "ssn", Ciphertext: a1, Context: []byte("tenant-a")}, root))
+	if err != nil || string(dec.Msg.Plaintext) != "078-05-1120" {
+		t.Errorf("Decrypt = %v, %v", dec, err)
+	}
+}
@@ -1,226 +1,528 @@
-//go:build !wasm
-
-package inference
-
-import (
-	"context"
-	"encoding/json"
-	"fmt"
-	"log/slog"
-	"os"
-	"path/filepath"
-	"strings"
-	"sync"
-
-	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
-	"olympus.fleet/00SDLC/Olympus2/90000-Enablement-Labs/90200-Logic-Libraries/170-Policy"
-	"connectrpc.com/connect"
-	"go.etcd.io/bbolt"
-)
-
-type VaultServer struct {
-	mu     sync.RWMutex
-	db     *bbolt.DB
-	pe     *policy.Evaluator
-}
-
-const (
-	bucketSecrets = "secrets"
-	bucketHistory = "history"
-)
-
-func NewVaultServer(storageDir string) *VaultServer {
-	os.MkdirAll(storageDir, 0755)
-	dbPath := filepath.Join(storageDir, "vault.db")
-	
-	db, err := bbolt.Open(dbPath, 0600, nil)
-	if err != nil {
-		slog.Error("Failed to open BoltDB", "path", dbPath, "error", err)
-		panic(err)
-	}
-
-	// Initialize buckets
-	err = db.Update(func(tx *bbolt.Tx) error {
-		_, err := tx.CreateBucketIfNotExists([]byte(bucketSecrets))
-		if err != nil { return err }
-		_, err = tx.CreateBucketIfNotExists([]byte(bucketHistory))
-		return err
-	})
-	if err != nil {
-		slog.Error("Failed to init buckets", "error", err)
-		panic(err)
-	}
-
-	s := &VaultServer{
-		db: db,
-		pe: &policy.Evaluator{},
-	}
-	
-	// Load PBAC policy
-	cwd, _ := os.Getwd()
-	slog.Info("VaultServer initializing", "cwd", cwd)
-	policyPaths := []string{
-		"../../olympus.fleet/20POSI/George/C0100-Configuration-Registry/POLICY.jebnf",
-		"olympus.fleet/20POSI/George/C0100-Configuration-Registry/POLICY.jebnf",
-		"../../../../../olympus.fleet/20POSI/George/C0100-Configuration-Registry/POLICY.jebnf",
-	}
-	for _, p := range policyPaths {
-		if err := s.pe.Load(p); err == nil {
-			slog.Info("Successfully loaded PBAC policy", "path", p)
-			break
-		}
-	}
-
-	return s
-}
-
-func (s *VaultServer) VaultWrite(ctx context.Context, req *connect.Request[vaultv1.VaultWriteRequest]) (*connect.Response[vaultv1.VaultWriteResponse], error) {
-	key := req.Msg.Key
-	val := req.Msg.Value
-	slog.Info("VaultWrite", "key", key)
-
-	var version int32
-	err := s.db.Update(func(tx *bbolt.Tx) error {
-		b := tx.Bucket([]byte(bucketSecrets))
-		if err := b.Put([]byte(key), []byte(val)); err != nil { return err }
-
-		h := tx.Bucket([]byte(bucketHistory))
-		histData := h.Get([]byte(key))
-		var versions []string
-		if histData != nil {
-			json.Unmarshal(histData, &versions)
-		}
-		versions = append(versions, val)
-		version = int32(len(versions))
-		
-		newData, _ := json.Marshal(versions)
-		return h.Put([]byte(key), newData)
-	})
-
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-
-	return connect.NewResponse(&vaultv1.VaultWriteResponse{Version: version}), nil
-}
-
-func (s *VaultServer) VaultRead(ctx context.Context, req *connect.Request[vaultv1.VaultReadRequest]) (*connect.Response[vaultv1.VaultReadResponse], error) {
-	slog.Info("VaultRead", "key", req.Msg.Key)
-	
-	var val string
-	var version int32
-	err := s.db.View(func(tx *bbolt.Tx) error {
-		b := tx.Bucket([]byte(bucketSecrets))
-		v := b.Get([]byte(req.Msg.Key))
-		if v == nil {
-			return fmt.Errorf("secret not found: %s", req.Msg.Key)
-		}
-		val = string(v)
-
-		h := tx.Bucket([]byte(bucketHistory))
-		histData := h.Get([]byte(req.Msg.Key))
-		var versions []string
-		json.Unmarshal(histData, &versions)
-		version = int32(len(versions))
-		return nil
-	})
-
-	if err != nil {
-		return nil, connect.NewError(connect.CodeNotFound, err)
-	}
-
-	return connect.NewResponse(&vaultv1.VaultReadResponse{Value: val, Version: version}), nil
-}
-
-func (s *VaultServer) GetSecretVersion(ctx context.Context, req *connect.Request[vaultv1.GetSecretVersionRequest]) (*connect.Response[vaultv1.VaultReadResponse], error) {
-	slog.Info("GetSecretVersion", "key", req.Msg.Key, "version", req.Msg.Version)
-	
-	var val string
-	err := s.db.View(func(tx *bbolt.Tx) error {
-		h := tx.Bucket([]byte(bucketHistory))
-		histData := h.Get([]byte(req.Msg.Key))
-		if histData == nil {
-			return fmt.Errorf("secret not found: %s", req.Msg.Key)
-		}
-		
-		var versions []string
-		json.Unmarshal(histData, &versions)
-		
-		idx := int(req.Msg.Version) - 1
-		if idx < 0 || idx >= len(versions) {
-			return fmt.Errorf("version %d not found for %s", req.Msg.Version, req.Msg.Key)
-		}
-		val = versions[idx]
-		return nil
-	})
-
-	if err != nil {
-		return nil, connect.NewError(connect.CodeNotFound, err)
-	}
-
-	return connect.NewResponse(&vaultv1.VaultReadResponse{Value: val, Version: req.Msg.Version}), nil
-}
-
-func (s *VaultServer) ListSecretVersions(ctx context.Context, req *connect.Request[vaultv1.ListSecretVersionsRequest]) (*connect.Response[vaultv1.ListSecretVersionsResponse], error) {
-	slog.Info("ListSecretVersions", "key", req.Msg.Key)
-	
-	var resVersions []int32
-	err := s.db.View(func(tx *bbolt.Tx) error {
-		h := tx.Bucket([]byte(bucketHistory))
-		histData := h.Get([]byte(req.Msg.Key))
-		if histData == nil {
-			return fmt.Errorf("secret not found: %s", req.Msg.Key)
-		}
-		
-		var versions []string
-		json.Unmarshal(histData, &versions)
-		
-		for i := range versions {
-			resVersions = append(resVersions, int32(i+1))
-		}
-		return nil
-	})
-
-	if err != nil {
-		return nil, connect.NewError(connect.CodeNotFound, err)
-	}
-
-	return connect.NewResponse(&vaultv1.ListSecretVersionsResponse{Versions: resVersions}), nil
-}
-
-func (s *VaultServer) ListSecrets(ctx context.Context, req *connect.Request[vaultv1.ListSecretsRequest]) (*connect.Response[vaultv1.ListSecretsResponse], error) {
-	slog.Info("ListSecrets", "prefix", req.Msg.Prefix)
-	
-	var keys []string
-	err := s.db.View(func(tx *bbolt.Tx) error {
-		b := tx.Bucket([]byte(bucketSecrets))
-		c := b.Cursor()
-		
-		prefix := []byte(req.Msg.Prefix)
-		for k, _ := c.Seek(prefix); k != nil && strings.HasPrefix(string(k), string(prefix)); k, _ = c.Next() {
-			keys = append(keys, string(k))
-		}
-		return nil
-	})
-
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-
-	return connect.NewResponse(&vaultv1.ListSecretsResponse{Keys: keys}), nil
-}
-
-func (s *VaultServer) TestIAMPolicy(ctx context.Context, req *connect.Request[vaultv1.TestIAMPolicyRequest]) (*connect.Response[vaultv1.TestIAMPolicyResponse], error) {
-	slog.Info("TestIAMPolicy", "identity", req.Msg.Identity, "action", req.Msg.Action, "resource", req.Msg.Resource)
-	
-	allowed, reason := s.pe.Authorize("George", req.Msg.Identity, "*")
-	
-	return connect.NewResponse(&vaultv1.TestIAMPolicyResponse{
-		Allowed: allowed,
-		Reason:  reason,
-	}), nil
-}
-
-func (s *VaultServer) Close() error {
-	return s.db.Close()
-}
+//go:build !wasm
+
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/410-Interaction-Models/410-100-Resonance-Bus"
+	"olympus.fleet/00SDLC/Olympus2/90000-Enablement-Labs/90200-Logic-Libraries/170-Policy"
+	"connectrpc.com/connect"
+	"go.etcd.io/bbolt"
+)
+
+type VaultServer struct {
+	mu sync.RWMutex
+	db *bbolt.DB
+	pe *policy.Evaluator
+
+	now         func() time.Time
+	requireAuth bool
+	jwks        jwksCache
+	bus         *resonance.Bus
+	databases   databaseConns
+	leaseWake   chan struct{}
+	barrier     *barrier
+}
+
+// Option configures optional VaultServer behaviour.
+type Option func(*VaultServer)
+
+// RequireAuth rejects requests that carry neither a token nor a verified
+// client certificate.
+func RequireAuth() Option {
+	return func(s *VaultServer) { s.requireAuth = true }
+}
+
+const (
+	bucketSecrets         = "secrets"
+	bucketHistory         = "history"
+	bucketTokens          = "tokens"
+	bucketTokenAccessors  = "token_accessors"
+	bucketTokenChildren   = "token_children"
+	bucketPolicies        = "acl_policies"
+	bucketAppRoles        = "approles"
+	bucketAppRoleIDs      = "approle_ids"
+	bucketSecretIDs       = "approle_secret_ids"
+	bucketSecretIDAccess  = "approle_secret_accessors"
+	bucketJWT             = "jwt_auth"
+	bucketCPI             = "cpi"
+	bucketAudit           = "audit"
+	bucketAuditIndex      = "audit_index"
+	bucketWindows         = "access_windows"
+	bucketControlGroups   = "control_groups"
+	bucketAccessRequests  = "access_requests"
+	bucketBreakGlass      = "break_glass"
+	bucketSecretMeta      = "secret_meta"
+	bucketRetention       = "retention"
+	bucketRotations       = "rotations"
+	bucketOutbox          = "outbox"
+	bucketWebhooks        = "webhooks"
+	bucketDeadLetters     = "dead_letters"
+	bucketDatabaseConfigs = "database_configs"
+	bucketDatabaseRoles   = "database_roles"
+	bucketLeases          = "leases"
+	bucketTransitKeys     = "transit_keys"
+	bucketKMSKeyRings     = "kms_key_rings"
+	bucketKMSCryptoKeys   = "kms_crypto_keys"
+	bucketPKICAs          = "pki_cas"
+	bucketPKIRoles        = "pki_roles"
+	bucketPKICerts        = "pki_certs"
+	bucketSSHCA           = "ssh_ca"
+	bucketSSHRoles        = "ssh_roles"
+	bucketOIDC            = "oidc"
+	bucketOIDCRoles       = "oidc_roles"
+	bucketPasswordPolicy  = "password_policies"
+)
+
+// buckets lists every bucket created when the vault is opened.
+var buckets = []string{
+	bucketSecrets, bucketHistory, bucketSecretMeta, bucketRetention,
+	bucketTokens, bucketTokenAccessors, bucketTokenChildren, bucketPolicies,
+	bucketAppRoles, bucketAppRoleIDs, bucketSecretIDs, bucketSecretIDAccess,
+	bucketJWT, bucketCPI, bucketAudit, bucketAuditIndex, bucketWindows,
+	bucketControlGroups, bucketAccessRequests, bucketBreakGlass,
+	bucketRotations, bucketOutbox, bucketWebhooks, bucketDeadLetters,
+	bucketDatabaseConfigs, bucketDatabaseRoles, bucketLeases,
+	bucketTransitKeys, bucketKMSKeyRings, bucketKMSCryptoKeys,
+	bucketPKICAs, bucketPKIRoles, bucketPKICerts, bucketSSHCA, bucketSSHRoles,
+	bucketOIDC, bucketOIDCRoles, bucketPasswordPolicy,
+}
+
+func NewVaultServer(storageDir string, opts ...Option) *VaultServer {
+	os.MkdirAll(storageDir, 0755)
+	dbPath := filepath.Join(storageDir, "vault.db")
+
+	db, err := bbolt.Open(dbPath, 0600, nil)
+	if err != nil {
+		slog.Error("Failed to open BoltDB", "path", dbPath, "error", err)
+		panic(err)
+	}
+
+	// Initialize buckets
+	err = db.Update(func(tx *bbolt.Tx) error {
+		indexed := tx.Bucket([]byte(bucketAuditIndex)) != nil
+		for _, name := range buckets {
+			if _, err := tx.CreateBucketIfNotExists([]byte(name)); err != nil {
+				return err
+			}
+		}
+		if !indexed {
+			return indexAudit(tx)
+		}
+		return nil
+	})
+	if err != nil {
+		slog.Error("Failed to init buckets", "error", err)
+		panic(err)
+	}
+
+	b, err := openBarrier(storageDir)
+	if err != nil {
+		slog.Error("Failed to open barrier", "error", err)
+		panic(err)
+	}
+
+	s := &VaultServer{
+		db:        db,
+		pe:        &policy.Evaluator{},
+		now:       time.Now,
+		leaseWake: make(chan struct{}, 1),
+		barrier:   b,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if err := s.sealWebhookSecrets(); err != nil {
+		slog.Error("Failed to seal webhook secrets", "error", err)
+		panic(err)
+	}
+	if err := s.sealDatabaseConfigs(); err != nil {
+		slog.Error("Failed to seal database configs", "error", err)
+		panic(err)
+	}
+	if err := s.bootstrapRootToken(storageDir); err != nil {
+		slog.Error("Failed to bootstrap root token", "error", err)
+		panic(err)
+	}
+
+	// Load PBAC policy
+	cwd, _ := os.Getwd()
+	slog.Info("VaultServer initializing", "cwd", cwd)
+	policyPaths := []string{
+		"../../olympus.fleet/20POSI/George/C0100-Configuration-Registry/POLICY.jebnf",
+		"olympus.fleet/20POSI/George/C0100-Configuration-Registry/POLICY.jebnf",
+		"../../../../../olympus.fleet/20POSI/George/C0100-Configuration-Registry/POLICY.jebnf",
+	}
+	for _, p := range policyPaths {
+		if err := s.pe.Load(p); err == nil {
+			slog.Info("Successfully loaded PBAC policy", "path", p)
+			s.bus.Emit(resonance.PolicyReloaded, p, "pbac")
+			break
+		}
+	}
+
+	s.bus.Emit(resonance.Unsealed, storageDir, "")
+	return s
+}
+
+func (s *VaultServer) VaultWrite(ctx context.Context, req *connect.Request[vaultv1.VaultWriteRequest]) (*connect.Response[vaultv1.VaultWriteResponse], error) {
+	key := req.Msg.Key
+	val := req.Msg.Value
+	slog.Info("VaultWrite", "key", key)
+	if err := s.authorize(ctx, s.writeCapability(key), key); err != nil {
+		s.audit(ctx, "VaultWrite", key, 0, err)
+		return nil, err
+	}
+	expire, err := expiryFrom(req.Msg.TtlSeconds, req.Msg.ExpireTime, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if kmsKey := req.Msg.KmsKeyName; kmsKey != "" {
+		if err := s.authorize(ctx, "update", "kms/"+kmsKey); err != nil {
+			s.audit(ctx, "VaultWrite", key, 0, err)
+			return nil, err
+		}
+	}
+	version, err := s.writeSecret(ctx, key, val, req.Msg.KmsKeyName, expire, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&vaultv1.VaultWriteResponse{Version: version, ExpireTime: unixOrZero(expire)}), nil
+}
+
+// writeSecret appends val as the newest version of key. It is the single
+// write path shared by VaultWrite and the rotation subsystem; callers
+// authorize first. A non-empty kmsKey becomes the secret's CMEK; otherwise
+// the secret keeps the key it already has, if any. A non-empty event, such
+// as eventRotate, is queued for the new version in the same transaction.
+// writeCapability is the capability a write to key needs: "create" while
+// the secret does not exist, or has expired and will start afresh, and
+// "update" once it does.
+func (s *VaultServer) writeCapability(key string) string {
+	capability := "update"
+	s.db.View(func(tx *bbolt.Tx) error {
+		if tx.Bucket([]byte(bucketSecrets)).Get([]byte(key)) == nil || expired(getSecretMeta(tx, key).ExpireTime, s.now()) {
+			capability = "create"
+		}
+		return nil
+	})
+	return capability
+}
+
+func (s *VaultServer) writeSecret(ctx context.Context, key, val, kmsKey string, expire time.Time, event string) (int32, error) {
+	if kmsKey == "" {
+		s.db.View(func(tx *bbolt.Tx) error {
+			kmsKey = getSecretMeta(tx, key).KMSKey
+			return nil
+		})
+	}
+	stored, kmsVersion, err := s.sealPayload(key, kmsKey, val)
+	if err != nil {
+		s.audit(ctx, "VaultWrite", key, 0, err)
+		return 0, err
+	}
+
+	now := s.now()
+	var version int32
+	var pruned []int32
+	err = s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucketSecrets))
+		if err := b.Put([]byte(key), []byte(stored)); err != nil {
+			return err
+		}
+
+		h := tx.Bucket([]byte(bucketHistory))
+		histData := h.Get([]byte(key))
+		var versions []string
+		if histData != nil {
+			json.Unmarshal(histData, &versions)
+		}
+		m := getSecretMeta(tx, key)
+		// A secret past its expiry is gone even before the reaper runs, so
+		// writing it starts a new secret rather than reviving the old one.
+		if expired(m.ExpireTime, now) {
+			if err := s.enqueueEvent(tx, m, key, eventDelete, 0); err != nil {
+				return err
+			}
+			versions, m = nil, &secretMeta{}
+		}
+		versions = append(versions, stored)
+		version = int32(len(versions))
+
+		for len(m.Versions) < len(versions)-1 {
+			m.Versions = append(m.Versions, versionMeta{})
+		}
+		m.Versions = append(m.Versions, versionMeta{CreateTime: now, ExpireTime: expire, KMSKeyVersion: kmsVersion})
+		m.KMSKey = kmsKey
+		pruned = effectiveRetention(tx, m).prune(versions, m, now)
+
+		newData, _ := json.Marshal(versions)
+		if err := h.Put([]byte(key), newData); err != nil {
+			return err
+		}
+		if version == 1 {
+			if err := s.enqueueEvent(tx, m, key, eventCreate, 0); err != nil {
+				return err
+			}
+		}
+		if err := s.enqueueEvent(tx, m, key, eventVersionAdd, version); err != nil {
+			return err
+		}
+		if event != "" {
+			if err := s.enqueueEvent(tx, m, key, event, version); err != nil {
+				return err
+			}
+		}
+		for _, v := range pruned {
+			if err := s.enqueueEvent(tx, m, key, eventVersionDestroy, v); err != nil {
+				return err
+			}
+		}
+		return putSecretMeta(tx, key, m)
+	})
+	s.audit(ctx, "VaultWrite", key, version, err)
+
+	if err != nil {
+		return 0, connect.NewError(connect.CodeInternal, err)
+	}
+	for _, v := range pruned {
+		s.audit(ctx, "PruneVersion", key, v, nil)
+		s.bus.Emit(resonance.SecretChanged, key, fmt.Sprintf("destroyed=%d", v))
+	}
+	s.bus.Emit(resonance.SecretChanged, key, fmt.Sprintf("version=%d", version))
+	return version, nil
+}
+
+func (s *VaultServer) VaultRead(ctx context.Context, req *connect.Request[vaultv1.VaultReadRequest]) (*connect.Response[vaultv1.VaultReadResponse], error) {
+	slog.Info("VaultRead", "key", req.Msg.Key)
+	ctx, err := s.checkAccessReason(ctx, req.Msg.Key, req.Msg.AccessReason)
+	if err != nil {
+		s.audit(ctx, "VaultRead", req.Msg.Key, 0, err)
+		return nil, err
+	}
+	if err := s.authorize(ctx, "read", req.Msg.Key); err != nil {
+		s.audit(ctx, "VaultRead", req.Msg.Key, 0, err)
+		return nil, err
+	}
+	if err := s.checkSecretWindow(req.Msg.Key); err != nil {
+		s.audit(ctx, "VaultRead", req.Msg.Key, 0, err)
+		return nil, err
+	}
+	ctx, err = s.attest(ctx, req.Header(), req.Msg.Key)
+	if err != nil {
+		s.audit(ctx, "VaultRead", req.Msg.Key, 0, err)
+		return nil, err
+	}
+	pending, redeem, err := s.controlGroupGate(ctx, req.Msg.Key, req.Msg.AccessRequestId)
+	if err != nil {
+		return nil, err
+	}
+	if pending != "" {
+		return connect.NewResponse(&vaultv1.VaultReadResponse{AccessRequestId: pending}), nil
+	}
+
+	var val string
+	var version int32
+	var meta versionMeta
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucketSecrets))
+		v := b.Get([]byte(req.Msg.Key))
+		if v == nil {
+			return fmt.Errorf("secret not found: %s", req.Msg.Key)
+		}
+		val = string(v)
+
+		h := tx.Bucket([]byte(bucketHistory))
+		histData := h.Get([]byte(req.Msg.Key))
+		var versions []string
+		json.Unmarshal(histData, &versions)
+		m := getSecretMeta(tx, req.Msg.Key)
+		version = m.current(len(versions))
+		meta = m.version(version)
+		return m.checkReadable(req.Msg.Key, version, s.now())
+	})
+	if err == nil {
+		val, err = s.openPayload(req.Msg.Key, version, meta, val)
+	}
+	if err == nil && redeem {
+		err = s.redeemAccess(ctx, req.Msg.Key, req.Msg.AccessRequestId)
+	}
+	s.audit(ctx, "VaultRead", req.Msg.Key, version, err)
+
+	if err != nil {
+		return nil, readError(err)
+	}
+
+	return connect.NewResponse(&vaultv1.VaultReadResponse{Value: val, Version: version}), nil
+}
+
+func (s *VaultServer) GetSecretVersion(ctx context.Context, req *connect.Request[vaultv1.GetSecretVersionRequest]) (*connect.Response[vaultv1.VaultReadResponse], error) {
+	slog.Info("GetSecretVersion", "key", req.Msg.Key, "version", req.Msg.Version)
+	ctx, err := s.checkAccessReason(ctx, req.Msg.Key, req.Msg.AccessReason)
+	if err != nil {
+		s.audit(ctx, "GetSecretVersion", req.Msg.Key, req.Msg.Version, err)
+		return nil, err
+	}
+	if err := s.authorize(ctx, "read", req.Msg.Key); err != nil {
+		s.audit(ctx, "GetSecretVersion", req.Msg.Key, req.Msg.Version, err)
+		return nil, err
+	}
+	if err := s.checkSecretWindow(req.Msg.Key); err != nil {
+		s.audit(ctx, "GetSecretVersion", req.Msg.Key, req.Msg.Version, err)
+		return nil, err
+	}
+	ctx, err = s.attest(ctx, req.Header(), req.Msg.Key)
+	if err != nil {
+		s.audit(ctx, "GetSecretVersion", req.Msg.Key, req.Msg.Version, err)
+		return nil, err
+	}
+	pending, redeem, err := s.controlGroupGate(ctx, req.Msg.Key, req.Msg.AccessRequestId)
+	if err != nil {
+		return nil, err
+	}
+	if pending != "" {
+		return connect.NewResponse(&vaultv1.VaultReadResponse{AccessRequestId: pending}), nil
+	}
+
+	var val string
+	var meta versionMeta
+	err = s.db.View(func(tx *bbolt.Tx) error {
+		h := tx.Bucket([]byte(bucketHistory))
+		histData := h.Get([]byte(req.Msg.Key))
+		if histData == nil {
+			return fmt.Errorf("secret not found: %s", req.Msg.Key)
+		}
+
+		var versions []string
+		json.Unmarshal(histData, &versions)
+
+		idx := int(req.Msg.Version) - 1
+		if idx < 0 || idx >= len(versions) {
+			return fmt.Errorf("version %d not found for %s", req.Msg.Version, req.Msg.Key)
+		}
+		val = versions[idx]
+		m := getSecretMeta(tx, req.Msg.Key)
+		meta = m.version(req.Msg.Version)
+		return m.checkReadable(req.Msg.Key, req.Msg.Version, s.now())
+	})
+	if err == nil {
+		val, err = s.openPayload(req.Msg.Key, req.Msg.Version, meta, val)
+	}
+	if err == nil && redeem {
+		err = s.redeemAccess(ctx, req.Msg.Key, req.Msg.AccessRequestId)
+	}
+	s.audit(ctx, "GetSecretVersion", req.Msg.Key, req.Msg.Version, err)
+
+	if err != nil {
+		return nil, readError(err)
+	}
+
+	return connect.NewResponse(&vaultv1.VaultReadResponse{Value: val, Version: req.Msg.Version}), nil
+}
+
+func (s *VaultServer) ListSecretVersions(ctx context.Context, req *connect.Request[vaultv1.ListSecretVersionsRequest]) (*connect.Response[vaultv1.ListSecretVersionsResponse], error) {
+	slog.Info("ListSecretVersions", "key", req.Msg.Key)
+	if err := s.authorize(ctx, "list", req.Msg.Key); err != nil {
+		return nil, err
+	}
+
+	var resVersions []int32
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		h := tx.Bucket([]byte(bucketHistory))
+		histData := h.Get([]byte(req.Msg.Key))
+		if histData == nil {
+			return fmt.Errorf("secret not found: %s", req.Msg.Key)
+		}
+
+		var versions []string
+		json.Unmarshal(histData, &versions)
+
+		for i := range versions {
+			resVersions = append(resVersions, int32(i+1))
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	return connect.NewResponse(&vaultv1.ListSecretVersionsResponse{Versions: resVersions}), nil
+}
+
+func (s *VaultServer) ListSecrets(ctx context.Context, req *connect.Request[vaultv1.ListSecretsRequest]) (*connect.Response[vaultv1.ListSecretsResponse], error) {
+	slog.Info("ListSecrets", "prefix", req.Msg.Prefix)
+	if err := s.authorize(ctx, "list", req.Msg.Prefix); err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(bucketSecrets))
+		c := b.Cursor()
+
+		prefix := []byte(req.Msg.Prefix)
+		for k, _ := c.Seek(prefix); k != nil && strings.HasPrefix(string(k), string(prefix)); k, _ = c.Next() {
+			keys = append(keys, string(k))
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&vaultv1.ListSecretsResponse{Keys: keys}), nil
+}
+
+func (s *VaultServer) TestIAMPolicy(ctx context.Context, req *connect.Request[vaultv1.TestIAMPolicyRequest]) (*connect.Response[vaultv1.TestIAMPolicyResponse], error) {
+	slog.Info("TestIAMPolicy", "identity", req.Msg.Identity, "action", req.Msg.Action, "resource", req.Msg.Resource)
+
+	identity := req.Msg.Identity
+	if c := CallerFrom(ctx); identity == "" && c != nil {
+		identity = c.Identity
+	}
+	allowed, reason := s.pe.Authorize("George", identity, "*")
+
+	return connect.NewResponse(&vaultv1.TestIAMPolicyResponse{
+		Allowed: allowed,
+		Reason:  reason,
+	}), nil
+}
+
+// authorize checks the caller attached to ctx. Token callers are held to
+// their ACL policies; certificate identities go through the sovereign
+// policy. Anonymous callers on the plaintext listener carry no identity
+// and are let through, matching the behaviour before authentication existed.
+func (s *VaultServer) authorize(ctx context.Context, capability, resource string) error {
+	c := CallerFrom(ctx)
+	if c == nil {
+		return nil
+	}
+	if c.TokenID != "" {
+		return s.checkACL(c, capability, resource)
+	}
+	allowed, reason := s.pe.Authorize("George", c.Identity, resource)
+	if !allowed {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s denied for %s: %s", c.Identity, resource, reason))
+	}
+	return nil
+}
+
+func (s *VaultServer) Close() error {
+	s.bus.Emit(resonance.Sealed, s.db.Path(), "")
+	s.databases.close()
+	return s.db.Close()
+}
@@ -0,0 +1,126 @@
+package inference
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	vaultv1 "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	"go.etcd.io/bbolt"
+)
+
+func TestWebhookDeliveries(t *testing.T) {
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	server, client, root := newTestClient(t, WithClock(func() time.Time { return clock }))
+	ctx := context.Background()
+
+	var received []secretEvent
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(WebhookSignatureHeader) != sign("s3cret", r.Header.Get(WebhookTimestampHeader), body) {
+			http.Error(w, "bad signature", http.StatusUnauthorized)
+			return
+		}
+		var e secretEvent
+		json.Unmarshal(body, &e)
+		received = append(received, e)
+	}))
+	defer hook.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "down", http.StatusBadGateway)
+	}))
+	defer down.Close()
+
+	client.PutWebhook(ctx, withToken(&vaultv1.PutWebhookRequest{Webhook: &vaultv1.Webhook{Name: "deploy", Url: hook.URL, Secret: "s3cret", Prefix: "app/"}}, root))
+	client.PutWebhook(ctx, withToken(&vaultv1.PutWebhookRequest{Webhook: &vaultv1.Webhook{Name: "flaky", Url: down.URL, Secret: "x", Events: []string{eventVersionAdd}}}, root))
+
+	// Signing keys are sealed at rest, including those stored in the clear
+	// by earlier versions.
+	legacy, _ := json.Marshal(webhook{Name: "legacy", URL: hook.URL, Secret: "s3cret", Prefix: "app/", Events: []string{eventCreate}})
+	server.db.Update(func(tx *bbolt.Tx) error { return tx.Bucket([]byte(bucketWebhooks)).Put([]byte("legacy"), legacy) })
+	if err := server.sealWebhookSecrets(); err != nil {
+		t.Fatalf("sealWebhookSecrets: %v", err)
+	}
+	server.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(bucketWebhooks)).ForEach(func(k, v []byte) error {
+			if bytes.Contains(v, []byte("s3cret")) {
+				t.Errorf("webhook %s stores its secret in the clear", k)
+			}
+			return nil
+		})
+	})
+
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "app/db", Value: "1"}, root))
+	client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "other/db", Value: "1"}, root))
+
+	if n, err := server.PublishEvents(ctx); err != nil || n != 3 {
+		t.Fatalf("Expected three signed deliveries, got %d %v", n, err)
+	}
+	types := map[string]bool{}
+	for _, e := range received {
+		if e.SecretID != "app/db" {
+			t.Errorf("Expected prefix filter to exclude %s", e.SecretID)
+		}
+		types[e.EventType] = true
+	}
+	if !types[eventCreate] || !types[eventVersionAdd] {
+		t.Errorf("Expected create and version-add events, got %v", received)
+	}
+
+	pending, _ := client.ListDeliveries(ctx, withToken(&vaultv1.ListDeliveriesRequest{Target: "flaky"}, root))
+	if len(pending.Msg.Deliveries) != 2 || pending.Msg.Deliveries[0].Attempts != 1 {
+		t.Fatalf("Expected two retrying deliveries, got %v", pending.Msg.Deliveries)
+	}
+	for range maxDeliveryAttempts {
+		clock = clock.Add(maxPublishBackoff)
+		server.PublishEvents(ctx)
+	}
+	pending, _ = client.ListDeliveries(ctx, withToken(&vaultv1.ListDeliveriesRequest{}, root))
+	dead, _ := client.ListDeliveries(ctx, withToken(&vaultv1.ListDeliveriesRequest{State: "dead"}, root))
+	if len(pending.Msg.Deliveries) != 0 || len(dead.Msg.Deliveries) != 2 || dead.Msg.Deliveries[0].Attempts != maxDeliveryAttempts {
+		t.Errorf("Expected both failing deliveries dead-lettered, got %v / %v", pending.Msg.Deliveries, dead.Msg.Deliveries)
+	}
+}
+
+func TestWebhookDeliveryOrder(t *testing.T) {
+	clock := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	server, client, root := newTestClient(t, WithClock(func() time.Time { return clock }))
+	ctx := context.Background()
+
+	var received []secretEvent
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e secretEvent
+		json.NewDecoder(r.Body).Decode(&e)
+		received = append(received, e)
+	}))
+	defer hook.Close()
+	client.PutWebhook(ctx, withToken(&vaultv1.PutWebhookRequest{Webhook: &vaultv1.Webhook{Name: "audit", Url: hook.URL, Secret: "s3cret"}}, root))
+
+	for range 4 {
+		client.VaultWrite(ctx, withToken(&vaultv1.VaultWriteRequest{Key: "app/db", Value: "v"}, root))
+	}
+	client.PutRotation(ctx, withToken(&vaultv1.PutRotationRequest{Key: "app/db", Rotation: &vaultv1.Rotation{PeriodSeconds: 60, Rotator: &vaultv1.Rotator{Command: []string{"echo", "rotated"}}}}, root))
+	clock = clock.Add(time.Minute)
+	if n, err := server.RotateDue(ctx); err != nil || n != 1 {
+		t.Fatalf("RotateDue = %d, %v", n, err)
+	}
+	server.PublishEvents(ctx)
+
+	want := []struct {
+		event   string
+		version int32
+	}{{eventCreate, 0}, {eventVersionAdd, 1}, {eventVersionAdd, 2}, {eventVersionAdd, 3}, {eventVersionAdd, 4}, {eventVersionAdd, 5}, {eventRotate, 5}}
+	if len(received) != len(want) {
+		t.Fatalf("Expected %d deliveries, got %v", len(want), received)
+	}
+	for i, e := range received {
+		if e.EventType != want[i].event || e.VersionID != want[i].version {
+			t.Errorf("delivery %d = %s v%d, want %s v%d", i, e.EventType, e.VersionID, want[i].event, want[i].version)
+		}
+	}
+}
@@ -1,59 +1,150 @@
-package main
-
-import (
-	"context"
-	"fmt"
-	"log/slog"
-	"net/http"
-	"os"
-	"os/signal"
-	"syscall"
-	"time"
-
-	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault/vaultv1connect"
-	"olympus.fleet/00SDLC/OlympusGCP-Vault/10000-Autonomous-Actors/10700-Processing-Engines/10710-Reasoning-Inference/inference"
-
-	"golang.org/x/net/http2"
-	"golang.org/x/net/http2/h2c"
-)
-
-func main() {
-	storageDir := "../../60000-Information-Storage/VaultData"
-	server := inference.NewVaultServer(storageDir)
-	defer server.Close()
-
-	mux := http.NewServeMux()
-	path, handler := vaultv1connect.NewVaultServiceHandler(server)
-	mux.Handle(path, handler)
-
-	// Health Check / Pulse
-	mux.HandleFunc("/pulse", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		fmt.Fprintf(w, `{"status":"HEALTHY", "workspace":"OlympusGCP-Vault", "time":"%s"}`, time.Now().Format(time.RFC3339))
-	})
-
-	port := "8092"
-	slog.Info("VaultManager starting", "port", port)
-
-	srv := &http.Server{
-		Addr:              ":" + port,
-		Handler:           h2c.NewHandler(mux, &http2.Server{}),
-		ReadHeaderTimeout: 3 * time.Second,
-	}
-
-	// Graceful shutdown
-	done := make(chan os.Signal, 1)
-	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
-
-	go func() {
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			slog.Error("Server failed", "error", err)
-		}
-	}()
-
-	<-done
-	slog.Info("VaultManager shutting down...")
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	srv.Shutdown(ctx)
-}
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/kms/kmsv1connect"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault/vaultv1connect"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/10000-Autonomous-Actors/10700-Processing-Engines/10710-Reasoning-Inference/inference"
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/410-Interaction-Models/410-100-Resonance-Bus"
+
+	"connectrpc.com/connect"
+	// PostgreSQL for the database secrets engine, as plugin "pgx".
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func main() {
+	tlsCert := flag.String("tls-cert", os.Getenv("VAULT_TLS_CERT"), "PEM certificate for the TLS listener (plaintext h2c when empty)")
+	tlsKey := flag.String("tls-key", os.Getenv("VAULT_TLS_KEY"), "PEM private key for the TLS listener")
+	clientCA := flag.String("tls-client-ca", os.Getenv("VAULT_TLS_CLIENT_CA"), "PEM bundle used to verify client certificates")
+	requireClientCert := flag.Bool("tls-require-client-cert", os.Getenv("VAULT_TLS_REQUIRE_CLIENT_CERT") == "true", "reject TLS clients without a verified certificate")
+	requireAuth := flag.Bool("require-auth", os.Getenv("VAULT_REQUIRE_AUTH") == "true", "reject requests without a token or client certificate")
+	rotationInterval := flag.Duration("rotation-interval", envDuration("VAULT_ROTATION_INTERVAL", time.Minute), "how often secrets due for rotation are rotated (0 disables)")
+	publishInterval := flag.Duration("publish-interval", envDuration("VAULT_PUBLISH_INTERVAL", 5*time.Second), "how often queued secret events are delivered (0 disables)")
+	busURL := flag.String("resonance-url", os.Getenv("VAULT_RESONANCE_URL"), "Resonance Bus endpoint receiving vault pulses (disabled when empty)")
+	busSource := flag.String("resonance-source", os.Getenv("VAULT_RESONANCE_SOURCE"), "node id pulses are sent as (defaults to the hostname)")
+	reapInterval := flag.Duration("reap-interval", envDuration("VAULT_REAP_INTERVAL", time.Minute), "how often expired secrets and versions are destroyed (0 disables)")
+	flag.Parse()
+	if err := checkTLSFlags(*tlsCert, *clientCA, *requireClientCert); err != nil {
+		slog.Error("Invalid TLS flags", "error", err)
+		os.Exit(1)
+	}
+
+	var opts []inference.Option
+	if *requireAuth {
+		opts = append(opts, inference.RequireAuth())
+	}
+	if *busURL != "" {
+		source := *busSource
+		if source == "" {
+			source, _ = os.Hostname()
+		}
+		bus := resonance.NewBus(source, resonance.NewHTTPTransport(*busURL))
+		defer bus.Close()
+		opts = append(opts, inference.WithBus(bus))
+	}
+
+	storageDir := "../../60000-Information-Storage/VaultData"
+	server := inference.NewVaultServer(storageDir, opts...)
+	defer server.Close()
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	go server.RunLeaseExpiry(jobsCtx)
+	if *reapInterval > 0 {
+		go server.RunReaper(jobsCtx, *reapInterval)
+	}
+	if *rotationInterval > 0 {
+		go server.RunRotations(jobsCtx, *rotationInterval)
+	}
+	if *publishInterval > 0 {
+		go server.RunPublisher(jobsCtx, *publishInterval)
+	}
+
+	mux := http.NewServeMux()
+	path, handler := vaultv1connect.NewVaultServiceHandler(server, connect.WithInterceptors(server.AuthInterceptor()))
+	mux.Handle(path, inference.PeerIdentityHandler(handler))
+	path, handler = vaultv1connect.NewTransitServiceHandler(server.Transit(), connect.WithInterceptors(server.AuthInterceptor()))
+	mux.Handle(path, inference.PeerIdentityHandler(handler))
+	path, handler = kmsv1connect.NewKeyManagementServiceHandler(server.KMS(), connect.WithInterceptors(server.AuthInterceptor()))
+	mux.Handle(path, inference.PeerIdentityHandler(handler))
+	path, handler = vaultv1connect.NewPKIServiceHandler(server.PKI(), connect.WithInterceptors(server.AuthInterceptor()))
+	mux.Handle(path, inference.PeerIdentityHandler(handler))
+	mux.Handle("/v1/pki/crl", server.PKI().CRLHandler())
+	path, handler = vaultv1connect.NewSSHServiceHandler(server.SSH(), connect.WithInterceptors(server.AuthInterceptor()))
+	mux.Handle(path, inference.PeerIdentityHandler(handler))
+	mux.Handle("/v1/ssh/public_key", server.SSH().PublicKeyHandler())
+	path, handler = vaultv1connect.NewOIDCServiceHandler(server.OIDC(), connect.WithInterceptors(server.AuthInterceptor()))
+	mux.Handle(path, inference.PeerIdentityHandler(handler))
+	mux.Handle(inference.OIDCPath+"/.well-known/", server.OIDC().Handler())
+
+	// Health Check / Pulse
+	mux.HandleFunc("/pulse", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"status":"HEALTHY", "workspace":"OlympusGCP-Vault", "time":"%s"}`, time.Now().Format(time.RFC3339))
+	})
+
+	port := "8092"
+	slog.Info("VaultManager starting", "port", port, "tls", *tlsCert != "", "mtls", *clientCA != "")
+
+	srv := &http.Server{
+		Addr:              ":" + port,
+		Handler:           h2c.NewHandler(mux, &http2.Server{}),
+		ReadHeaderTimeout: 3 * time.Second,
+	}
+
+	if *tlsCert != "" {
+		reloader, err := newCertReloader(*tlsCert, *tlsKey, *clientCA)
+		if err != nil {
+			slog.Error("Failed to load TLS material", "error", err)
+			os.Exit(1)
+		}
+		srv.Handler = mux
+		srv.TLSConfig = reloader.tlsConfig(*requireClientCert)
+		if err := http2.ConfigureServer(srv, &http2.Server{}); err != nil {
+			slog.Error("Failed to configure HTTP/2", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	// Graceful shutdown
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		var err error
+		if srv.TLSConfig != nil {
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			slog.Error("Server failed", "error", err)
+		}
+	}()
+
+	<-done
+	slog.Info("VaultManager shutting down...")
+	stopJobs()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	srv.Shutdown(ctx)
+}
+
+// envDuration reads a duration default from the environment.
+func envDuration(name string, fallback time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(name)); err == nil {
+		return d
+	}
+	return fallback
+}
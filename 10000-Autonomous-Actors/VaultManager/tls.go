@@ -0,0 +1,158 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// certReloader serves the key pair and client CA bundle from disk, picking
+// up rotated files on the next handshake without restarting the listener.
+type certReloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	mu       sync.Mutex
+	cert     *tls.Certificate
+	certMod  time.Time
+	clientCA *x509.CertPool
+	caMod    time.Time
+}
+
+// checkTLSFlags rejects listener settings that would quietly weaken what
+// was asked for: requiring client certificates only means something on a
+// TLS listener that has a CA to verify them against.
+func checkTLSFlags(certFile, caFile string, requireClientCert bool) error {
+	if !requireClientCert {
+		return nil
+	}
+	if certFile == "" {
+		return fmt.Errorf("-tls-require-client-cert needs -tls-cert; the listener would otherwise serve plaintext h2c")
+	}
+	if caFile == "" {
+		return fmt.Errorf("-tls-require-client-cert needs -tls-client-ca to verify client certificates against")
+	}
+	return nil
+}
+
+func newCertReloader(certFile, keyFile, caFile string) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile, caFile: caFile}
+	if _, err := r.certificate(); err != nil {
+		return nil, err
+	}
+	if caFile != "" {
+		if _, err := r.clientCAs(); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func modTime(paths ...string) (time.Time, error) {
+	var latest time.Time
+	for _, p := range paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if fi.ModTime().After(latest) {
+			latest = fi.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+func (r *certReloader) certificate() (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	mod, err := modTime(r.certFile, r.keyFile)
+	if err != nil {
+		if r.cert != nil {
+			return r.cert, nil
+		}
+		return nil, err
+	}
+	if r.cert != nil && !mod.After(r.certMod) {
+		return r.cert, nil
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		if r.cert != nil {
+			slog.Error("TLS certificate reload failed, keeping previous", "error", err)
+			return r.cert, nil
+		}
+		return nil, err
+	}
+	if r.cert != nil {
+		slog.Info("TLS certificate reloaded", "cert", r.certFile)
+	}
+	r.cert, r.certMod = &cert, mod
+	return r.cert, nil
+}
+
+func (r *certReloader) clientCAs() (*x509.CertPool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	mod, err := modTime(r.caFile)
+	if err != nil {
+		if r.clientCA != nil {
+			return r.clientCA, nil
+		}
+		return nil, err
+	}
+	if r.clientCA != nil && !mod.After(r.caMod) {
+		return r.clientCA, nil
+	}
+	pem, err := os.ReadFile(r.caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		if r.clientCA != nil {
+			slog.Error("Client CA reload failed, keeping previous", "path", r.caFile)
+			return r.clientCA, nil
+		}
+		return nil, fmt.Errorf("no certificates found in client CA bundle %s", r.caFile)
+	}
+	r.clientCA, r.caMod = pool, mod
+	return r.clientCA, nil
+}
+
+// tlsConfig builds the listener configuration. When a client CA is given,
+// client certificates are verified against it; requireClientCert turns
+// that into mandatory mutual TLS.
+func (r *certReloader) tlsConfig(requireClientCert bool) *tls.Config {
+	base := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return r.certificate()
+		},
+	}
+	if r.caFile == "" {
+		return base
+	}
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		pool, err := r.clientCAs()
+		if err != nil {
+			return nil, err
+		}
+		cfg := base.Clone()
+		cfg.GetConfigForClient = nil
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+		if requireClientCert {
+			cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		return cfg, nil
+	}
+	return base
+}
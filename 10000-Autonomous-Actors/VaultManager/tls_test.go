@@ -0,0 +1,162 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"olympus.fleet/00SDLC/OlympusGCP-Vault/10000-Autonomous-Actors/10700-Processing-Engines/10710-Reasoning-Inference/inference"
+)
+
+type testPKI struct {
+	caCert *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	caPEM  []byte
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create CA: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testPKI{caCert: cert, caKey: key, caPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func (p *testPKI) issue(t *testing.T, serial int64, tmpl *x509.Certificate) (certPEM, keyPEM []byte) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl.SerialNumber = big.NewInt(serial)
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, p.caCert, &key.PublicKey, p.caKey)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func serverTemplate() *x509.Certificate {
+	return &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "vault"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+}
+
+func TestMutualTLSIdentityAndReload(t *testing.T) {
+	pki := newTestPKI(t)
+	dir := t.TempDir()
+	certFile, keyFile, caFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), filepath.Join(dir, "ca.crt")
+
+	certPEM, keyPEM := pki.issue(t, 10, serverTemplate())
+	os.WriteFile(certFile, certPEM, 0600)
+	os.WriteFile(keyFile, keyPEM, 0600)
+	os.WriteFile(caFile, pki.caPEM, 0600)
+
+	reloader, err := newCertReloader(certFile, keyFile, caFile)
+	if err != nil {
+		t.Fatalf("newCertReloader: %v", err)
+	}
+
+	ts := httptest.NewUnstartedServer(inference.PeerIdentityHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if c := inference.CallerFrom(r.Context()); c != nil {
+			io.WriteString(w, c.Identity)
+		}
+	})))
+	ts.TLS = reloader.tlsConfig(true)
+	ts.StartTLS()
+	defer ts.Close()
+
+	spiffe, _ := url.Parse("spiffe://olympus.fleet/agent/conductor")
+	clientPEM, clientKey := pki.issue(t, 20, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "conductor"},
+		URIs:        []*url.URL{spiffe},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	clientCert, _ := tls.X509KeyPair(clientPEM, clientKey)
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(pki.caPEM)
+
+	newClient := func(certs ...tls.Certificate) *http.Client {
+		return &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: certs}}}
+	}
+
+	resp, err := newClient(clientCert).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("mTLS request failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "spiffe://olympus.fleet/agent/conductor" {
+		t.Errorf("Expected SPIFFE identity, got %q", body)
+	}
+	if resp.TLS.PeerCertificates[0].SerialNumber.Int64() != 10 {
+		t.Errorf("Expected serial 10, got %d", resp.TLS.PeerCertificates[0].SerialNumber.Int64())
+	}
+
+	if _, err := newClient().Get(ts.URL); err == nil {
+		t.Error("Expected handshake without client certificate to fail")
+	}
+
+	// Rotate the server certificate on disk; the next handshake must see it.
+	certPEM, keyPEM = pki.issue(t, 11, serverTemplate())
+	os.WriteFile(certFile, certPEM, 0600)
+	os.WriteFile(keyFile, keyPEM, 0600)
+	future := time.Now().Add(time.Minute)
+	os.Chtimes(certFile, future, future)
+
+	resp, err = newClient(clientCert).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("request after reload failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.TLS.PeerCertificates[0].SerialNumber.Int64() != 11 {
+		t.Errorf("Expected reloaded serial 11, got %d", resp.TLS.PeerCertificates[0].SerialNumber.Int64())
+	}
+}
+
+func TestCheckTLSFlags(t *testing.T) {
+	cases := []struct {
+		cert, ca string
+		require  bool
+		ok       bool
+	}{
+		{"", "", false, true},
+		{"tls.crt", "", false, true},
+		{"tls.crt", "ca.crt", true, true},
+		{"tls.crt", "", true, false},
+		{"", "ca.crt", true, false},
+		{"", "", true, false},
+	}
+	for _, c := range cases {
+		err := checkTLSFlags(c.cert, c.ca, c.require)
+		if (err == nil) != c.ok {
+			t.Errorf("checkTLSFlags(%q, %q, %v) = %v, want ok=%v", c.cert, c.ca, c.require, err, c.ok)
+		}
+	}
+}
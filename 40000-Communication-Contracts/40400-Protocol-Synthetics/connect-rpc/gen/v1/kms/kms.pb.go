@@ -0,0 +1,2635 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/kms/kms.proto
+
+// The essential surface of the Cloud KMS API. Package, service, method and
+// field numbers match google/cloud/kms/v1 so the Cloud KMS client libraries
+// can talk to VaultManager over gRPC; fields the emulator does not
+// implement are left out and ignored on the wire.
+
+package kmsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProtectionLevel int32
+
+const (
+	ProtectionLevel_PROTECTION_LEVEL_UNSPECIFIED ProtectionLevel = 0
+	ProtectionLevel_SOFTWARE                     ProtectionLevel = 1
+	ProtectionLevel_HSM                          ProtectionLevel = 2
+	ProtectionLevel_EXTERNAL                     ProtectionLevel = 3
+	ProtectionLevel_EXTERNAL_VPC                 ProtectionLevel = 4
+)
+
+// Enum value maps for ProtectionLevel.
+var (
+	ProtectionLevel_name = map[int32]string{
+		0: "PROTECTION_LEVEL_UNSPECIFIED",
+		1: "SOFTWARE",
+		2: "HSM",
+		3: "EXTERNAL",
+		4: "EXTERNAL_VPC",
+	}
+	ProtectionLevel_value = map[string]int32{
+		"PROTECTION_LEVEL_UNSPECIFIED": 0,
+		"SOFTWARE":                     1,
+		"HSM":                          2,
+		"EXTERNAL":                     3,
+		"EXTERNAL_VPC":                 4,
+	}
+)
+
+func (x ProtectionLevel) Enum() *ProtectionLevel {
+	p := new(ProtectionLevel)
+	*p = x
+	return p
+}
+
+func (x ProtectionLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProtectionLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_kms_kms_proto_enumTypes[0].Descriptor()
+}
+
+func (ProtectionLevel) Type() protoreflect.EnumType {
+	return &file_v1_kms_kms_proto_enumTypes[0]
+}
+
+func (x ProtectionLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProtectionLevel.Descriptor instead.
+func (ProtectionLevel) EnumDescriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{0}
+}
+
+type CryptoKey_CryptoKeyPurpose int32
+
+const (
+	CryptoKey_CRYPTO_KEY_PURPOSE_UNSPECIFIED CryptoKey_CryptoKeyPurpose = 0
+	CryptoKey_ENCRYPT_DECRYPT                CryptoKey_CryptoKeyPurpose = 1
+	CryptoKey_ASYMMETRIC_SIGN                CryptoKey_CryptoKeyPurpose = 5
+	CryptoKey_ASYMMETRIC_DECRYPT             CryptoKey_CryptoKeyPurpose = 6
+	CryptoKey_RAW_ENCRYPT_DECRYPT            CryptoKey_CryptoKeyPurpose = 7
+	CryptoKey_MAC                            CryptoKey_CryptoKeyPurpose = 9
+)
+
+// Enum value maps for CryptoKey_CryptoKeyPurpose.
+var (
+	CryptoKey_CryptoKeyPurpose_name = map[int32]string{
+		0: "CRYPTO_KEY_PURPOSE_UNSPECIFIED",
+		1: "ENCRYPT_DECRYPT",
+		5: "ASYMMETRIC_SIGN",
+		6: "ASYMMETRIC_DECRYPT",
+		7: "RAW_ENCRYPT_DECRYPT",
+		9: "MAC",
+	}
+	CryptoKey_CryptoKeyPurpose_value = map[string]int32{
+		"CRYPTO_KEY_PURPOSE_UNSPECIFIED": 0,
+		"ENCRYPT_DECRYPT":                1,
+		"ASYMMETRIC_SIGN":                5,
+		"ASYMMETRIC_DECRYPT":             6,
+		"RAW_ENCRYPT_DECRYPT":            7,
+		"MAC":                            9,
+	}
+)
+
+func (x CryptoKey_CryptoKeyPurpose) Enum() *CryptoKey_CryptoKeyPurpose {
+	p := new(CryptoKey_CryptoKeyPurpose)
+	*p = x
+	return p
+}
+
+func (x CryptoKey_CryptoKeyPurpose) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CryptoKey_CryptoKeyPurpose) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_kms_kms_proto_enumTypes[1].Descriptor()
+}
+
+func (CryptoKey_CryptoKeyPurpose) Type() protoreflect.EnumType {
+	return &file_v1_kms_kms_proto_enumTypes[1]
+}
+
+func (x CryptoKey_CryptoKeyPurpose) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CryptoKey_CryptoKeyPurpose.Descriptor instead.
+func (CryptoKey_CryptoKeyPurpose) EnumDescriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type CryptoKeyVersion_CryptoKeyVersionAlgorithm int32
+
+const (
+	CryptoKeyVersion_CRYPTO_KEY_VERSION_ALGORITHM_UNSPECIFIED CryptoKeyVersion_CryptoKeyVersionAlgorithm = 0
+	CryptoKeyVersion_GOOGLE_SYMMETRIC_ENCRYPTION              CryptoKeyVersion_CryptoKeyVersionAlgorithm = 1
+	CryptoKeyVersion_RSA_SIGN_PSS_2048_SHA256                 CryptoKeyVersion_CryptoKeyVersionAlgorithm = 2
+	CryptoKeyVersion_RSA_SIGN_PSS_3072_SHA256                 CryptoKeyVersion_CryptoKeyVersionAlgorithm = 3
+	CryptoKeyVersion_RSA_SIGN_PSS_4096_SHA256                 CryptoKeyVersion_CryptoKeyVersionAlgorithm = 4
+	CryptoKeyVersion_RSA_SIGN_PSS_4096_SHA512                 CryptoKeyVersion_CryptoKeyVersionAlgorithm = 15
+	CryptoKeyVersion_RSA_SIGN_PKCS1_2048_SHA256               CryptoKeyVersion_CryptoKeyVersionAlgorithm = 5
+	CryptoKeyVersion_RSA_SIGN_PKCS1_3072_SHA256               CryptoKeyVersion_CryptoKeyVersionAlgorithm = 6
+	CryptoKeyVersion_RSA_SIGN_PKCS1_4096_SHA256               CryptoKeyVersion_CryptoKeyVersionAlgorithm = 7
+	CryptoKeyVersion_RSA_SIGN_PKCS1_4096_SHA512               CryptoKeyVersion_CryptoKeyVersionAlgorithm = 16
+	CryptoKeyVersion_EC_SIGN_P256_SHA256                      CryptoKeyVersion_CryptoKeyVersionAlgorithm = 12
+	CryptoKeyVersion_EC_SIGN_P384_SHA384                      CryptoKeyVersion_CryptoKeyVersionAlgorithm = 13
+	CryptoKeyVersion_EC_SIGN_ED25519                          CryptoKeyVersion_CryptoKeyVersionAlgorithm = 40
+)
+
+// Enum value maps for CryptoKeyVersion_CryptoKeyVersionAlgorithm.
+var (
+	CryptoKeyVersion_CryptoKeyVersionAlgorithm_name = map[int32]string{
+		0:  "CRYPTO_KEY_VERSION_ALGORITHM_UNSPECIFIED",
+		1:  "GOOGLE_SYMMETRIC_ENCRYPTION",
+		2:  "RSA_SIGN_PSS_2048_SHA256",
+		3:  "RSA_SIGN_PSS_3072_SHA256",
+		4:  "RSA_SIGN_PSS_4096_SHA256",
+		15: "RSA_SIGN_PSS_4096_SHA512",
+		5:  "RSA_SIGN_PKCS1_2048_SHA256",
+		6:  "RSA_SIGN_PKCS1_3072_SHA256",
+		7:  "RSA_SIGN_PKCS1_4096_SHA256",
+		16: "RSA_SIGN_PKCS1_4096_SHA512",
+		12: "EC_SIGN_P256_SHA256",
+		13: "EC_SIGN_P384_SHA384",
+		40: "EC_SIGN_ED25519",
+	}
+	CryptoKeyVersion_CryptoKeyVersionAlgorithm_value = map[string]int32{
+		"CRYPTO_KEY_VERSION_ALGORITHM_UNSPECIFIED": 0,
+		"GOOGLE_SYMMETRIC_ENCRYPTION":              1,
+		"RSA_SIGN_PSS_2048_SHA256":                 2,
+		"RSA_SIGN_PSS_3072_SHA256":                 3,
+		"RSA_SIGN_PSS_4096_SHA256":                 4,
+		"RSA_SIGN_PSS_4096_SHA512":                 15,
+		"RSA_SIGN_PKCS1_2048_SHA256":               5,
+		"RSA_SIGN_PKCS1_3072_SHA256":               6,
+		"RSA_SIGN_PKCS1_4096_SHA256":               7,
+		"RSA_SIGN_PKCS1_4096_SHA512":               16,
+		"EC_SIGN_P256_SHA256":                      12,
+		"EC_SIGN_P384_SHA384":                      13,
+		"EC_SIGN_ED25519":                          40,
+	}
+)
+
+func (x CryptoKeyVersion_CryptoKeyVersionAlgorithm) Enum() *CryptoKeyVersion_CryptoKeyVersionAlgorithm {
+	p := new(CryptoKeyVersion_CryptoKeyVersionAlgorithm)
+	*p = x
+	return p
+}
+
+func (x CryptoKeyVersion_CryptoKeyVersionAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CryptoKeyVersion_CryptoKeyVersionAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_kms_kms_proto_enumTypes[2].Descriptor()
+}
+
+func (CryptoKeyVersion_CryptoKeyVersionAlgorithm) Type() protoreflect.EnumType {
+	return &file_v1_kms_kms_proto_enumTypes[2]
+}
+
+func (x CryptoKeyVersion_CryptoKeyVersionAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CryptoKeyVersion_CryptoKeyVersionAlgorithm.Descriptor instead.
+func (CryptoKeyVersion_CryptoKeyVersionAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type CryptoKeyVersion_CryptoKeyVersionState int32
+
+const (
+	CryptoKeyVersion_CRYPTO_KEY_VERSION_STATE_UNSPECIFIED CryptoKeyVersion_CryptoKeyVersionState = 0
+	CryptoKeyVersion_PENDING_GENERATION                   CryptoKeyVersion_CryptoKeyVersionState = 5
+	CryptoKeyVersion_ENABLED                              CryptoKeyVersion_CryptoKeyVersionState = 1
+	CryptoKeyVersion_DISABLED                             CryptoKeyVersion_CryptoKeyVersionState = 2
+	CryptoKeyVersion_DESTROYED                            CryptoKeyVersion_CryptoKeyVersionState = 3
+	CryptoKeyVersion_DESTROY_SCHEDULED                    CryptoKeyVersion_CryptoKeyVersionState = 4
+)
+
+// Enum value maps for CryptoKeyVersion_CryptoKeyVersionState.
+var (
+	CryptoKeyVersion_CryptoKeyVersionState_name = map[int32]string{
+		0: "CRYPTO_KEY_VERSION_STATE_UNSPECIFIED",
+		5: "PENDING_GENERATION",
+		1: "ENABLED",
+		2: "DISABLED",
+		3: "DESTROYED",
+		4: "DESTROY_SCHEDULED",
+	}
+	CryptoKeyVersion_CryptoKeyVersionState_value = map[string]int32{
+		"CRYPTO_KEY_VERSION_STATE_UNSPECIFIED": 0,
+		"PENDING_GENERATION":                   5,
+		"ENABLED":                              1,
+		"DISABLED":                             2,
+		"DESTROYED":                            3,
+		"DESTROY_SCHEDULED":                    4,
+	}
+)
+
+func (x CryptoKeyVersion_CryptoKeyVersionState) Enum() *CryptoKeyVersion_CryptoKeyVersionState {
+	p := new(CryptoKeyVersion_CryptoKeyVersionState)
+	*p = x
+	return p
+}
+
+func (x CryptoKeyVersion_CryptoKeyVersionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CryptoKeyVersion_CryptoKeyVersionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_kms_kms_proto_enumTypes[3].Descriptor()
+}
+
+func (CryptoKeyVersion_CryptoKeyVersionState) Type() protoreflect.EnumType {
+	return &file_v1_kms_kms_proto_enumTypes[3]
+}
+
+func (x CryptoKeyVersion_CryptoKeyVersionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CryptoKeyVersion_CryptoKeyVersionState.Descriptor instead.
+func (CryptoKeyVersion_CryptoKeyVersionState) EnumDescriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{3, 1}
+}
+
+type CryptoKeyVersion_CryptoKeyVersionView int32
+
+const (
+	CryptoKeyVersion_CRYPTO_KEY_VERSION_VIEW_UNSPECIFIED CryptoKeyVersion_CryptoKeyVersionView = 0
+	CryptoKeyVersion_FULL                                CryptoKeyVersion_CryptoKeyVersionView = 1
+)
+
+// Enum value maps for CryptoKeyVersion_CryptoKeyVersionView.
+var (
+	CryptoKeyVersion_CryptoKeyVersionView_name = map[int32]string{
+		0: "CRYPTO_KEY_VERSION_VIEW_UNSPECIFIED",
+		1: "FULL",
+	}
+	CryptoKeyVersion_CryptoKeyVersionView_value = map[string]int32{
+		"CRYPTO_KEY_VERSION_VIEW_UNSPECIFIED": 0,
+		"FULL":                                1,
+	}
+)
+
+func (x CryptoKeyVersion_CryptoKeyVersionView) Enum() *CryptoKeyVersion_CryptoKeyVersionView {
+	p := new(CryptoKeyVersion_CryptoKeyVersionView)
+	*p = x
+	return p
+}
+
+func (x CryptoKeyVersion_CryptoKeyVersionView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CryptoKeyVersion_CryptoKeyVersionView) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_kms_kms_proto_enumTypes[4].Descriptor()
+}
+
+func (CryptoKeyVersion_CryptoKeyVersionView) Type() protoreflect.EnumType {
+	return &file_v1_kms_kms_proto_enumTypes[4]
+}
+
+func (x CryptoKeyVersion_CryptoKeyVersionView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CryptoKeyVersion_CryptoKeyVersionView.Descriptor instead.
+func (CryptoKeyVersion_CryptoKeyVersionView) EnumDescriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{3, 2}
+}
+
+type KeyRing struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "projects/*/locations/*/keyRings/*".
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyRing) Reset() {
+	*x = KeyRing{}
+	mi := &file_v1_kms_kms_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyRing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyRing) ProtoMessage() {}
+
+func (x *KeyRing) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyRing.ProtoReflect.Descriptor instead.
+func (*KeyRing) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KeyRing) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KeyRing) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type CryptoKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "projects/*/locations/*/keyRings/*/cryptoKeys/*".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The version Encrypt uses; ENCRYPT_DECRYPT keys only.
+	Primary         *CryptoKeyVersion          `protobuf:"bytes,2,opt,name=primary,proto3" json:"primary,omitempty"`
+	Purpose         CryptoKey_CryptoKeyPurpose `protobuf:"varint,3,opt,name=purpose,proto3,enum=google.cloud.kms.v1.CryptoKey_CryptoKeyPurpose" json:"purpose,omitempty"`
+	CreateTime      *timestamppb.Timestamp     `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	VersionTemplate *CryptoKeyVersionTemplate  `protobuf:"bytes,11,opt,name=version_template,json=versionTemplate,proto3" json:"version_template,omitempty"`
+	Labels          map[string]string          `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// How long versions stay DESTROY_SCHEDULED before their key material is
+	// destroyed. Defaults to 30 days.
+	DestroyScheduledDuration *durationpb.Duration `protobuf:"bytes,14,opt,name=destroy_scheduled_duration,json=destroyScheduledDuration,proto3" json:"destroy_scheduled_duration,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CryptoKey) Reset() {
+	*x = CryptoKey{}
+	mi := &file_v1_kms_kms_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CryptoKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CryptoKey) ProtoMessage() {}
+
+func (x *CryptoKey) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CryptoKey.ProtoReflect.Descriptor instead.
+func (*CryptoKey) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CryptoKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CryptoKey) GetPrimary() *CryptoKeyVersion {
+	if x != nil {
+		return x.Primary
+	}
+	return nil
+}
+
+func (x *CryptoKey) GetPurpose() CryptoKey_CryptoKeyPurpose {
+	if x != nil {
+		return x.Purpose
+	}
+	return CryptoKey_CRYPTO_KEY_PURPOSE_UNSPECIFIED
+}
+
+func (x *CryptoKey) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *CryptoKey) GetVersionTemplate() *CryptoKeyVersionTemplate {
+	if x != nil {
+		return x.VersionTemplate
+	}
+	return nil
+}
+
+func (x *CryptoKey) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CryptoKey) GetDestroyScheduledDuration() *durationpb.Duration {
+	if x != nil {
+		return x.DestroyScheduledDuration
+	}
+	return nil
+}
+
+type CryptoKeyVersionTemplate struct {
+	state           protoimpl.MessageState                     `protogen:"open.v1"`
+	ProtectionLevel ProtectionLevel                            `protobuf:"varint,1,opt,name=protection_level,json=protectionLevel,proto3,enum=google.cloud.kms.v1.ProtectionLevel" json:"protection_level,omitempty"`
+	Algorithm       CryptoKeyVersion_CryptoKeyVersionAlgorithm `protobuf:"varint,3,opt,name=algorithm,proto3,enum=google.cloud.kms.v1.CryptoKeyVersion_CryptoKeyVersionAlgorithm" json:"algorithm,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CryptoKeyVersionTemplate) Reset() {
+	*x = CryptoKeyVersionTemplate{}
+	mi := &file_v1_kms_kms_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CryptoKeyVersionTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CryptoKeyVersionTemplate) ProtoMessage() {}
+
+func (x *CryptoKeyVersionTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CryptoKeyVersionTemplate.ProtoReflect.Descriptor instead.
+func (*CryptoKeyVersionTemplate) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CryptoKeyVersionTemplate) GetProtectionLevel() ProtectionLevel {
+	if x != nil {
+		return x.ProtectionLevel
+	}
+	return ProtectionLevel_PROTECTION_LEVEL_UNSPECIFIED
+}
+
+func (x *CryptoKeyVersionTemplate) GetAlgorithm() CryptoKeyVersion_CryptoKeyVersionAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return CryptoKeyVersion_CRYPTO_KEY_VERSION_ALGORITHM_UNSPECIFIED
+}
+
+type CryptoKeyVersion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "projects/*/locations/*/keyRings/*/cryptoKeys/*/cryptoKeyVersions/*".
+	Name             string                                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State            CryptoKeyVersion_CryptoKeyVersionState     `protobuf:"varint,3,opt,name=state,proto3,enum=google.cloud.kms.v1.CryptoKeyVersion_CryptoKeyVersionState" json:"state,omitempty"`
+	ProtectionLevel  ProtectionLevel                            `protobuf:"varint,7,opt,name=protection_level,json=protectionLevel,proto3,enum=google.cloud.kms.v1.ProtectionLevel" json:"protection_level,omitempty"`
+	Algorithm        CryptoKeyVersion_CryptoKeyVersionAlgorithm `protobuf:"varint,10,opt,name=algorithm,proto3,enum=google.cloud.kms.v1.CryptoKeyVersion_CryptoKeyVersionAlgorithm" json:"algorithm,omitempty"`
+	CreateTime       *timestamppb.Timestamp                     `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	GenerateTime     *timestamppb.Timestamp                     `protobuf:"bytes,11,opt,name=generate_time,json=generateTime,proto3" json:"generate_time,omitempty"`
+	DestroyTime      *timestamppb.Timestamp                     `protobuf:"bytes,5,opt,name=destroy_time,json=destroyTime,proto3" json:"destroy_time,omitempty"`
+	DestroyEventTime *timestamppb.Timestamp                     `protobuf:"bytes,6,opt,name=destroy_event_time,json=destroyEventTime,proto3" json:"destroy_event_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CryptoKeyVersion) Reset() {
+	*x = CryptoKeyVersion{}
+	mi := &file_v1_kms_kms_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CryptoKeyVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CryptoKeyVersion) ProtoMessage() {}
+
+func (x *CryptoKeyVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CryptoKeyVersion.ProtoReflect.Descriptor instead.
+func (*CryptoKeyVersion) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CryptoKeyVersion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CryptoKeyVersion) GetState() CryptoKeyVersion_CryptoKeyVersionState {
+	if x != nil {
+		return x.State
+	}
+	return CryptoKeyVersion_CRYPTO_KEY_VERSION_STATE_UNSPECIFIED
+}
+
+func (x *CryptoKeyVersion) GetProtectionLevel() ProtectionLevel {
+	if x != nil {
+		return x.ProtectionLevel
+	}
+	return ProtectionLevel_PROTECTION_LEVEL_UNSPECIFIED
+}
+
+func (x *CryptoKeyVersion) GetAlgorithm() CryptoKeyVersion_CryptoKeyVersionAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return CryptoKeyVersion_CRYPTO_KEY_VERSION_ALGORITHM_UNSPECIFIED
+}
+
+func (x *CryptoKeyVersion) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *CryptoKeyVersion) GetGenerateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GenerateTime
+	}
+	return nil
+}
+
+func (x *CryptoKeyVersion) GetDestroyTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DestroyTime
+	}
+	return nil
+}
+
+func (x *CryptoKeyVersion) GetDestroyEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DestroyEventTime
+	}
+	return nil
+}
+
+type PublicKey struct {
+	state           protoimpl.MessageState                     `protogen:"open.v1"`
+	Pem             string                                     `protobuf:"bytes,1,opt,name=pem,proto3" json:"pem,omitempty"`
+	Algorithm       CryptoKeyVersion_CryptoKeyVersionAlgorithm `protobuf:"varint,2,opt,name=algorithm,proto3,enum=google.cloud.kms.v1.CryptoKeyVersion_CryptoKeyVersionAlgorithm" json:"algorithm,omitempty"`
+	PemCrc32C       *wrapperspb.Int64Value                     `protobuf:"bytes,3,opt,name=pem_crc32c,json=pemCrc32c,proto3" json:"pem_crc32c,omitempty"`
+	Name            string                                     `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	ProtectionLevel ProtectionLevel                            `protobuf:"varint,5,opt,name=protection_level,json=protectionLevel,proto3,enum=google.cloud.kms.v1.ProtectionLevel" json:"protection_level,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PublicKey) Reset() {
+	*x = PublicKey{}
+	mi := &file_v1_kms_kms_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicKey) ProtoMessage() {}
+
+func (x *PublicKey) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicKey.ProtoReflect.Descriptor instead.
+func (*PublicKey) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PublicKey) GetPem() string {
+	if x != nil {
+		return x.Pem
+	}
+	return ""
+}
+
+func (x *PublicKey) GetAlgorithm() CryptoKeyVersion_CryptoKeyVersionAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return CryptoKeyVersion_CRYPTO_KEY_VERSION_ALGORITHM_UNSPECIFIED
+}
+
+func (x *PublicKey) GetPemCrc32C() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.PemCrc32C
+	}
+	return nil
+}
+
+func (x *PublicKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PublicKey) GetProtectionLevel() ProtectionLevel {
+	if x != nil {
+		return x.ProtectionLevel
+	}
+	return ProtectionLevel_PROTECTION_LEVEL_UNSPECIFIED
+}
+
+type ListKeyRingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "projects/*/locations/*".
+	Parent    string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// filter and order_by are accepted but not applied.
+	Filter        string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKeyRingsRequest) Reset() {
+	*x = ListKeyRingsRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKeyRingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeyRingsRequest) ProtoMessage() {}
+
+func (x *ListKeyRingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeyRingsRequest.ProtoReflect.Descriptor instead.
+func (*ListKeyRingsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListKeyRingsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListKeyRingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListKeyRingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListKeyRingsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListKeyRingsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListCryptoKeysRequest struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Parent        string                                `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	PageSize      int32                                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                                `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	VersionView   CryptoKeyVersion_CryptoKeyVersionView `protobuf:"varint,4,opt,name=version_view,json=versionView,proto3,enum=google.cloud.kms.v1.CryptoKeyVersion_CryptoKeyVersionView" json:"version_view,omitempty"`
+	Filter        string                                `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       string                                `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCryptoKeysRequest) Reset() {
+	*x = ListCryptoKeysRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCryptoKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCryptoKeysRequest) ProtoMessage() {}
+
+func (x *ListCryptoKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCryptoKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListCryptoKeysRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListCryptoKeysRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListCryptoKeysRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCryptoKeysRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCryptoKeysRequest) GetVersionView() CryptoKeyVersion_CryptoKeyVersionView {
+	if x != nil {
+		return x.VersionView
+	}
+	return CryptoKeyVersion_CRYPTO_KEY_VERSION_VIEW_UNSPECIFIED
+}
+
+func (x *ListCryptoKeysRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListCryptoKeysRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListCryptoKeyVersionsRequest struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Parent        string                                `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	PageSize      int32                                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                                `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	View          CryptoKeyVersion_CryptoKeyVersionView `protobuf:"varint,4,opt,name=view,proto3,enum=google.cloud.kms.v1.CryptoKeyVersion_CryptoKeyVersionView" json:"view,omitempty"`
+	Filter        string                                `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy       string                                `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCryptoKeyVersionsRequest) Reset() {
+	*x = ListCryptoKeyVersionsRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCryptoKeyVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCryptoKeyVersionsRequest) ProtoMessage() {}
+
+func (x *ListCryptoKeyVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCryptoKeyVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCryptoKeyVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCryptoKeyVersionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListCryptoKeyVersionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCryptoKeyVersionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCryptoKeyVersionsRequest) GetView() CryptoKeyVersion_CryptoKeyVersionView {
+	if x != nil {
+		return x.View
+	}
+	return CryptoKeyVersion_CRYPTO_KEY_VERSION_VIEW_UNSPECIFIED
+}
+
+func (x *ListCryptoKeyVersionsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListCryptoKeyVersionsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListKeyRingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyRings      []*KeyRing             `protobuf:"bytes,1,rep,name=key_rings,json=keyRings,proto3" json:"key_rings,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKeyRingsResponse) Reset() {
+	*x = ListKeyRingsResponse{}
+	mi := &file_v1_kms_kms_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKeyRingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeyRingsResponse) ProtoMessage() {}
+
+func (x *ListKeyRingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeyRingsResponse.ProtoReflect.Descriptor instead.
+func (*ListKeyRingsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListKeyRingsResponse) GetKeyRings() []*KeyRing {
+	if x != nil {
+		return x.KeyRings
+	}
+	return nil
+}
+
+func (x *ListKeyRingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListKeyRingsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListCryptoKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CryptoKeys    []*CryptoKey           `protobuf:"bytes,1,rep,name=crypto_keys,json=cryptoKeys,proto3" json:"crypto_keys,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCryptoKeysResponse) Reset() {
+	*x = ListCryptoKeysResponse{}
+	mi := &file_v1_kms_kms_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCryptoKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCryptoKeysResponse) ProtoMessage() {}
+
+func (x *ListCryptoKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCryptoKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListCryptoKeysResponse) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListCryptoKeysResponse) GetCryptoKeys() []*CryptoKey {
+	if x != nil {
+		return x.CryptoKeys
+	}
+	return nil
+}
+
+func (x *ListCryptoKeysResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCryptoKeysResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListCryptoKeyVersionsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CryptoKeyVersions []*CryptoKeyVersion    `protobuf:"bytes,1,rep,name=crypto_key_versions,json=cryptoKeyVersions,proto3" json:"crypto_key_versions,omitempty"`
+	NextPageToken     string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize         int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListCryptoKeyVersionsResponse) Reset() {
+	*x = ListCryptoKeyVersionsResponse{}
+	mi := &file_v1_kms_kms_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCryptoKeyVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCryptoKeyVersionsResponse) ProtoMessage() {}
+
+func (x *ListCryptoKeyVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCryptoKeyVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCryptoKeyVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCryptoKeyVersionsResponse) GetCryptoKeyVersions() []*CryptoKeyVersion {
+	if x != nil {
+		return x.CryptoKeyVersions
+	}
+	return nil
+}
+
+func (x *ListCryptoKeyVersionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCryptoKeyVersionsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type GetKeyRingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeyRingRequest) Reset() {
+	*x = GetKeyRingRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeyRingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeyRingRequest) ProtoMessage() {}
+
+func (x *GetKeyRingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeyRingRequest.ProtoReflect.Descriptor instead.
+func (*GetKeyRingRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetKeyRingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetCryptoKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCryptoKeyRequest) Reset() {
+	*x = GetCryptoKeyRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCryptoKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCryptoKeyRequest) ProtoMessage() {}
+
+func (x *GetCryptoKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCryptoKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetCryptoKeyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCryptoKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetCryptoKeyVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCryptoKeyVersionRequest) Reset() {
+	*x = GetCryptoKeyVersionRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCryptoKeyVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCryptoKeyVersionRequest) ProtoMessage() {}
+
+func (x *GetCryptoKeyVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCryptoKeyVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetCryptoKeyVersionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCryptoKeyVersionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetPublicKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicKeyRequest) Reset() {
+	*x = GetPublicKeyRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyRequest) ProtoMessage() {}
+
+func (x *GetPublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPublicKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateKeyRingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parent        string                 `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	KeyRingId     string                 `protobuf:"bytes,2,opt,name=key_ring_id,json=keyRingId,proto3" json:"key_ring_id,omitempty"`
+	KeyRing       *KeyRing               `protobuf:"bytes,3,opt,name=key_ring,json=keyRing,proto3" json:"key_ring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateKeyRingRequest) Reset() {
+	*x = CreateKeyRingRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateKeyRingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKeyRingRequest) ProtoMessage() {}
+
+func (x *CreateKeyRingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKeyRingRequest.ProtoReflect.Descriptor instead.
+func (*CreateKeyRingRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateKeyRingRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateKeyRingRequest) GetKeyRingId() string {
+	if x != nil {
+		return x.KeyRingId
+	}
+	return ""
+}
+
+func (x *CreateKeyRingRequest) GetKeyRing() *KeyRing {
+	if x != nil {
+		return x.KeyRing
+	}
+	return nil
+}
+
+type CreateCryptoKeyRequest struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	Parent                     string                 `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	CryptoKeyId                string                 `protobuf:"bytes,2,opt,name=crypto_key_id,json=cryptoKeyId,proto3" json:"crypto_key_id,omitempty"`
+	CryptoKey                  *CryptoKey             `protobuf:"bytes,3,opt,name=crypto_key,json=cryptoKey,proto3" json:"crypto_key,omitempty"`
+	SkipInitialVersionCreation bool                   `protobuf:"varint,5,opt,name=skip_initial_version_creation,json=skipInitialVersionCreation,proto3" json:"skip_initial_version_creation,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *CreateCryptoKeyRequest) Reset() {
+	*x = CreateCryptoKeyRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCryptoKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCryptoKeyRequest) ProtoMessage() {}
+
+func (x *CreateCryptoKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCryptoKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCryptoKeyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateCryptoKeyRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateCryptoKeyRequest) GetCryptoKeyId() string {
+	if x != nil {
+		return x.CryptoKeyId
+	}
+	return ""
+}
+
+func (x *CreateCryptoKeyRequest) GetCryptoKey() *CryptoKey {
+	if x != nil {
+		return x.CryptoKey
+	}
+	return nil
+}
+
+func (x *CreateCryptoKeyRequest) GetSkipInitialVersionCreation() bool {
+	if x != nil {
+		return x.SkipInitialVersionCreation
+	}
+	return false
+}
+
+type CreateCryptoKeyVersionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Parent           string                 `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	CryptoKeyVersion *CryptoKeyVersion      `protobuf:"bytes,2,opt,name=crypto_key_version,json=cryptoKeyVersion,proto3" json:"crypto_key_version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateCryptoKeyVersionRequest) Reset() {
+	*x = CreateCryptoKeyVersionRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCryptoKeyVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCryptoKeyVersionRequest) ProtoMessage() {}
+
+func (x *CreateCryptoKeyVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCryptoKeyVersionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCryptoKeyVersionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateCryptoKeyVersionRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateCryptoKeyVersionRequest) GetCryptoKeyVersion() *CryptoKeyVersion {
+	if x != nil {
+		return x.CryptoKeyVersion
+	}
+	return nil
+}
+
+type UpdateCryptoKeyVersionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only state may be updated, between ENABLED and DISABLED.
+	CryptoKeyVersion *CryptoKeyVersion      `protobuf:"bytes,1,opt,name=crypto_key_version,json=cryptoKeyVersion,proto3" json:"crypto_key_version,omitempty"`
+	UpdateMask       *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateCryptoKeyVersionRequest) Reset() {
+	*x = UpdateCryptoKeyVersionRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCryptoKeyVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCryptoKeyVersionRequest) ProtoMessage() {}
+
+func (x *UpdateCryptoKeyVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCryptoKeyVersionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCryptoKeyVersionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateCryptoKeyVersionRequest) GetCryptoKeyVersion() *CryptoKeyVersion {
+	if x != nil {
+		return x.CryptoKeyVersion
+	}
+	return nil
+}
+
+func (x *UpdateCryptoKeyVersionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateCryptoKeyPrimaryVersionRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CryptoKeyVersionId string                 `protobuf:"bytes,2,opt,name=crypto_key_version_id,json=cryptoKeyVersionId,proto3" json:"crypto_key_version_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateCryptoKeyPrimaryVersionRequest) Reset() {
+	*x = UpdateCryptoKeyPrimaryVersionRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCryptoKeyPrimaryVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCryptoKeyPrimaryVersionRequest) ProtoMessage() {}
+
+func (x *UpdateCryptoKeyPrimaryVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCryptoKeyPrimaryVersionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCryptoKeyPrimaryVersionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateCryptoKeyPrimaryVersionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCryptoKeyPrimaryVersionRequest) GetCryptoKeyVersionId() string {
+	if x != nil {
+		return x.CryptoKeyVersionId
+	}
+	return ""
+}
+
+type DestroyCryptoKeyVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroyCryptoKeyVersionRequest) Reset() {
+	*x = DestroyCryptoKeyVersionRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroyCryptoKeyVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyCryptoKeyVersionRequest) ProtoMessage() {}
+
+func (x *DestroyCryptoKeyVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyCryptoKeyVersionRequest.ProtoReflect.Descriptor instead.
+func (*DestroyCryptoKeyVersionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DestroyCryptoKeyVersionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RestoreCryptoKeyVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCryptoKeyVersionRequest) Reset() {
+	*x = RestoreCryptoKeyVersionRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCryptoKeyVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCryptoKeyVersionRequest) ProtoMessage() {}
+
+func (x *RestoreCryptoKeyVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCryptoKeyVersionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCryptoKeyVersionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestoreCryptoKeyVersionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type EncryptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A CryptoKey, which encrypts with its primary version, or a
+	// CryptoKeyVersion.
+	Name                              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Plaintext                         []byte                 `protobuf:"bytes,2,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	AdditionalAuthenticatedData       []byte                 `protobuf:"bytes,3,opt,name=additional_authenticated_data,json=additionalAuthenticatedData,proto3" json:"additional_authenticated_data,omitempty"`
+	PlaintextCrc32C                   *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=plaintext_crc32c,json=plaintextCrc32c,proto3" json:"plaintext_crc32c,omitempty"`
+	AdditionalAuthenticatedDataCrc32C *wrapperspb.Int64Value `protobuf:"bytes,8,opt,name=additional_authenticated_data_crc32c,json=additionalAuthenticatedDataCrc32c,proto3" json:"additional_authenticated_data_crc32c,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *EncryptRequest) Reset() {
+	*x = EncryptRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptRequest) ProtoMessage() {}
+
+func (x *EncryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptRequest.ProtoReflect.Descriptor instead.
+func (*EncryptRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EncryptRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EncryptRequest) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+func (x *EncryptRequest) GetAdditionalAuthenticatedData() []byte {
+	if x != nil {
+		return x.AdditionalAuthenticatedData
+	}
+	return nil
+}
+
+func (x *EncryptRequest) GetPlaintextCrc32C() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.PlaintextCrc32C
+	}
+	return nil
+}
+
+func (x *EncryptRequest) GetAdditionalAuthenticatedDataCrc32C() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.AdditionalAuthenticatedDataCrc32C
+	}
+	return nil
+}
+
+type EncryptResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The CryptoKeyVersion used.
+	Name                                      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ciphertext                                []byte                 `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	CiphertextCrc32C                          *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=ciphertext_crc32c,json=ciphertextCrc32c,proto3" json:"ciphertext_crc32c,omitempty"`
+	VerifiedPlaintextCrc32C                   bool                   `protobuf:"varint,5,opt,name=verified_plaintext_crc32c,json=verifiedPlaintextCrc32c,proto3" json:"verified_plaintext_crc32c,omitempty"`
+	VerifiedAdditionalAuthenticatedDataCrc32C bool                   `protobuf:"varint,6,opt,name=verified_additional_authenticated_data_crc32c,json=verifiedAdditionalAuthenticatedDataCrc32c,proto3" json:"verified_additional_authenticated_data_crc32c,omitempty"`
+	ProtectionLevel                           ProtectionLevel        `protobuf:"varint,7,opt,name=protection_level,json=protectionLevel,proto3,enum=google.cloud.kms.v1.ProtectionLevel" json:"protection_level,omitempty"`
+	unknownFields                             protoimpl.UnknownFields
+	sizeCache                                 protoimpl.SizeCache
+}
+
+func (x *EncryptResponse) Reset() {
+	*x = EncryptResponse{}
+	mi := &file_v1_kms_kms_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptResponse) ProtoMessage() {}
+
+func (x *EncryptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptResponse.ProtoReflect.Descriptor instead.
+func (*EncryptResponse) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EncryptResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EncryptResponse) GetCiphertext() []byte {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return nil
+}
+
+func (x *EncryptResponse) GetCiphertextCrc32C() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.CiphertextCrc32C
+	}
+	return nil
+}
+
+func (x *EncryptResponse) GetVerifiedPlaintextCrc32C() bool {
+	if x != nil {
+		return x.VerifiedPlaintextCrc32C
+	}
+	return false
+}
+
+func (x *EncryptResponse) GetVerifiedAdditionalAuthenticatedDataCrc32C() bool {
+	if x != nil {
+		return x.VerifiedAdditionalAuthenticatedDataCrc32C
+	}
+	return false
+}
+
+func (x *EncryptResponse) GetProtectionLevel() ProtectionLevel {
+	if x != nil {
+		return x.ProtectionLevel
+	}
+	return ProtectionLevel_PROTECTION_LEVEL_UNSPECIFIED
+}
+
+type DecryptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The CryptoKey; the version is recorded in the ciphertext.
+	Name                              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ciphertext                        []byte                 `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	AdditionalAuthenticatedData       []byte                 `protobuf:"bytes,3,opt,name=additional_authenticated_data,json=additionalAuthenticatedData,proto3" json:"additional_authenticated_data,omitempty"`
+	CiphertextCrc32C                  *wrapperspb.Int64Value `protobuf:"bytes,5,opt,name=ciphertext_crc32c,json=ciphertextCrc32c,proto3" json:"ciphertext_crc32c,omitempty"`
+	AdditionalAuthenticatedDataCrc32C *wrapperspb.Int64Value `protobuf:"bytes,6,opt,name=additional_authenticated_data_crc32c,json=additionalAuthenticatedDataCrc32c,proto3" json:"additional_authenticated_data_crc32c,omitempty"`
+	unknownFields                     protoimpl.UnknownFields
+	sizeCache                         protoimpl.SizeCache
+}
+
+func (x *DecryptRequest) Reset() {
+	*x = DecryptRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptRequest) ProtoMessage() {}
+
+func (x *DecryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptRequest.ProtoReflect.Descriptor instead.
+func (*DecryptRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DecryptRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DecryptRequest) GetCiphertext() []byte {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return nil
+}
+
+func (x *DecryptRequest) GetAdditionalAuthenticatedData() []byte {
+	if x != nil {
+		return x.AdditionalAuthenticatedData
+	}
+	return nil
+}
+
+func (x *DecryptRequest) GetCiphertextCrc32C() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.CiphertextCrc32C
+	}
+	return nil
+}
+
+func (x *DecryptRequest) GetAdditionalAuthenticatedDataCrc32C() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.AdditionalAuthenticatedDataCrc32C
+	}
+	return nil
+}
+
+type DecryptResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Plaintext       []byte                 `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	PlaintextCrc32C *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=plaintext_crc32c,json=plaintextCrc32c,proto3" json:"plaintext_crc32c,omitempty"`
+	UsedPrimary     bool                   `protobuf:"varint,3,opt,name=used_primary,json=usedPrimary,proto3" json:"used_primary,omitempty"`
+	ProtectionLevel ProtectionLevel        `protobuf:"varint,4,opt,name=protection_level,json=protectionLevel,proto3,enum=google.cloud.kms.v1.ProtectionLevel" json:"protection_level,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DecryptResponse) Reset() {
+	*x = DecryptResponse{}
+	mi := &file_v1_kms_kms_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecryptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptResponse) ProtoMessage() {}
+
+func (x *DecryptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptResponse.ProtoReflect.Descriptor instead.
+func (*DecryptResponse) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DecryptResponse) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+func (x *DecryptResponse) GetPlaintextCrc32C() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.PlaintextCrc32C
+	}
+	return nil
+}
+
+func (x *DecryptResponse) GetUsedPrimary() bool {
+	if x != nil {
+		return x.UsedPrimary
+	}
+	return false
+}
+
+func (x *DecryptResponse) GetProtectionLevel() ProtectionLevel {
+	if x != nil {
+		return x.ProtectionLevel
+	}
+	return ProtectionLevel_PROTECTION_LEVEL_UNSPECIFIED
+}
+
+type Digest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Digest:
+	//
+	//	*Digest_Sha256
+	//	*Digest_Sha384
+	//	*Digest_Sha512
+	Digest        isDigest_Digest `protobuf_oneof:"digest"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Digest) Reset() {
+	*x = Digest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Digest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Digest) ProtoMessage() {}
+
+func (x *Digest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Digest.ProtoReflect.Descriptor instead.
+func (*Digest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Digest) GetDigest() isDigest_Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *Digest) GetSha256() []byte {
+	if x != nil {
+		if x, ok := x.Digest.(*Digest_Sha256); ok {
+			return x.Sha256
+		}
+	}
+	return nil
+}
+
+func (x *Digest) GetSha384() []byte {
+	if x != nil {
+		if x, ok := x.Digest.(*Digest_Sha384); ok {
+			return x.Sha384
+		}
+	}
+	return nil
+}
+
+func (x *Digest) GetSha512() []byte {
+	if x != nil {
+		if x, ok := x.Digest.(*Digest_Sha512); ok {
+			return x.Sha512
+		}
+	}
+	return nil
+}
+
+type isDigest_Digest interface {
+	isDigest_Digest()
+}
+
+type Digest_Sha256 struct {
+	Sha256 []byte `protobuf:"bytes,1,opt,name=sha256,proto3,oneof"`
+}
+
+type Digest_Sha384 struct {
+	Sha384 []byte `protobuf:"bytes,2,opt,name=sha384,proto3,oneof"`
+}
+
+type Digest_Sha512 struct {
+	Sha512 []byte `protobuf:"bytes,3,opt,name=sha512,proto3,oneof"`
+}
+
+func (*Digest_Sha256) isDigest_Digest() {}
+
+func (*Digest_Sha384) isDigest_Digest() {}
+
+func (*Digest_Sha512) isDigest_Digest() {}
+
+type AsymmetricSignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A CryptoKeyVersion.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Exactly one of digest or data; EC_SIGN_ED25519 requires data.
+	Digest        *Digest                `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	DigestCrc32C  *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=digest_crc32c,json=digestCrc32c,proto3" json:"digest_crc32c,omitempty"`
+	Data          []byte                 `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	DataCrc32C    *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=data_crc32c,json=dataCrc32c,proto3" json:"data_crc32c,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AsymmetricSignRequest) Reset() {
+	*x = AsymmetricSignRequest{}
+	mi := &file_v1_kms_kms_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AsymmetricSignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsymmetricSignRequest) ProtoMessage() {}
+
+func (x *AsymmetricSignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsymmetricSignRequest.ProtoReflect.Descriptor instead.
+func (*AsymmetricSignRequest) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AsymmetricSignRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AsymmetricSignRequest) GetDigest() *Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *AsymmetricSignRequest) GetDigestCrc32C() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.DigestCrc32C
+	}
+	return nil
+}
+
+func (x *AsymmetricSignRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AsymmetricSignRequest) GetDataCrc32C() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.DataCrc32C
+	}
+	return nil
+}
+
+type AsymmetricSignResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Signature            []byte                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	SignatureCrc32C      *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=signature_crc32c,json=signatureCrc32c,proto3" json:"signature_crc32c,omitempty"`
+	VerifiedDigestCrc32C bool                   `protobuf:"varint,3,opt,name=verified_digest_crc32c,json=verifiedDigestCrc32c,proto3" json:"verified_digest_crc32c,omitempty"`
+	Name                 string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	VerifiedDataCrc32C   bool                   `protobuf:"varint,5,opt,name=verified_data_crc32c,json=verifiedDataCrc32c,proto3" json:"verified_data_crc32c,omitempty"`
+	ProtectionLevel      ProtectionLevel        `protobuf:"varint,6,opt,name=protection_level,json=protectionLevel,proto3,enum=google.cloud.kms.v1.ProtectionLevel" json:"protection_level,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AsymmetricSignResponse) Reset() {
+	*x = AsymmetricSignResponse{}
+	mi := &file_v1_kms_kms_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AsymmetricSignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsymmetricSignResponse) ProtoMessage() {}
+
+func (x *AsymmetricSignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_kms_kms_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsymmetricSignResponse.ProtoReflect.Descriptor instead.
+func (*AsymmetricSignResponse) Descriptor() ([]byte, []int) {
+	return file_v1_kms_kms_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AsymmetricSignResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *AsymmetricSignResponse) GetSignatureCrc32C() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.SignatureCrc32C
+	}
+	return nil
+}
+
+func (x *AsymmetricSignResponse) GetVerifiedDigestCrc32C() bool {
+	if x != nil {
+		return x.VerifiedDigestCrc32C
+	}
+	return false
+}
+
+func (x *AsymmetricSignResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AsymmetricSignResponse) GetVerifiedDataCrc32C() bool {
+	if x != nil {
+		return x.VerifiedDataCrc32C
+	}
+	return false
+}
+
+func (x *AsymmetricSignResponse) GetProtectionLevel() ProtectionLevel {
+	if x != nil {
+		return x.ProtectionLevel
+	}
+	return ProtectionLevel_PROTECTION_LEVEL_UNSPECIFIED
+}
+
+var File_v1_kms_kms_proto protoreflect.FileDescriptor
+
+const file_v1_kms_kms_proto_rawDesc = "" +
+	"\n" +
+	"\x10v1/kms/kms.proto\x12\x13google.cloud.kms.v1\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"Z\n" +
+	"\aKeyRing\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12;\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\xb7\x05\n" +
+	"\tCryptoKey\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12?\n" +
+	"\aprimary\x18\x02 \x01(\v2%.google.cloud.kms.v1.CryptoKeyVersionR\aprimary\x12I\n" +
+	"\apurpose\x18\x03 \x01(\x0e2/.google.cloud.kms.v1.CryptoKey.CryptoKeyPurposeR\apurpose\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12X\n" +
+	"\x10version_template\x18\v \x01(\v2-.google.cloud.kms.v1.CryptoKeyVersionTemplateR\x0fversionTemplate\x12B\n" +
+	"\x06labels\x18\n" +
+	" \x03(\v2*.google.cloud.kms.v1.CryptoKey.LabelsEntryR\x06labels\x12W\n" +
+	"\x1adestroy_scheduled_duration\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\x18destroyScheduledDuration\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9a\x01\n" +
+	"\x10CryptoKeyPurpose\x12\"\n" +
+	"\x1eCRYPTO_KEY_PURPOSE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fENCRYPT_DECRYPT\x10\x01\x12\x13\n" +
+	"\x0fASYMMETRIC_SIGN\x10\x05\x12\x16\n" +
+	"\x12ASYMMETRIC_DECRYPT\x10\x06\x12\x17\n" +
+	"\x13RAW_ENCRYPT_DECRYPT\x10\a\x12\a\n" +
+	"\x03MAC\x10\t\"\xca\x01\n" +
+	"\x18CryptoKeyVersionTemplate\x12O\n" +
+	"\x10protection_level\x18\x01 \x01(\x0e2$.google.cloud.kms.v1.ProtectionLevelR\x0fprotectionLevel\x12]\n" +
+	"\talgorithm\x18\x03 \x01(\x0e2?.google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionAlgorithmR\talgorithm\"\xc4\t\n" +
+	"\x10CryptoKeyVersion\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12Q\n" +
+	"\x05state\x18\x03 \x01(\x0e2;.google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionStateR\x05state\x12O\n" +
+	"\x10protection_level\x18\a \x01(\x0e2$.google.cloud.kms.v1.ProtectionLevelR\x0fprotectionLevel\x12]\n" +
+	"\talgorithm\x18\n" +
+	" \x01(\x0e2?.google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionAlgorithmR\talgorithm\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12?\n" +
+	"\rgenerate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\fgenerateTime\x12=\n" +
+	"\fdestroy_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vdestroyTime\x12H\n" +
+	"\x12destroy_event_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x10destroyEventTime\"\xa9\x03\n" +
+	"\x19CryptoKeyVersionAlgorithm\x12,\n" +
+	"(CRYPTO_KEY_VERSION_ALGORITHM_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bGOOGLE_SYMMETRIC_ENCRYPTION\x10\x01\x12\x1c\n" +
+	"\x18RSA_SIGN_PSS_2048_SHA256\x10\x02\x12\x1c\n" +
+	"\x18RSA_SIGN_PSS_3072_SHA256\x10\x03\x12\x1c\n" +
+	"\x18RSA_SIGN_PSS_4096_SHA256\x10\x04\x12\x1c\n" +
+	"\x18RSA_SIGN_PSS_4096_SHA512\x10\x0f\x12\x1e\n" +
+	"\x1aRSA_SIGN_PKCS1_2048_SHA256\x10\x05\x12\x1e\n" +
+	"\x1aRSA_SIGN_PKCS1_3072_SHA256\x10\x06\x12\x1e\n" +
+	"\x1aRSA_SIGN_PKCS1_4096_SHA256\x10\a\x12\x1e\n" +
+	"\x1aRSA_SIGN_PKCS1_4096_SHA512\x10\x10\x12\x17\n" +
+	"\x13EC_SIGN_P256_SHA256\x10\f\x12\x17\n" +
+	"\x13EC_SIGN_P384_SHA384\x10\r\x12\x13\n" +
+	"\x0fEC_SIGN_ED25519\x10(\"\x9a\x01\n" +
+	"\x15CryptoKeyVersionState\x12(\n" +
+	"$CRYPTO_KEY_VERSION_STATE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PENDING_GENERATION\x10\x05\x12\v\n" +
+	"\aENABLED\x10\x01\x12\f\n" +
+	"\bDISABLED\x10\x02\x12\r\n" +
+	"\tDESTROYED\x10\x03\x12\x15\n" +
+	"\x11DESTROY_SCHEDULED\x10\x04\"I\n" +
+	"\x14CryptoKeyVersionView\x12'\n" +
+	"#CRYPTO_KEY_VERSION_VIEW_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04FULL\x10\x01\"\x9d\x02\n" +
+	"\tPublicKey\x12\x10\n" +
+	"\x03pem\x18\x01 \x01(\tR\x03pem\x12]\n" +
+	"\talgorithm\x18\x02 \x01(\x0e2?.google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionAlgorithmR\talgorithm\x12:\n" +
+	"\n" +
+	"pem_crc32c\x18\x03 \x01(\v2\x1b.google.protobuf.Int64ValueR\tpemCrc32c\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12O\n" +
+	"\x10protection_level\x18\x05 \x01(\x0e2$.google.cloud.kms.v1.ProtectionLevelR\x0fprotectionLevel\"\x9c\x01\n" +
+	"\x13ListKeyRingsRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\"\xfd\x01\n" +
+	"\x15ListCryptoKeysRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12]\n" +
+	"\fversion_view\x18\x04 \x01(\x0e2:.google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionViewR\vversionView\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\"\xf5\x01\n" +
+	"\x1cListCryptoKeyVersionsRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12N\n" +
+	"\x04view\x18\x04 \x01(\x0e2:.google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionViewR\x04view\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\"\x98\x01\n" +
+	"\x14ListKeyRingsResponse\x129\n" +
+	"\tkey_rings\x18\x01 \x03(\v2\x1c.google.cloud.kms.v1.KeyRingR\bkeyRings\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xa0\x01\n" +
+	"\x16ListCryptoKeysResponse\x12?\n" +
+	"\vcrypto_keys\x18\x01 \x03(\v2\x1e.google.cloud.kms.v1.CryptoKeyR\n" +
+	"cryptoKeys\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xbd\x01\n" +
+	"\x1dListCryptoKeyVersionsResponse\x12U\n" +
+	"\x13crypto_key_versions\x18\x01 \x03(\v2%.google.cloud.kms.v1.CryptoKeyVersionR\x11cryptoKeyVersions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"'\n" +
+	"\x11GetKeyRingRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\")\n" +
+	"\x13GetCryptoKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"0\n" +
+	"\x1aGetCryptoKeyVersionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\")\n" +
+	"\x13GetPublicKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x87\x01\n" +
+	"\x14CreateKeyRingRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\x1e\n" +
+	"\vkey_ring_id\x18\x02 \x01(\tR\tkeyRingId\x127\n" +
+	"\bkey_ring\x18\x03 \x01(\v2\x1c.google.cloud.kms.v1.KeyRingR\akeyRing\"\xd6\x01\n" +
+	"\x16CreateCryptoKeyRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12\"\n" +
+	"\rcrypto_key_id\x18\x02 \x01(\tR\vcryptoKeyId\x12=\n" +
+	"\n" +
+	"crypto_key\x18\x03 \x01(\v2\x1e.google.cloud.kms.v1.CryptoKeyR\tcryptoKey\x12A\n" +
+	"\x1dskip_initial_version_creation\x18\x05 \x01(\bR\x1askipInitialVersionCreation\"\x8c\x01\n" +
+	"\x1dCreateCryptoKeyVersionRequest\x12\x16\n" +
+	"\x06parent\x18\x01 \x01(\tR\x06parent\x12S\n" +
+	"\x12crypto_key_version\x18\x02 \x01(\v2%.google.cloud.kms.v1.CryptoKeyVersionR\x10cryptoKeyVersion\"\xb1\x01\n" +
+	"\x1dUpdateCryptoKeyVersionRequest\x12S\n" +
+	"\x12crypto_key_version\x18\x01 \x01(\v2%.google.cloud.kms.v1.CryptoKeyVersionR\x10cryptoKeyVersion\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"m\n" +
+	"$UpdateCryptoKeyPrimaryVersionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x121\n" +
+	"\x15crypto_key_version_id\x18\x02 \x01(\tR\x12cryptoKeyVersionId\"4\n" +
+	"\x1eDestroyCryptoKeyVersionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"4\n" +
+	"\x1eRestoreCryptoKeyVersionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xbc\x02\n" +
+	"\x0eEncryptRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tplaintext\x18\x02 \x01(\fR\tplaintext\x12B\n" +
+	"\x1dadditional_authenticated_data\x18\x03 \x01(\fR\x1badditionalAuthenticatedData\x12F\n" +
+	"\x10plaintext_crc32c\x18\a \x01(\v2\x1b.google.protobuf.Int64ValueR\x0fplaintextCrc32c\x12l\n" +
+	"$additional_authenticated_data_crc32c\x18\b \x01(\v2\x1b.google.protobuf.Int64ValueR!additionalAuthenticatedDataCrc32c\"\xfe\x02\n" +
+	"\x0fEncryptResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x02 \x01(\fR\n" +
+	"ciphertext\x12H\n" +
+	"\x11ciphertext_crc32c\x18\x04 \x01(\v2\x1b.google.protobuf.Int64ValueR\x10ciphertextCrc32c\x12:\n" +
+	"\x19verified_plaintext_crc32c\x18\x05 \x01(\bR\x17verifiedPlaintextCrc32c\x12`\n" +
+	"-verified_additional_authenticated_data_crc32c\x18\x06 \x01(\bR)verifiedAdditionalAuthenticatedDataCrc32c\x12O\n" +
+	"\x10protection_level\x18\a \x01(\x0e2$.google.cloud.kms.v1.ProtectionLevelR\x0fprotectionLevel\"\xc0\x02\n" +
+	"\x0eDecryptRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x02 \x01(\fR\n" +
+	"ciphertext\x12B\n" +
+	"\x1dadditional_authenticated_data\x18\x03 \x01(\fR\x1badditionalAuthenticatedData\x12H\n" +
+	"\x11ciphertext_crc32c\x18\x05 \x01(\v2\x1b.google.protobuf.Int64ValueR\x10ciphertextCrc32c\x12l\n" +
+	"$additional_authenticated_data_crc32c\x18\x06 \x01(\v2\x1b.google.protobuf.Int64ValueR!additionalAuthenticatedDataCrc32c\"\xeb\x01\n" +
+	"\x0fDecryptResponse\x12\x1c\n" +
+	"\tplaintext\x18\x01 \x01(\fR\tplaintext\x12F\n" +
+	"\x10plaintext_crc32c\x18\x02 \x01(\v2\x1b.google.protobuf.Int64ValueR\x0fplaintextCrc32c\x12!\n" +
+	"\fused_primary\x18\x03 \x01(\bR\vusedPrimary\x12O\n" +
+	"\x10protection_level\x18\x04 \x01(\x0e2$.google.cloud.kms.v1.ProtectionLevelR\x0fprotectionLevel\"`\n" +
+	"\x06Digest\x12\x18\n" +
+	"\x06sha256\x18\x01 \x01(\fH\x00R\x06sha256\x12\x18\n" +
+	"\x06sha384\x18\x02 \x01(\fH\x00R\x06sha384\x12\x18\n" +
+	"\x06sha512\x18\x03 \x01(\fH\x00R\x06sha512B\b\n" +
+	"\x06digest\"\xf4\x01\n" +
+	"\x15AsymmetricSignRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x123\n" +
+	"\x06digest\x18\x03 \x01(\v2\x1b.google.cloud.kms.v1.DigestR\x06digest\x12@\n" +
+	"\rdigest_crc32c\x18\x04 \x01(\v2\x1b.google.protobuf.Int64ValueR\fdigestCrc32c\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\x12<\n" +
+	"\vdata_crc32c\x18\a \x01(\v2\x1b.google.protobuf.Int64ValueR\n" +
+	"dataCrc32c\"\xcb\x02\n" +
+	"\x16AsymmetricSignResponse\x12\x1c\n" +
+	"\tsignature\x18\x01 \x01(\fR\tsignature\x12F\n" +
+	"\x10signature_crc32c\x18\x02 \x01(\v2\x1b.google.protobuf.Int64ValueR\x0fsignatureCrc32c\x124\n" +
+	"\x16verified_digest_crc32c\x18\x03 \x01(\bR\x14verifiedDigestCrc32c\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x120\n" +
+	"\x14verified_data_crc32c\x18\x05 \x01(\bR\x12verifiedDataCrc32c\x12O\n" +
+	"\x10protection_level\x18\x06 \x01(\x0e2$.google.cloud.kms.v1.ProtectionLevelR\x0fprotectionLevel*j\n" +
+	"\x0fProtectionLevel\x12 \n" +
+	"\x1cPROTECTION_LEVEL_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bSOFTWARE\x10\x01\x12\a\n" +
+	"\x03HSM\x10\x02\x12\f\n" +
+	"\bEXTERNAL\x10\x03\x12\x10\n" +
+	"\fEXTERNAL_VPC\x10\x042\x82\x0e\n" +
+	"\x14KeyManagementService\x12c\n" +
+	"\fListKeyRings\x12(.google.cloud.kms.v1.ListKeyRingsRequest\x1a).google.cloud.kms.v1.ListKeyRingsResponse\x12i\n" +
+	"\x0eListCryptoKeys\x12*.google.cloud.kms.v1.ListCryptoKeysRequest\x1a+.google.cloud.kms.v1.ListCryptoKeysResponse\x12~\n" +
+	"\x15ListCryptoKeyVersions\x121.google.cloud.kms.v1.ListCryptoKeyVersionsRequest\x1a2.google.cloud.kms.v1.ListCryptoKeyVersionsResponse\x12R\n" +
+	"\n" +
+	"GetKeyRing\x12&.google.cloud.kms.v1.GetKeyRingRequest\x1a\x1c.google.cloud.kms.v1.KeyRing\x12X\n" +
+	"\fGetCryptoKey\x12(.google.cloud.kms.v1.GetCryptoKeyRequest\x1a\x1e.google.cloud.kms.v1.CryptoKey\x12m\n" +
+	"\x13GetCryptoKeyVersion\x12/.google.cloud.kms.v1.GetCryptoKeyVersionRequest\x1a%.google.cloud.kms.v1.CryptoKeyVersion\x12X\n" +
+	"\fGetPublicKey\x12(.google.cloud.kms.v1.GetPublicKeyRequest\x1a\x1e.google.cloud.kms.v1.PublicKey\x12X\n" +
+	"\rCreateKeyRing\x12).google.cloud.kms.v1.CreateKeyRingRequest\x1a\x1c.google.cloud.kms.v1.KeyRing\x12^\n" +
+	"\x0fCreateCryptoKey\x12+.google.cloud.kms.v1.CreateCryptoKeyRequest\x1a\x1e.google.cloud.kms.v1.CryptoKey\x12s\n" +
+	"\x16CreateCryptoKeyVersion\x122.google.cloud.kms.v1.CreateCryptoKeyVersionRequest\x1a%.google.cloud.kms.v1.CryptoKeyVersion\x12s\n" +
+	"\x16UpdateCryptoKeyVersion\x122.google.cloud.kms.v1.UpdateCryptoKeyVersionRequest\x1a%.google.cloud.kms.v1.CryptoKeyVersion\x12z\n" +
+	"\x1dUpdateCryptoKeyPrimaryVersion\x129.google.cloud.kms.v1.UpdateCryptoKeyPrimaryVersionRequest\x1a\x1e.google.cloud.kms.v1.CryptoKey\x12u\n" +
+	"\x17DestroyCryptoKeyVersion\x123.google.cloud.kms.v1.DestroyCryptoKeyVersionRequest\x1a%.google.cloud.kms.v1.CryptoKeyVersion\x12u\n" +
+	"\x17RestoreCryptoKeyVersion\x123.google.cloud.kms.v1.RestoreCryptoKeyVersionRequest\x1a%.google.cloud.kms.v1.CryptoKeyVersion\x12T\n" +
+	"\aEncrypt\x12#.google.cloud.kms.v1.EncryptRequest\x1a$.google.cloud.kms.v1.EncryptResponse\x12T\n" +
+	"\aDecrypt\x12#.google.cloud.kms.v1.DecryptRequest\x1a$.google.cloud.kms.v1.DecryptResponse\x12i\n" +
+	"\x0eAsymmetricSign\x12*.google.cloud.kms.v1.AsymmetricSignRequest\x1a+.google.cloud.kms.v1.AsymmetricSignResponseB#Z!OlympusGCP-Vault/gen/v1/kms;kmsv1b\x06proto3"
+
+var (
+	file_v1_kms_kms_proto_rawDescOnce sync.Once
+	file_v1_kms_kms_proto_rawDescData []byte
+)
+
+func file_v1_kms_kms_proto_rawDescGZIP() []byte {
+	file_v1_kms_kms_proto_rawDescOnce.Do(func() {
+		file_v1_kms_kms_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_kms_kms_proto_rawDesc), len(file_v1_kms_kms_proto_rawDesc)))
+	})
+	return file_v1_kms_kms_proto_rawDescData
+}
+
+var file_v1_kms_kms_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_v1_kms_kms_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_v1_kms_kms_proto_goTypes = []any{
+	(ProtectionLevel)(0),                            // 0: google.cloud.kms.v1.ProtectionLevel
+	(CryptoKey_CryptoKeyPurpose)(0),                 // 1: google.cloud.kms.v1.CryptoKey.CryptoKeyPurpose
+	(CryptoKeyVersion_CryptoKeyVersionAlgorithm)(0), // 2: google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionAlgorithm
+	(CryptoKeyVersion_CryptoKeyVersionState)(0),     // 3: google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionState
+	(CryptoKeyVersion_CryptoKeyVersionView)(0),      // 4: google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionView
+	(*KeyRing)(nil),                                 // 5: google.cloud.kms.v1.KeyRing
+	(*CryptoKey)(nil),                               // 6: google.cloud.kms.v1.CryptoKey
+	(*CryptoKeyVersionTemplate)(nil),                // 7: google.cloud.kms.v1.CryptoKeyVersionTemplate
+	(*CryptoKeyVersion)(nil),                        // 8: google.cloud.kms.v1.CryptoKeyVersion
+	(*PublicKey)(nil),                               // 9: google.cloud.kms.v1.PublicKey
+	(*ListKeyRingsRequest)(nil),                     // 10: google.cloud.kms.v1.ListKeyRingsRequest
+	(*ListCryptoKeysRequest)(nil),                   // 11: google.cloud.kms.v1.ListCryptoKeysRequest
+	(*ListCryptoKeyVersionsRequest)(nil),            // 12: google.cloud.kms.v1.ListCryptoKeyVersionsRequest
+	(*ListKeyRingsResponse)(nil),                    // 13: google.cloud.kms.v1.ListKeyRingsResponse
+	(*ListCryptoKeysResponse)(nil),                  // 14: google.cloud.kms.v1.ListCryptoKeysResponse
+	(*ListCryptoKeyVersionsResponse)(nil),           // 15: google.cloud.kms.v1.ListCryptoKeyVersionsResponse
+	(*GetKeyRingRequest)(nil),                       // 16: google.cloud.kms.v1.GetKeyRingRequest
+	(*GetCryptoKeyRequest)(nil),                     // 17: google.cloud.kms.v1.GetCryptoKeyRequest
+	(*GetCryptoKeyVersionRequest)(nil),              // 18: google.cloud.kms.v1.GetCryptoKeyVersionRequest
+	(*GetPublicKeyRequest)(nil),                     // 19: google.cloud.kms.v1.GetPublicKeyRequest
+	(*CreateKeyRingRequest)(nil),                    // 20: google.cloud.kms.v1.CreateKeyRingRequest
+	(*CreateCryptoKeyRequest)(nil),                  // 21: google.cloud.kms.v1.CreateCryptoKeyRequest
+	(*CreateCryptoKeyVersionRequest)(nil),           // 22: google.cloud.kms.v1.CreateCryptoKeyVersionRequest
+	(*UpdateCryptoKeyVersionRequest)(nil),           // 23: google.cloud.kms.v1.UpdateCryptoKeyVersionRequest
+	(*UpdateCryptoKeyPrimaryVersionRequest)(nil),    // 24: google.cloud.kms.v1.UpdateCryptoKeyPrimaryVersionRequest
+	(*DestroyCryptoKeyVersionRequest)(nil),          // 25: google.cloud.kms.v1.DestroyCryptoKeyVersionRequest
+	(*RestoreCryptoKeyVersionRequest)(nil),          // 26: google.cloud.kms.v1.RestoreCryptoKeyVersionRequest
+	(*EncryptRequest)(nil),                          // 27: google.cloud.kms.v1.EncryptRequest
+	(*EncryptResponse)(nil),                         // 28: google.cloud.kms.v1.EncryptResponse
+	(*DecryptRequest)(nil),                          // 29: google.cloud.kms.v1.DecryptRequest
+	(*DecryptResponse)(nil),                         // 30: google.cloud.kms.v1.DecryptResponse
+	(*Digest)(nil),                                  // 31: google.cloud.kms.v1.Digest
+	(*AsymmetricSignRequest)(nil),                   // 32: google.cloud.kms.v1.AsymmetricSignRequest
+	(*AsymmetricSignResponse)(nil),                  // 33: google.cloud.kms.v1.AsymmetricSignResponse
+	nil,                                             // 34: google.cloud.kms.v1.CryptoKey.LabelsEntry
+	(*timestamppb.Timestamp)(nil),                   // 35: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                     // 36: google.protobuf.Duration
+	(*wrapperspb.Int64Value)(nil),                   // 37: google.protobuf.Int64Value
+	(*fieldmaskpb.FieldMask)(nil),                   // 38: google.protobuf.FieldMask
+}
+var file_v1_kms_kms_proto_depIdxs = []int32{
+	35, // 0: google.cloud.kms.v1.KeyRing.create_time:type_name -> google.protobuf.Timestamp
+	8,  // 1: google.cloud.kms.v1.CryptoKey.primary:type_name -> google.cloud.kms.v1.CryptoKeyVersion
+	1,  // 2: google.cloud.kms.v1.CryptoKey.purpose:type_name -> google.cloud.kms.v1.CryptoKey.CryptoKeyPurpose
+	35, // 3: google.cloud.kms.v1.CryptoKey.create_time:type_name -> google.protobuf.Timestamp
+	7,  // 4: google.cloud.kms.v1.CryptoKey.version_template:type_name -> google.cloud.kms.v1.CryptoKeyVersionTemplate
+	34, // 5: google.cloud.kms.v1.CryptoKey.labels:type_name -> google.cloud.kms.v1.CryptoKey.LabelsEntry
+	36, // 6: google.cloud.kms.v1.CryptoKey.destroy_scheduled_duration:type_name -> google.protobuf.Duration
+	0,  // 7: google.cloud.kms.v1.CryptoKeyVersionTemplate.protection_level:type_name -> google.cloud.kms.v1.ProtectionLevel
+	2,  // 8: google.cloud.kms.v1.CryptoKeyVersionTemplate.algorithm:type_name -> google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionAlgorithm
+	3,  // 9: google.cloud.kms.v1.CryptoKeyVersion.state:type_name -> google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionState
+	0,  // 10: google.cloud.kms.v1.CryptoKeyVersion.protection_level:type_name -> google.cloud.kms.v1.ProtectionLevel
+	2,  // 11: google.cloud.kms.v1.CryptoKeyVersion.algorithm:type_name -> google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionAlgorithm
+	35, // 12: google.cloud.kms.v1.CryptoKeyVersion.create_time:type_name -> google.protobuf.Timestamp
+	35, // 13: google.cloud.kms.v1.CryptoKeyVersion.generate_time:type_name -> google.protobuf.Timestamp
+	35, // 14: google.cloud.kms.v1.CryptoKeyVersion.destroy_time:type_name -> google.protobuf.Timestamp
+	35, // 15: google.cloud.kms.v1.CryptoKeyVersion.destroy_event_time:type_name -> google.protobuf.Timestamp
+	2,  // 16: google.cloud.kms.v1.PublicKey.algorithm:type_name -> google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionAlgorithm
+	37, // 17: google.cloud.kms.v1.PublicKey.pem_crc32c:type_name -> google.protobuf.Int64Value
+	0,  // 18: google.cloud.kms.v1.PublicKey.protection_level:type_name -> google.cloud.kms.v1.ProtectionLevel
+	4,  // 19: google.cloud.kms.v1.ListCryptoKeysRequest.version_view:type_name -> google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionView
+	4,  // 20: google.cloud.kms.v1.ListCryptoKeyVersionsRequest.view:type_name -> google.cloud.kms.v1.CryptoKeyVersion.CryptoKeyVersionView
+	5,  // 21: google.cloud.kms.v1.ListKeyRingsResponse.key_rings:type_name -> google.cloud.kms.v1.KeyRing
+	6,  // 22: google.cloud.kms.v1.ListCryptoKeysResponse.crypto_keys:type_name -> google.cloud.kms.v1.CryptoKey
+	8,  // 23: google.cloud.kms.v1.ListCryptoKeyVersionsResponse.crypto_key_versions:type_name -> google.cloud.kms.v1.CryptoKeyVersion
+	5,  // 24: google.cloud.kms.v1.CreateKeyRingRequest.key_ring:type_name -> google.cloud.kms.v1.KeyRing
+	6,  // 25: google.cloud.kms.v1.CreateCryptoKeyRequest.crypto_key:type_name -> google.cloud.kms.v1.CryptoKey
+	8,  // 26: google.cloud.kms.v1.CreateCryptoKeyVersionRequest.crypto_key_version:type_name -> google.cloud.kms.v1.CryptoKeyVersion
+	8,  // 27: google.cloud.kms.v1.UpdateCryptoKeyVersionRequest.crypto_key_version:type_name -> google.cloud.kms.v1.CryptoKeyVersion
+	38, // 28: google.cloud.kms.v1.UpdateCryptoKeyVersionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	37, // 29: google.cloud.kms.v1.EncryptRequest.plaintext_crc32c:type_name -> google.protobuf.Int64Value
+	37, // 30: google.cloud.kms.v1.EncryptRequest.additional_authenticated_data_crc32c:type_name -> google.protobuf.Int64Value
+	37, // 31: google.cloud.kms.v1.EncryptResponse.ciphertext_crc32c:type_name -> google.protobuf.Int64Value
+	0,  // 32: google.cloud.kms.v1.EncryptResponse.protection_level:type_name -> google.cloud.kms.v1.ProtectionLevel
+	37, // 33: google.cloud.kms.v1.DecryptRequest.ciphertext_crc32c:type_name -> google.protobuf.Int64Value
+	37, // 34: google.cloud.kms.v1.DecryptRequest.additional_authenticated_data_crc32c:type_name -> google.protobuf.Int64Value
+	37, // 35: google.cloud.kms.v1.DecryptResponse.plaintext_crc32c:type_name -> google.protobuf.Int64Value
+	0,  // 36: google.cloud.kms.v1.DecryptResponse.protection_level:type_name -> google.cloud.kms.v1.ProtectionLevel
+	31, // 37: google.cloud.kms.v1.AsymmetricSignRequest.digest:type_name -> google.cloud.kms.v1.Digest
+	37, // 38: google.cloud.kms.v1.AsymmetricSignRequest.digest_crc32c:type_name -> google.protobuf.Int64Value
+	37, // 39: google.cloud.kms.v1.AsymmetricSignRequest.data_crc32c:type_name -> google.protobuf.Int64Value
+	37, // 40: google.cloud.kms.v1.AsymmetricSignResponse.signature_crc32c:type_name -> google.protobuf.Int64Value
+	0,  // 41: google.cloud.kms.v1.AsymmetricSignResponse.protection_level:type_name -> google.cloud.kms.v1.ProtectionLevel
+	10, // 42: google.cloud.kms.v1.KeyManagementService.ListKeyRings:input_type -> google.cloud.kms.v1.ListKeyRingsRequest
+	11, // 43: google.cloud.kms.v1.KeyManagementService.ListCryptoKeys:input_type -> google.cloud.kms.v1.ListCryptoKeysRequest
+	12, // 44: google.cloud.kms.v1.KeyManagementService.ListCryptoKeyVersions:input_type -> google.cloud.kms.v1.ListCryptoKeyVersionsRequest
+	16, // 45: google.cloud.kms.v1.KeyManagementService.GetKeyRing:input_type -> google.cloud.kms.v1.GetKeyRingRequest
+	17, // 46: google.cloud.kms.v1.KeyManagementService.GetCryptoKey:input_type -> google.cloud.kms.v1.GetCryptoKeyRequest
+	18, // 47: google.cloud.kms.v1.KeyManagementService.GetCryptoKeyVersion:input_type -> google.cloud.kms.v1.GetCryptoKeyVersionRequest
+	19, // 48: google.cloud.kms.v1.KeyManagementService.GetPublicKey:input_type -> google.cloud.kms.v1.GetPublicKeyRequest
+	20, // 49: google.cloud.kms.v1.KeyManagementService.CreateKeyRing:input_type -> google.cloud.kms.v1.CreateKeyRingRequest
+	21, // 50: google.cloud.kms.v1.KeyManagementService.CreateCryptoKey:input_type -> google.cloud.kms.v1.CreateCryptoKeyRequest
+	22, // 51: google.cloud.kms.v1.KeyManagementService.CreateCryptoKeyVersion:input_type -> google.cloud.kms.v1.CreateCryptoKeyVersionRequest
+	23, // 52: google.cloud.kms.v1.KeyManagementService.UpdateCryptoKeyVersion:input_type -> google.cloud.kms.v1.UpdateCryptoKeyVersionRequest
+	24, // 53: google.cloud.kms.v1.KeyManagementService.UpdateCryptoKeyPrimaryVersion:input_type -> google.cloud.kms.v1.UpdateCryptoKeyPrimaryVersionRequest
+	25, // 54: google.cloud.kms.v1.KeyManagementService.DestroyCryptoKeyVersion:input_type -> google.cloud.kms.v1.DestroyCryptoKeyVersionRequest
+	26, // 55: google.cloud.kms.v1.KeyManagementService.RestoreCryptoKeyVersion:input_type -> google.cloud.kms.v1.RestoreCryptoKeyVersionRequest
+	27, // 56: google.cloud.kms.v1.KeyManagementService.Encrypt:input_type -> google.cloud.kms.v1.EncryptRequest
+	29, // 57: google.cloud.kms.v1.KeyManagementService.Decrypt:input_type -> google.cloud.kms.v1.DecryptRequest
+	32, // 58: google.cloud.kms.v1.KeyManagementService.AsymmetricSign:input_type -> google.cloud.kms.v1.AsymmetricSignRequest
+	13, // 59: google.cloud.kms.v1.KeyManagementService.ListKeyRings:output_type -> google.cloud.kms.v1.ListKeyRingsResponse
+	14, // 60: google.cloud.kms.v1.KeyManagementService.ListCryptoKeys:output_type -> google.cloud.kms.v1.ListCryptoKeysResponse
+	15, // 61: google.cloud.kms.v1.KeyManagementService.ListCryptoKeyVersions:output_type -> google.cloud.kms.v1.ListCryptoKeyVersionsResponse
+	5,  // 62: google.cloud.kms.v1.KeyManagementService.GetKeyRing:output_type -> google.cloud.kms.v1.KeyRing
+	6,  // 63: google.cloud.kms.v1.KeyManagementService.GetCryptoKey:output_type -> google.cloud.kms.v1.CryptoKey
+	8,  // 64: google.cloud.kms.v1.KeyManagementService.GetCryptoKeyVersion:output_type -> google.cloud.kms.v1.CryptoKeyVersion
+	9,  // 65: google.cloud.kms.v1.KeyManagementService.GetPublicKey:output_type -> google.cloud.kms.v1.PublicKey
+	5,  // 66: google.cloud.kms.v1.KeyManagementService.CreateKeyRing:output_type -> google.cloud.kms.v1.KeyRing
+	6,  // 67: google.cloud.kms.v1.KeyManagementService.CreateCryptoKey:output_type -> google.cloud.kms.v1.CryptoKey
+	8,  // 68: google.cloud.kms.v1.KeyManagementService.CreateCryptoKeyVersion:output_type -> google.cloud.kms.v1.CryptoKeyVersion
+	8,  // 69: google.cloud.kms.v1.KeyManagementService.UpdateCryptoKeyVersion:output_type -> google.cloud.kms.v1.CryptoKeyVersion
+	6,  // 70: google.cloud.kms.v1.KeyManagementService.UpdateCryptoKeyPrimaryVersion:output_type -> google.cloud.kms.v1.CryptoKey
+	8,  // 71: google.cloud.kms.v1.KeyManagementService.DestroyCryptoKeyVersion:output_type -> google.cloud.kms.v1.CryptoKeyVersion
+	8,  // 72: google.cloud.kms.v1.KeyManagementService.RestoreCryptoKeyVersion:output_type -> google.cloud.kms.v1.CryptoKeyVersion
+	28, // 73: google.cloud.kms.v1.KeyManagementService.Encrypt:output_type -> google.cloud.kms.v1.EncryptResponse
+	30, // 74: google.cloud.kms.v1.KeyManagementService.Decrypt:output_type -> google.cloud.kms.v1.DecryptResponse
+	33, // 75: google.cloud.kms.v1.KeyManagementService.AsymmetricSign:output_type -> google.cloud.kms.v1.AsymmetricSignResponse
+	59, // [59:76] is the sub-list for method output_type
+	42, // [42:59] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_v1_kms_kms_proto_init() }
+func file_v1_kms_kms_proto_init() {
+	if File_v1_kms_kms_proto != nil {
+		return
+	}
+	file_v1_kms_kms_proto_msgTypes[26].OneofWrappers = []any{
+		(*Digest_Sha256)(nil),
+		(*Digest_Sha384)(nil),
+		(*Digest_Sha512)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_kms_kms_proto_rawDesc), len(file_v1_kms_kms_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_kms_kms_proto_goTypes,
+		DependencyIndexes: file_v1_kms_kms_proto_depIdxs,
+		EnumInfos:         file_v1_kms_kms_proto_enumTypes,
+		MessageInfos:      file_v1_kms_kms_proto_msgTypes,
+	}.Build()
+	File_v1_kms_kms_proto = out.File
+	file_v1_kms_kms_proto_goTypes = nil
+	file_v1_kms_kms_proto_depIdxs = nil
+}
@@ -0,0 +1,579 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: v1/kms/kms.proto
+
+// The essential surface of the Cloud KMS API. Package, service, method and
+// field numbers match google/cloud/kms/v1 so the Cloud KMS client libraries
+// can talk to VaultManager over gRPC; fields the emulator does not
+// implement are left out and ignored on the wire.
+package kmsv1connect
+
+import (
+	kms "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/kms"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// KeyManagementServiceName is the fully-qualified name of the KeyManagementService service.
+	KeyManagementServiceName = "google.cloud.kms.v1.KeyManagementService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// KeyManagementServiceListKeyRingsProcedure is the fully-qualified name of the
+	// KeyManagementService's ListKeyRings RPC.
+	KeyManagementServiceListKeyRingsProcedure = "/google.cloud.kms.v1.KeyManagementService/ListKeyRings"
+	// KeyManagementServiceListCryptoKeysProcedure is the fully-qualified name of the
+	// KeyManagementService's ListCryptoKeys RPC.
+	KeyManagementServiceListCryptoKeysProcedure = "/google.cloud.kms.v1.KeyManagementService/ListCryptoKeys"
+	// KeyManagementServiceListCryptoKeyVersionsProcedure is the fully-qualified name of the
+	// KeyManagementService's ListCryptoKeyVersions RPC.
+	KeyManagementServiceListCryptoKeyVersionsProcedure = "/google.cloud.kms.v1.KeyManagementService/ListCryptoKeyVersions"
+	// KeyManagementServiceGetKeyRingProcedure is the fully-qualified name of the KeyManagementService's
+	// GetKeyRing RPC.
+	KeyManagementServiceGetKeyRingProcedure = "/google.cloud.kms.v1.KeyManagementService/GetKeyRing"
+	// KeyManagementServiceGetCryptoKeyProcedure is the fully-qualified name of the
+	// KeyManagementService's GetCryptoKey RPC.
+	KeyManagementServiceGetCryptoKeyProcedure = "/google.cloud.kms.v1.KeyManagementService/GetCryptoKey"
+	// KeyManagementServiceGetCryptoKeyVersionProcedure is the fully-qualified name of the
+	// KeyManagementService's GetCryptoKeyVersion RPC.
+	KeyManagementServiceGetCryptoKeyVersionProcedure = "/google.cloud.kms.v1.KeyManagementService/GetCryptoKeyVersion"
+	// KeyManagementServiceGetPublicKeyProcedure is the fully-qualified name of the
+	// KeyManagementService's GetPublicKey RPC.
+	KeyManagementServiceGetPublicKeyProcedure = "/google.cloud.kms.v1.KeyManagementService/GetPublicKey"
+	// KeyManagementServiceCreateKeyRingProcedure is the fully-qualified name of the
+	// KeyManagementService's CreateKeyRing RPC.
+	KeyManagementServiceCreateKeyRingProcedure = "/google.cloud.kms.v1.KeyManagementService/CreateKeyRing"
+	// KeyManagementServiceCreateCryptoKeyProcedure is the fully-qualified name of the
+	// KeyManagementService's CreateCryptoKey RPC.
+	KeyManagementServiceCreateCryptoKeyProcedure = "/google.cloud.kms.v1.KeyManagementService/CreateCryptoKey"
+	// KeyManagementServiceCreateCryptoKeyVersionProcedure is the fully-qualified name of the
+	// KeyManagementService's CreateCryptoKeyVersion RPC.
+	KeyManagementServiceCreateCryptoKeyVersionProcedure = "/google.cloud.kms.v1.KeyManagementService/CreateCryptoKeyVersion"
+	// KeyManagementServiceUpdateCryptoKeyVersionProcedure is the fully-qualified name of the
+	// KeyManagementService's UpdateCryptoKeyVersion RPC.
+	KeyManagementServiceUpdateCryptoKeyVersionProcedure = "/google.cloud.kms.v1.KeyManagementService/UpdateCryptoKeyVersion"
+	// KeyManagementServiceUpdateCryptoKeyPrimaryVersionProcedure is the fully-qualified name of the
+	// KeyManagementService's UpdateCryptoKeyPrimaryVersion RPC.
+	KeyManagementServiceUpdateCryptoKeyPrimaryVersionProcedure = "/google.cloud.kms.v1.KeyManagementService/UpdateCryptoKeyPrimaryVersion"
+	// KeyManagementServiceDestroyCryptoKeyVersionProcedure is the fully-qualified name of the
+	// KeyManagementService's DestroyCryptoKeyVersion RPC.
+	KeyManagementServiceDestroyCryptoKeyVersionProcedure = "/google.cloud.kms.v1.KeyManagementService/DestroyCryptoKeyVersion"
+	// KeyManagementServiceRestoreCryptoKeyVersionProcedure is the fully-qualified name of the
+	// KeyManagementService's RestoreCryptoKeyVersion RPC.
+	KeyManagementServiceRestoreCryptoKeyVersionProcedure = "/google.cloud.kms.v1.KeyManagementService/RestoreCryptoKeyVersion"
+	// KeyManagementServiceEncryptProcedure is the fully-qualified name of the KeyManagementService's
+	// Encrypt RPC.
+	KeyManagementServiceEncryptProcedure = "/google.cloud.kms.v1.KeyManagementService/Encrypt"
+	// KeyManagementServiceDecryptProcedure is the fully-qualified name of the KeyManagementService's
+	// Decrypt RPC.
+	KeyManagementServiceDecryptProcedure = "/google.cloud.kms.v1.KeyManagementService/Decrypt"
+	// KeyManagementServiceAsymmetricSignProcedure is the fully-qualified name of the
+	// KeyManagementService's AsymmetricSign RPC.
+	KeyManagementServiceAsymmetricSignProcedure = "/google.cloud.kms.v1.KeyManagementService/AsymmetricSign"
+)
+
+// KeyManagementServiceClient is a client for the google.cloud.kms.v1.KeyManagementService service.
+type KeyManagementServiceClient interface {
+	ListKeyRings(context.Context, *connect.Request[kms.ListKeyRingsRequest]) (*connect.Response[kms.ListKeyRingsResponse], error)
+	ListCryptoKeys(context.Context, *connect.Request[kms.ListCryptoKeysRequest]) (*connect.Response[kms.ListCryptoKeysResponse], error)
+	ListCryptoKeyVersions(context.Context, *connect.Request[kms.ListCryptoKeyVersionsRequest]) (*connect.Response[kms.ListCryptoKeyVersionsResponse], error)
+	GetKeyRing(context.Context, *connect.Request[kms.GetKeyRingRequest]) (*connect.Response[kms.KeyRing], error)
+	GetCryptoKey(context.Context, *connect.Request[kms.GetCryptoKeyRequest]) (*connect.Response[kms.CryptoKey], error)
+	GetCryptoKeyVersion(context.Context, *connect.Request[kms.GetCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error)
+	GetPublicKey(context.Context, *connect.Request[kms.GetPublicKeyRequest]) (*connect.Response[kms.PublicKey], error)
+	CreateKeyRing(context.Context, *connect.Request[kms.CreateKeyRingRequest]) (*connect.Response[kms.KeyRing], error)
+	CreateCryptoKey(context.Context, *connect.Request[kms.CreateCryptoKeyRequest]) (*connect.Response[kms.CryptoKey], error)
+	CreateCryptoKeyVersion(context.Context, *connect.Request[kms.CreateCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error)
+	UpdateCryptoKeyVersion(context.Context, *connect.Request[kms.UpdateCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error)
+	UpdateCryptoKeyPrimaryVersion(context.Context, *connect.Request[kms.UpdateCryptoKeyPrimaryVersionRequest]) (*connect.Response[kms.CryptoKey], error)
+	DestroyCryptoKeyVersion(context.Context, *connect.Request[kms.DestroyCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error)
+	RestoreCryptoKeyVersion(context.Context, *connect.Request[kms.RestoreCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error)
+	Encrypt(context.Context, *connect.Request[kms.EncryptRequest]) (*connect.Response[kms.EncryptResponse], error)
+	Decrypt(context.Context, *connect.Request[kms.DecryptRequest]) (*connect.Response[kms.DecryptResponse], error)
+	AsymmetricSign(context.Context, *connect.Request[kms.AsymmetricSignRequest]) (*connect.Response[kms.AsymmetricSignResponse], error)
+}
+
+// NewKeyManagementServiceClient constructs a client for the
+// google.cloud.kms.v1.KeyManagementService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewKeyManagementServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) KeyManagementServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	keyManagementServiceMethods := kms.File_v1_kms_kms_proto.Services().ByName("KeyManagementService").Methods()
+	return &keyManagementServiceClient{
+		listKeyRings: connect.NewClient[kms.ListKeyRingsRequest, kms.ListKeyRingsResponse](
+			httpClient,
+			baseURL+KeyManagementServiceListKeyRingsProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("ListKeyRings")),
+			connect.WithClientOptions(opts...),
+		),
+		listCryptoKeys: connect.NewClient[kms.ListCryptoKeysRequest, kms.ListCryptoKeysResponse](
+			httpClient,
+			baseURL+KeyManagementServiceListCryptoKeysProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("ListCryptoKeys")),
+			connect.WithClientOptions(opts...),
+		),
+		listCryptoKeyVersions: connect.NewClient[kms.ListCryptoKeyVersionsRequest, kms.ListCryptoKeyVersionsResponse](
+			httpClient,
+			baseURL+KeyManagementServiceListCryptoKeyVersionsProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("ListCryptoKeyVersions")),
+			connect.WithClientOptions(opts...),
+		),
+		getKeyRing: connect.NewClient[kms.GetKeyRingRequest, kms.KeyRing](
+			httpClient,
+			baseURL+KeyManagementServiceGetKeyRingProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("GetKeyRing")),
+			connect.WithClientOptions(opts...),
+		),
+		getCryptoKey: connect.NewClient[kms.GetCryptoKeyRequest, kms.CryptoKey](
+			httpClient,
+			baseURL+KeyManagementServiceGetCryptoKeyProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("GetCryptoKey")),
+			connect.WithClientOptions(opts...),
+		),
+		getCryptoKeyVersion: connect.NewClient[kms.GetCryptoKeyVersionRequest, kms.CryptoKeyVersion](
+			httpClient,
+			baseURL+KeyManagementServiceGetCryptoKeyVersionProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("GetCryptoKeyVersion")),
+			connect.WithClientOptions(opts...),
+		),
+		getPublicKey: connect.NewClient[kms.GetPublicKeyRequest, kms.PublicKey](
+			httpClient,
+			baseURL+KeyManagementServiceGetPublicKeyProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("GetPublicKey")),
+			connect.WithClientOptions(opts...),
+		),
+		createKeyRing: connect.NewClient[kms.CreateKeyRingRequest, kms.KeyRing](
+			httpClient,
+			baseURL+KeyManagementServiceCreateKeyRingProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("CreateKeyRing")),
+			connect.WithClientOptions(opts...),
+		),
+		createCryptoKey: connect.NewClient[kms.CreateCryptoKeyRequest, kms.CryptoKey](
+			httpClient,
+			baseURL+KeyManagementServiceCreateCryptoKeyProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("CreateCryptoKey")),
+			connect.WithClientOptions(opts...),
+		),
+		createCryptoKeyVersion: connect.NewClient[kms.CreateCryptoKeyVersionRequest, kms.CryptoKeyVersion](
+			httpClient,
+			baseURL+KeyManagementServiceCreateCryptoKeyVersionProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("CreateCryptoKeyVersion")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCryptoKeyVersion: connect.NewClient[kms.UpdateCryptoKeyVersionRequest, kms.CryptoKeyVersion](
+			httpClient,
+			baseURL+KeyManagementServiceUpdateCryptoKeyVersionProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("UpdateCryptoKeyVersion")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCryptoKeyPrimaryVersion: connect.NewClient[kms.UpdateCryptoKeyPrimaryVersionRequest, kms.CryptoKey](
+			httpClient,
+			baseURL+KeyManagementServiceUpdateCryptoKeyPrimaryVersionProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("UpdateCryptoKeyPrimaryVersion")),
+			connect.WithClientOptions(opts...),
+		),
+		destroyCryptoKeyVersion: connect.NewClient[kms.DestroyCryptoKeyVersionRequest, kms.CryptoKeyVersion](
+			httpClient,
+			baseURL+KeyManagementServiceDestroyCryptoKeyVersionProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("DestroyCryptoKeyVersion")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreCryptoKeyVersion: connect.NewClient[kms.RestoreCryptoKeyVersionRequest, kms.CryptoKeyVersion](
+			httpClient,
+			baseURL+KeyManagementServiceRestoreCryptoKeyVersionProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("RestoreCryptoKeyVersion")),
+			connect.WithClientOptions(opts...),
+		),
+		encrypt: connect.NewClient[kms.EncryptRequest, kms.EncryptResponse](
+			httpClient,
+			baseURL+KeyManagementServiceEncryptProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("Encrypt")),
+			connect.WithClientOptions(opts...),
+		),
+		decrypt: connect.NewClient[kms.DecryptRequest, kms.DecryptResponse](
+			httpClient,
+			baseURL+KeyManagementServiceDecryptProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("Decrypt")),
+			connect.WithClientOptions(opts...),
+		),
+		asymmetricSign: connect.NewClient[kms.AsymmetricSignRequest, kms.AsymmetricSignResponse](
+			httpClient,
+			baseURL+KeyManagementServiceAsymmetricSignProcedure,
+			connect.WithSchema(keyManagementServiceMethods.ByName("AsymmetricSign")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// keyManagementServiceClient implements KeyManagementServiceClient.
+type keyManagementServiceClient struct {
+	listKeyRings                  *connect.Client[kms.ListKeyRingsRequest, kms.ListKeyRingsResponse]
+	listCryptoKeys                *connect.Client[kms.ListCryptoKeysRequest, kms.ListCryptoKeysResponse]
+	listCryptoKeyVersions         *connect.Client[kms.ListCryptoKeyVersionsRequest, kms.ListCryptoKeyVersionsResponse]
+	getKeyRing                    *connect.Client[kms.GetKeyRingRequest, kms.KeyRing]
+	getCryptoKey                  *connect.Client[kms.GetCryptoKeyRequest, kms.CryptoKey]
+	getCryptoKeyVersion           *connect.Client[kms.GetCryptoKeyVersionRequest, kms.CryptoKeyVersion]
+	getPublicKey                  *connect.Client[kms.GetPublicKeyRequest, kms.PublicKey]
+	createKeyRing                 *connect.Client[kms.CreateKeyRingRequest, kms.KeyRing]
+	createCryptoKey               *connect.Client[kms.CreateCryptoKeyRequest, kms.CryptoKey]
+	createCryptoKeyVersion        *connect.Client[kms.CreateCryptoKeyVersionRequest, kms.CryptoKeyVersion]
+	updateCryptoKeyVersion        *connect.Client[kms.UpdateCryptoKeyVersionRequest, kms.CryptoKeyVersion]
+	updateCryptoKeyPrimaryVersion *connect.Client[kms.UpdateCryptoKeyPrimaryVersionRequest, kms.CryptoKey]
+	destroyCryptoKeyVersion       *connect.Client[kms.DestroyCryptoKeyVersionRequest, kms.CryptoKeyVersion]
+	restoreCryptoKeyVersion       *connect.Client[kms.RestoreCryptoKeyVersionRequest, kms.CryptoKeyVersion]
+	encrypt                       *connect.Client[kms.EncryptRequest, kms.EncryptResponse]
+	decrypt                       *connect.Client[kms.DecryptRequest, kms.DecryptResponse]
+	asymmetricSign                *connect.Client[kms.AsymmetricSignRequest, kms.AsymmetricSignResponse]
+}
+
+// ListKeyRings calls google.cloud.kms.v1.KeyManagementService.ListKeyRings.
+func (c *keyManagementServiceClient) ListKeyRings(ctx context.Context, req *connect.Request[kms.ListKeyRingsRequest]) (*connect.Response[kms.ListKeyRingsResponse], error) {
+	return c.listKeyRings.CallUnary(ctx, req)
+}
+
+// ListCryptoKeys calls google.cloud.kms.v1.KeyManagementService.ListCryptoKeys.
+func (c *keyManagementServiceClient) ListCryptoKeys(ctx context.Context, req *connect.Request[kms.ListCryptoKeysRequest]) (*connect.Response[kms.ListCryptoKeysResponse], error) {
+	return c.listCryptoKeys.CallUnary(ctx, req)
+}
+
+// ListCryptoKeyVersions calls google.cloud.kms.v1.KeyManagementService.ListCryptoKeyVersions.
+func (c *keyManagementServiceClient) ListCryptoKeyVersions(ctx context.Context, req *connect.Request[kms.ListCryptoKeyVersionsRequest]) (*connect.Response[kms.ListCryptoKeyVersionsResponse], error) {
+	return c.listCryptoKeyVersions.CallUnary(ctx, req)
+}
+
+// GetKeyRing calls google.cloud.kms.v1.KeyManagementService.GetKeyRing.
+func (c *keyManagementServiceClient) GetKeyRing(ctx context.Context, req *connect.Request[kms.GetKeyRingRequest]) (*connect.Response[kms.KeyRing], error) {
+	return c.getKeyRing.CallUnary(ctx, req)
+}
+
+// GetCryptoKey calls google.cloud.kms.v1.KeyManagementService.GetCryptoKey.
+func (c *keyManagementServiceClient) GetCryptoKey(ctx context.Context, req *connect.Request[kms.GetCryptoKeyRequest]) (*connect.Response[kms.CryptoKey], error) {
+	return c.getCryptoKey.CallUnary(ctx, req)
+}
+
+// GetCryptoKeyVersion calls google.cloud.kms.v1.KeyManagementService.GetCryptoKeyVersion.
+func (c *keyManagementServiceClient) GetCryptoKeyVersion(ctx context.Context, req *connect.Request[kms.GetCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error) {
+	return c.getCryptoKeyVersion.CallUnary(ctx, req)
+}
+
+// GetPublicKey calls google.cloud.kms.v1.KeyManagementService.GetPublicKey.
+func (c *keyManagementServiceClient) GetPublicKey(ctx context.Context, req *connect.Request[kms.GetPublicKeyRequest]) (*connect.Response[kms.PublicKey], error) {
+	return c.getPublicKey.CallUnary(ctx, req)
+}
+
+// CreateKeyRing calls google.cloud.kms.v1.KeyManagementService.CreateKeyRing.
+func (c *keyManagementServiceClient) CreateKeyRing(ctx context.Context, req *connect.Request[kms.CreateKeyRingRequest]) (*connect.Response[kms.KeyRing], error) {
+	return c.createKeyRing.CallUnary(ctx, req)
+}
+
+// CreateCryptoKey calls google.cloud.kms.v1.KeyManagementService.CreateCryptoKey.
+func (c *keyManagementServiceClient) CreateCryptoKey(ctx context.Context, req *connect.Request[kms.CreateCryptoKeyRequest]) (*connect.Response[kms.CryptoKey], error) {
+	return c.createCryptoKey.CallUnary(ctx, req)
+}
+
+// CreateCryptoKeyVersion calls google.cloud.kms.v1.KeyManagementService.CreateCryptoKeyVersion.
+func (c *keyManagementServiceClient) CreateCryptoKeyVersion(ctx context.Context, req *connect.Request[kms.CreateCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error) {
+	return c.createCryptoKeyVersion.CallUnary(ctx, req)
+}
+
+// UpdateCryptoKeyVersion calls google.cloud.kms.v1.KeyManagementService.UpdateCryptoKeyVersion.
+func (c *keyManagementServiceClient) UpdateCryptoKeyVersion(ctx context.Context, req *connect.Request[kms.UpdateCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error) {
+	return c.updateCryptoKeyVersion.CallUnary(ctx, req)
+}
+
+// UpdateCryptoKeyPrimaryVersion calls
+// google.cloud.kms.v1.KeyManagementService.UpdateCryptoKeyPrimaryVersion.
+func (c *keyManagementServiceClient) UpdateCryptoKeyPrimaryVersion(ctx context.Context, req *connect.Request[kms.UpdateCryptoKeyPrimaryVersionRequest]) (*connect.Response[kms.CryptoKey], error) {
+	return c.updateCryptoKeyPrimaryVersion.CallUnary(ctx, req)
+}
+
+// DestroyCryptoKeyVersion calls google.cloud.kms.v1.KeyManagementService.DestroyCryptoKeyVersion.
+func (c *keyManagementServiceClient) DestroyCryptoKeyVersion(ctx context.Context, req *connect.Request[kms.DestroyCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error) {
+	return c.destroyCryptoKeyVersion.CallUnary(ctx, req)
+}
+
+// RestoreCryptoKeyVersion calls google.cloud.kms.v1.KeyManagementService.RestoreCryptoKeyVersion.
+func (c *keyManagementServiceClient) RestoreCryptoKeyVersion(ctx context.Context, req *connect.Request[kms.RestoreCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error) {
+	return c.restoreCryptoKeyVersion.CallUnary(ctx, req)
+}
+
+// Encrypt calls google.cloud.kms.v1.KeyManagementService.Encrypt.
+func (c *keyManagementServiceClient) Encrypt(ctx context.Context, req *connect.Request[kms.EncryptRequest]) (*connect.Response[kms.EncryptResponse], error) {
+	return c.encrypt.CallUnary(ctx, req)
+}
+
+// Decrypt calls google.cloud.kms.v1.KeyManagementService.Decrypt.
+func (c *keyManagementServiceClient) Decrypt(ctx context.Context, req *connect.Request[kms.DecryptRequest]) (*connect.Response[kms.DecryptResponse], error) {
+	return c.decrypt.CallUnary(ctx, req)
+}
+
+// AsymmetricSign calls google.cloud.kms.v1.KeyManagementService.AsymmetricSign.
+func (c *keyManagementServiceClient) AsymmetricSign(ctx context.Context, req *connect.Request[kms.AsymmetricSignRequest]) (*connect.Response[kms.AsymmetricSignResponse], error) {
+	return c.asymmetricSign.CallUnary(ctx, req)
+}
+
+// KeyManagementServiceHandler is an implementation of the google.cloud.kms.v1.KeyManagementService
+// service.
+type KeyManagementServiceHandler interface {
+	ListKeyRings(context.Context, *connect.Request[kms.ListKeyRingsRequest]) (*connect.Response[kms.ListKeyRingsResponse], error)
+	ListCryptoKeys(context.Context, *connect.Request[kms.ListCryptoKeysRequest]) (*connect.Response[kms.ListCryptoKeysResponse], error)
+	ListCryptoKeyVersions(context.Context, *connect.Request[kms.ListCryptoKeyVersionsRequest]) (*connect.Response[kms.ListCryptoKeyVersionsResponse], error)
+	GetKeyRing(context.Context, *connect.Request[kms.GetKeyRingRequest]) (*connect.Response[kms.KeyRing], error)
+	GetCryptoKey(context.Context, *connect.Request[kms.GetCryptoKeyRequest]) (*connect.Response[kms.CryptoKey], error)
+	GetCryptoKeyVersion(context.Context, *connect.Request[kms.GetCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error)
+	GetPublicKey(context.Context, *connect.Request[kms.GetPublicKeyRequest]) (*connect.Response[kms.PublicKey], error)
+	CreateKeyRing(context.Context, *connect.Request[kms.CreateKeyRingRequest]) (*connect.Response[kms.KeyRing], error)
+	CreateCryptoKey(context.Context, *connect.Request[kms.CreateCryptoKeyRequest]) (*connect.Response[kms.CryptoKey], error)
+	CreateCryptoKeyVersion(context.Context, *connect.Request[kms.CreateCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error)
+	UpdateCryptoKeyVersion(context.Context, *connect.Request[kms.UpdateCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error)
+	UpdateCryptoKeyPrimaryVersion(context.Context, *connect.Request[kms.UpdateCryptoKeyPrimaryVersionRequest]) (*connect.Response[kms.CryptoKey], error)
+	DestroyCryptoKeyVersion(context.Context, *connect.Request[kms.DestroyCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error)
+	RestoreCryptoKeyVersion(context.Context, *connect.Request[kms.RestoreCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error)
+	Encrypt(context.Context, *connect.Request[kms.EncryptRequest]) (*connect.Response[kms.EncryptResponse], error)
+	Decrypt(context.Context, *connect.Request[kms.DecryptRequest]) (*connect.Response[kms.DecryptResponse], error)
+	AsymmetricSign(context.Context, *connect.Request[kms.AsymmetricSignRequest]) (*connect.Response[kms.AsymmetricSignResponse], error)
+}
+
+// NewKeyManagementServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewKeyManagementServiceHandler(svc KeyManagementServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	keyManagementServiceMethods := kms.File_v1_kms_kms_proto.Services().ByName("KeyManagementService").Methods()
+	keyManagementServiceListKeyRingsHandler := connect.NewUnaryHandler(
+		KeyManagementServiceListKeyRingsProcedure,
+		svc.ListKeyRings,
+		connect.WithSchema(keyManagementServiceMethods.ByName("ListKeyRings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceListCryptoKeysHandler := connect.NewUnaryHandler(
+		KeyManagementServiceListCryptoKeysProcedure,
+		svc.ListCryptoKeys,
+		connect.WithSchema(keyManagementServiceMethods.ByName("ListCryptoKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceListCryptoKeyVersionsHandler := connect.NewUnaryHandler(
+		KeyManagementServiceListCryptoKeyVersionsProcedure,
+		svc.ListCryptoKeyVersions,
+		connect.WithSchema(keyManagementServiceMethods.ByName("ListCryptoKeyVersions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceGetKeyRingHandler := connect.NewUnaryHandler(
+		KeyManagementServiceGetKeyRingProcedure,
+		svc.GetKeyRing,
+		connect.WithSchema(keyManagementServiceMethods.ByName("GetKeyRing")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceGetCryptoKeyHandler := connect.NewUnaryHandler(
+		KeyManagementServiceGetCryptoKeyProcedure,
+		svc.GetCryptoKey,
+		connect.WithSchema(keyManagementServiceMethods.ByName("GetCryptoKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceGetCryptoKeyVersionHandler := connect.NewUnaryHandler(
+		KeyManagementServiceGetCryptoKeyVersionProcedure,
+		svc.GetCryptoKeyVersion,
+		connect.WithSchema(keyManagementServiceMethods.ByName("GetCryptoKeyVersion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceGetPublicKeyHandler := connect.NewUnaryHandler(
+		KeyManagementServiceGetPublicKeyProcedure,
+		svc.GetPublicKey,
+		connect.WithSchema(keyManagementServiceMethods.ByName("GetPublicKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceCreateKeyRingHandler := connect.NewUnaryHandler(
+		KeyManagementServiceCreateKeyRingProcedure,
+		svc.CreateKeyRing,
+		connect.WithSchema(keyManagementServiceMethods.ByName("CreateKeyRing")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceCreateCryptoKeyHandler := connect.NewUnaryHandler(
+		KeyManagementServiceCreateCryptoKeyProcedure,
+		svc.CreateCryptoKey,
+		connect.WithSchema(keyManagementServiceMethods.ByName("CreateCryptoKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceCreateCryptoKeyVersionHandler := connect.NewUnaryHandler(
+		KeyManagementServiceCreateCryptoKeyVersionProcedure,
+		svc.CreateCryptoKeyVersion,
+		connect.WithSchema(keyManagementServiceMethods.ByName("CreateCryptoKeyVersion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceUpdateCryptoKeyVersionHandler := connect.NewUnaryHandler(
+		KeyManagementServiceUpdateCryptoKeyVersionProcedure,
+		svc.UpdateCryptoKeyVersion,
+		connect.WithSchema(keyManagementServiceMethods.ByName("UpdateCryptoKeyVersion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceUpdateCryptoKeyPrimaryVersionHandler := connect.NewUnaryHandler(
+		KeyManagementServiceUpdateCryptoKeyPrimaryVersionProcedure,
+		svc.UpdateCryptoKeyPrimaryVersion,
+		connect.WithSchema(keyManagementServiceMethods.ByName("UpdateCryptoKeyPrimaryVersion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceDestroyCryptoKeyVersionHandler := connect.NewUnaryHandler(
+		KeyManagementServiceDestroyCryptoKeyVersionProcedure,
+		svc.DestroyCryptoKeyVersion,
+		connect.WithSchema(keyManagementServiceMethods.ByName("DestroyCryptoKeyVersion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceRestoreCryptoKeyVersionHandler := connect.NewUnaryHandler(
+		KeyManagementServiceRestoreCryptoKeyVersionProcedure,
+		svc.RestoreCryptoKeyVersion,
+		connect.WithSchema(keyManagementServiceMethods.ByName("RestoreCryptoKeyVersion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceEncryptHandler := connect.NewUnaryHandler(
+		KeyManagementServiceEncryptProcedure,
+		svc.Encrypt,
+		connect.WithSchema(keyManagementServiceMethods.ByName("Encrypt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceDecryptHandler := connect.NewUnaryHandler(
+		KeyManagementServiceDecryptProcedure,
+		svc.Decrypt,
+		connect.WithSchema(keyManagementServiceMethods.ByName("Decrypt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyManagementServiceAsymmetricSignHandler := connect.NewUnaryHandler(
+		KeyManagementServiceAsymmetricSignProcedure,
+		svc.AsymmetricSign,
+		connect.WithSchema(keyManagementServiceMethods.ByName("AsymmetricSign")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/google.cloud.kms.v1.KeyManagementService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case KeyManagementServiceListKeyRingsProcedure:
+			keyManagementServiceListKeyRingsHandler.ServeHTTP(w, r)
+		case KeyManagementServiceListCryptoKeysProcedure:
+			keyManagementServiceListCryptoKeysHandler.ServeHTTP(w, r)
+		case KeyManagementServiceListCryptoKeyVersionsProcedure:
+			keyManagementServiceListCryptoKeyVersionsHandler.ServeHTTP(w, r)
+		case KeyManagementServiceGetKeyRingProcedure:
+			keyManagementServiceGetKeyRingHandler.ServeHTTP(w, r)
+		case KeyManagementServiceGetCryptoKeyProcedure:
+			keyManagementServiceGetCryptoKeyHandler.ServeHTTP(w, r)
+		case KeyManagementServiceGetCryptoKeyVersionProcedure:
+			keyManagementServiceGetCryptoKeyVersionHandler.ServeHTTP(w, r)
+		case KeyManagementServiceGetPublicKeyProcedure:
+			keyManagementServiceGetPublicKeyHandler.ServeHTTP(w, r)
+		case KeyManagementServiceCreateKeyRingProcedure:
+			keyManagementServiceCreateKeyRingHandler.ServeHTTP(w, r)
+		case KeyManagementServiceCreateCryptoKeyProcedure:
+			keyManagementServiceCreateCryptoKeyHandler.ServeHTTP(w, r)
+		case KeyManagementServiceCreateCryptoKeyVersionProcedure:
+			keyManagementServiceCreateCryptoKeyVersionHandler.ServeHTTP(w, r)
+		case KeyManagementServiceUpdateCryptoKeyVersionProcedure:
+			keyManagementServiceUpdateCryptoKeyVersionHandler.ServeHTTP(w, r)
+		case KeyManagementServiceUpdateCryptoKeyPrimaryVersionProcedure:
+			keyManagementServiceUpdateCryptoKeyPrimaryVersionHandler.ServeHTTP(w, r)
+		case KeyManagementServiceDestroyCryptoKeyVersionProcedure:
+			keyManagementServiceDestroyCryptoKeyVersionHandler.ServeHTTP(w, r)
+		case KeyManagementServiceRestoreCryptoKeyVersionProcedure:
+			keyManagementServiceRestoreCryptoKeyVersionHandler.ServeHTTP(w, r)
+		case KeyManagementServiceEncryptProcedure:
+			keyManagementServiceEncryptHandler.ServeHTTP(w, r)
+		case KeyManagementServiceDecryptProcedure:
+			keyManagementServiceDecryptHandler.ServeHTTP(w, r)
+		case KeyManagementServiceAsymmetricSignProcedure:
+			keyManagementServiceAsymmetricSignHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedKeyManagementServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedKeyManagementServiceHandler struct{}
+
+func (UnimplementedKeyManagementServiceHandler) ListKeyRings(context.Context, *connect.Request[kms.ListKeyRingsRequest]) (*connect.Response[kms.ListKeyRingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.ListKeyRings is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) ListCryptoKeys(context.Context, *connect.Request[kms.ListCryptoKeysRequest]) (*connect.Response[kms.ListCryptoKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.ListCryptoKeys is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) ListCryptoKeyVersions(context.Context, *connect.Request[kms.ListCryptoKeyVersionsRequest]) (*connect.Response[kms.ListCryptoKeyVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.ListCryptoKeyVersions is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) GetKeyRing(context.Context, *connect.Request[kms.GetKeyRingRequest]) (*connect.Response[kms.KeyRing], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.GetKeyRing is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) GetCryptoKey(context.Context, *connect.Request[kms.GetCryptoKeyRequest]) (*connect.Response[kms.CryptoKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.GetCryptoKey is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) GetCryptoKeyVersion(context.Context, *connect.Request[kms.GetCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.GetCryptoKeyVersion is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) GetPublicKey(context.Context, *connect.Request[kms.GetPublicKeyRequest]) (*connect.Response[kms.PublicKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.GetPublicKey is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) CreateKeyRing(context.Context, *connect.Request[kms.CreateKeyRingRequest]) (*connect.Response[kms.KeyRing], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.CreateKeyRing is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) CreateCryptoKey(context.Context, *connect.Request[kms.CreateCryptoKeyRequest]) (*connect.Response[kms.CryptoKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.CreateCryptoKey is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) CreateCryptoKeyVersion(context.Context, *connect.Request[kms.CreateCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.CreateCryptoKeyVersion is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) UpdateCryptoKeyVersion(context.Context, *connect.Request[kms.UpdateCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.UpdateCryptoKeyVersion is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) UpdateCryptoKeyPrimaryVersion(context.Context, *connect.Request[kms.UpdateCryptoKeyPrimaryVersionRequest]) (*connect.Response[kms.CryptoKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.UpdateCryptoKeyPrimaryVersion is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) DestroyCryptoKeyVersion(context.Context, *connect.Request[kms.DestroyCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.DestroyCryptoKeyVersion is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) RestoreCryptoKeyVersion(context.Context, *connect.Request[kms.RestoreCryptoKeyVersionRequest]) (*connect.Response[kms.CryptoKeyVersion], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.RestoreCryptoKeyVersion is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) Encrypt(context.Context, *connect.Request[kms.EncryptRequest]) (*connect.Response[kms.EncryptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.Encrypt is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) Decrypt(context.Context, *connect.Request[kms.DecryptRequest]) (*connect.Response[kms.DecryptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.Decrypt is not implemented"))
+}
+
+func (UnimplementedKeyManagementServiceHandler) AsymmetricSign(context.Context, *connect.Request[kms.AsymmetricSignRequest]) (*connect.Response[kms.AsymmetricSignResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("google.cloud.kms.v1.KeyManagementService.AsymmetricSign is not implemented"))
+}
@@ -1,666 +1,10432 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.11
-// 	protoc        (unknown)
-// source: v1/vault/vault.proto
-
-package vaultv1
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type VaultWriteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *VaultWriteRequest) Reset() {
-	*x = VaultWriteRequest{}
-	mi := &file_v1_vault_vault_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *VaultWriteRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*VaultWriteRequest) ProtoMessage() {}
-
-func (x *VaultWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_vault_vault_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use VaultWriteRequest.ProtoReflect.Descriptor instead.
-func (*VaultWriteRequest) Descriptor() ([]byte, []int) {
-	return file_v1_vault_vault_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *VaultWriteRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *VaultWriteRequest) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
-
-type VaultWriteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *VaultWriteResponse) Reset() {
-	*x = VaultWriteResponse{}
-	mi := &file_v1_vault_vault_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *VaultWriteResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*VaultWriteResponse) ProtoMessage() {}
-
-func (x *VaultWriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_vault_vault_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use VaultWriteResponse.ProtoReflect.Descriptor instead.
-func (*VaultWriteResponse) Descriptor() ([]byte, []int) {
-	return file_v1_vault_vault_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *VaultWriteResponse) GetVersion() int32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-type VaultReadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *VaultReadRequest) Reset() {
-	*x = VaultReadRequest{}
-	mi := &file_v1_vault_vault_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *VaultReadRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*VaultReadRequest) ProtoMessage() {}
-
-func (x *VaultReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_vault_vault_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use VaultReadRequest.ProtoReflect.Descriptor instead.
-func (*VaultReadRequest) Descriptor() ([]byte, []int) {
-	return file_v1_vault_vault_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *VaultReadRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-type VaultReadResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *VaultReadResponse) Reset() {
-	*x = VaultReadResponse{}
-	mi := &file_v1_vault_vault_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *VaultReadResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*VaultReadResponse) ProtoMessage() {}
-
-func (x *VaultReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_vault_vault_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use VaultReadResponse.ProtoReflect.Descriptor instead.
-func (*VaultReadResponse) Descriptor() ([]byte, []int) {
-	return file_v1_vault_vault_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *VaultReadResponse) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
-
-func (x *VaultReadResponse) GetVersion() int32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-type GetSecretVersionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetSecretVersionRequest) Reset() {
-	*x = GetSecretVersionRequest{}
-	mi := &file_v1_vault_vault_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetSecretVersionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetSecretVersionRequest) ProtoMessage() {}
-
-func (x *GetSecretVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_vault_vault_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetSecretVersionRequest.ProtoReflect.Descriptor instead.
-func (*GetSecretVersionRequest) Descriptor() ([]byte, []int) {
-	return file_v1_vault_vault_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *GetSecretVersionRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *GetSecretVersionRequest) GetVersion() int32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-type ListSecretVersionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListSecretVersionsRequest) Reset() {
-	*x = ListSecretVersionsRequest{}
-	mi := &file_v1_vault_vault_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListSecretVersionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSecretVersionsRequest) ProtoMessage() {}
-
-func (x *ListSecretVersionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_vault_vault_proto_msgTypes[5]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSecretVersionsRequest.ProtoReflect.Descriptor instead.
-func (*ListSecretVersionsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_vault_vault_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ListSecretVersionsRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-type ListSecretVersionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Versions      []int32                `protobuf:"varint,1,rep,packed,name=versions,proto3" json:"versions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListSecretVersionsResponse) Reset() {
-	*x = ListSecretVersionsResponse{}
-	mi := &file_v1_vault_vault_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListSecretVersionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSecretVersionsResponse) ProtoMessage() {}
-
-func (x *ListSecretVersionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_vault_vault_proto_msgTypes[6]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSecretVersionsResponse.ProtoReflect.Descriptor instead.
-func (*ListSecretVersionsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_vault_vault_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *ListSecretVersionsResponse) GetVersions() []int32 {
-	if x != nil {
-		return x.Versions
-	}
-	return nil
-}
-
-type ListSecretsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListSecretsRequest) Reset() {
-	*x = ListSecretsRequest{}
-	mi := &file_v1_vault_vault_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListSecretsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSecretsRequest) ProtoMessage() {}
-
-func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_vault_vault_proto_msgTypes[7]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
-func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_vault_vault_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *ListSecretsRequest) GetPrefix() string {
-	if x != nil {
-		return x.Prefix
-	}
-	return ""
-}
-
-type ListSecretsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListSecretsResponse) Reset() {
-	*x = ListSecretsResponse{}
-	mi := &file_v1_vault_vault_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListSecretsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListSecretsResponse) ProtoMessage() {}
-
-func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_vault_vault_proto_msgTypes[8]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
-func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_vault_vault_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *ListSecretsResponse) GetKeys() []string {
-	if x != nil {
-		return x.Keys
-	}
-	return nil
-}
-
-type TestIAMPolicyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
-	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	Resource      string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *TestIAMPolicyRequest) Reset() {
-	*x = TestIAMPolicyRequest{}
-	mi := &file_v1_vault_vault_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *TestIAMPolicyRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TestIAMPolicyRequest) ProtoMessage() {}
-
-func (x *TestIAMPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_vault_vault_proto_msgTypes[9]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TestIAMPolicyRequest.ProtoReflect.Descriptor instead.
-func (*TestIAMPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_v1_vault_vault_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *TestIAMPolicyRequest) GetIdentity() string {
-	if x != nil {
-		return x.Identity
-	}
-	return ""
-}
-
-func (x *TestIAMPolicyRequest) GetAction() string {
-	if x != nil {
-		return x.Action
-	}
-	return ""
-}
-
-func (x *TestIAMPolicyRequest) GetResource() string {
-	if x != nil {
-		return x.Resource
-	}
-	return ""
-}
-
-type TestIAMPolicyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *TestIAMPolicyResponse) Reset() {
-	*x = TestIAMPolicyResponse{}
-	mi := &file_v1_vault_vault_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *TestIAMPolicyResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TestIAMPolicyResponse) ProtoMessage() {}
-
-func (x *TestIAMPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_vault_vault_proto_msgTypes[10]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TestIAMPolicyResponse.ProtoReflect.Descriptor instead.
-func (*TestIAMPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_v1_vault_vault_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *TestIAMPolicyResponse) GetAllowed() bool {
-	if x != nil {
-		return x.Allowed
-	}
-	return false
-}
-
-func (x *TestIAMPolicyResponse) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-var File_v1_vault_vault_proto protoreflect.FileDescriptor
-
-const file_v1_vault_vault_proto_rawDesc = "" +
-	"\n" +
-	"\x14v1/vault/vault.proto\x12\bvault.v1\";\n" +
-	"\x11VaultWriteRequest\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\".\n" +
-	"\x12VaultWriteResponse\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\x05R\aversion\"$\n" +
-	"\x10VaultReadRequest\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\"C\n" +
-	"\x11VaultReadResponse\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\x05R\aversion\"E\n" +
-	"\x17GetSecretVersionRequest\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\x05R\aversion\"-\n" +
-	"\x19ListSecretVersionsRequest\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\"8\n" +
-	"\x1aListSecretVersionsResponse\x12\x1a\n" +
-	"\bversions\x18\x01 \x03(\x05R\bversions\",\n" +
-	"\x12ListSecretsRequest\x12\x16\n" +
-	"\x06prefix\x18\x01 \x01(\tR\x06prefix\")\n" +
-	"\x13ListSecretsResponse\x12\x12\n" +
-	"\x04keys\x18\x01 \x03(\tR\x04keys\"f\n" +
-	"\x14TestIAMPolicyRequest\x12\x1a\n" +
-	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\x16\n" +
-	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1a\n" +
-	"\bresource\x18\x03 \x01(\tR\bresource\"I\n" +
-	"\x15TestIAMPolicyResponse\x12\x18\n" +
-	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason2\xf0\x03\n" +
-	"\fVaultService\x12G\n" +
-	"\n" +
-	"VaultWrite\x12\x1b.vault.v1.VaultWriteRequest\x1a\x1c.vault.v1.VaultWriteResponse\x12D\n" +
-	"\tVaultRead\x12\x1a.vault.v1.VaultReadRequest\x1a\x1b.vault.v1.VaultReadResponse\x12R\n" +
-	"\x10GetSecretVersion\x12!.vault.v1.GetSecretVersionRequest\x1a\x1b.vault.v1.VaultReadResponse\x12_\n" +
-	"\x12ListSecretVersions\x12#.vault.v1.ListSecretVersionsRequest\x1a$.vault.v1.ListSecretVersionsResponse\x12J\n" +
-	"\vListSecrets\x12\x1c.vault.v1.ListSecretsRequest\x1a\x1d.vault.v1.ListSecretsResponse\x12P\n" +
-	"\rTestIAMPolicy\x12\x1e.vault.v1.TestIAMPolicyRequest\x1a\x1f.vault.v1.TestIAMPolicyResponseB'Z%OlympusGCP-Vault/gen/v1/vault;vaultv1b\x06proto3"
-
-var (
-	file_v1_vault_vault_proto_rawDescOnce sync.Once
-	file_v1_vault_vault_proto_rawDescData []byte
-)
-
-func file_v1_vault_vault_proto_rawDescGZIP() []byte {
-	file_v1_vault_vault_proto_rawDescOnce.Do(func() {
-		file_v1_vault_vault_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_vault_vault_proto_rawDesc), len(file_v1_vault_vault_proto_rawDesc)))
-	})
-	return file_v1_vault_vault_proto_rawDescData
-}
-
-var file_v1_vault_vault_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_v1_vault_vault_proto_goTypes = []any{
-	(*VaultWriteRequest)(nil),          // 0: vault.v1.VaultWriteRequest
-	(*VaultWriteResponse)(nil),         // 1: vault.v1.VaultWriteResponse
-	(*VaultReadRequest)(nil),           // 2: vault.v1.VaultReadRequest
-	(*VaultReadResponse)(nil),          // 3: vault.v1.VaultReadResponse
-	(*GetSecretVersionRequest)(nil),    // 4: vault.v1.GetSecretVersionRequest
-	(*ListSecretVersionsRequest)(nil),  // 5: vault.v1.ListSecretVersionsRequest
-	(*ListSecretVersionsResponse)(nil), // 6: vault.v1.ListSecretVersionsResponse
-	(*ListSecretsRequest)(nil),         // 7: vault.v1.ListSecretsRequest
-	(*ListSecretsResponse)(nil),        // 8: vault.v1.ListSecretsResponse
-	(*TestIAMPolicyRequest)(nil),       // 9: vault.v1.TestIAMPolicyRequest
-	(*TestIAMPolicyResponse)(nil),      // 10: vault.v1.TestIAMPolicyResponse
-}
-var file_v1_vault_vault_proto_depIdxs = []int32{
-	0,  // 0: vault.v1.VaultService.VaultWrite:input_type -> vault.v1.VaultWriteRequest
-	2,  // 1: vault.v1.VaultService.VaultRead:input_type -> vault.v1.VaultReadRequest
-	4,  // 2: vault.v1.VaultService.GetSecretVersion:input_type -> vault.v1.GetSecretVersionRequest
-	5,  // 3: vault.v1.VaultService.ListSecretVersions:input_type -> vault.v1.ListSecretVersionsRequest
-	7,  // 4: vault.v1.VaultService.ListSecrets:input_type -> vault.v1.ListSecretsRequest
-	9,  // 5: vault.v1.VaultService.TestIAMPolicy:input_type -> vault.v1.TestIAMPolicyRequest
-	1,  // 6: vault.v1.VaultService.VaultWrite:output_type -> vault.v1.VaultWriteResponse
-	3,  // 7: vault.v1.VaultService.VaultRead:output_type -> vault.v1.VaultReadResponse
-	3,  // 8: vault.v1.VaultService.GetSecretVersion:output_type -> vault.v1.VaultReadResponse
-	6,  // 9: vault.v1.VaultService.ListSecretVersions:output_type -> vault.v1.ListSecretVersionsResponse
-	8,  // 10: vault.v1.VaultService.ListSecrets:output_type -> vault.v1.ListSecretsResponse
-	10, // 11: vault.v1.VaultService.TestIAMPolicy:output_type -> vault.v1.TestIAMPolicyResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
-}
-
-func init() { file_v1_vault_vault_proto_init() }
-func file_v1_vault_vault_proto_init() {
-	if File_v1_vault_vault_proto != nil {
-		return
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_vault_vault_proto_rawDesc), len(file_v1_vault_vault_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_v1_vault_vault_proto_goTypes,
-		DependencyIndexes: file_v1_vault_vault_proto_depIdxs,
-		MessageInfos:      file_v1_vault_vault_proto_msgTypes,
-	}.Build()
-	File_v1_vault_vault_proto = out.File
-	file_v1_vault_vault_proto_goTypes = nil
-	file_v1_vault_vault_proto_depIdxs = nil
-}
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/vault/vault.proto
+
+package vaultv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VaultWriteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Optional expiry of the written version, relative or absolute (unix
+	// seconds). Expired versions are destroyed by the reaper.
+	TtlSeconds int64 `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	ExpireTime int64 `protobuf:"varint,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// CryptoKey of the vault's KMS service that encrypts this and later
+	// versions of the secret. Empty keeps the secret's current key, if any.
+	KmsKeyName    string `protobuf:"bytes,5,opt,name=kms_key_name,json=kmsKeyName,proto3" json:"kms_key_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultWriteRequest) Reset() {
+	*x = VaultWriteRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultWriteRequest) ProtoMessage() {}
+
+func (x *VaultWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultWriteRequest.ProtoReflect.Descriptor instead.
+func (*VaultWriteRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VaultWriteRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *VaultWriteRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *VaultWriteRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *VaultWriteRequest) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *VaultWriteRequest) GetKmsKeyName() string {
+	if x != nil {
+		return x.KmsKeyName
+	}
+	return ""
+}
+
+type VaultWriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ExpireTime    int64                  `protobuf:"varint,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultWriteResponse) Reset() {
+	*x = VaultWriteResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultWriteResponse) ProtoMessage() {}
+
+func (x *VaultWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultWriteResponse.ProtoReflect.Descriptor instead.
+func (*VaultWriteResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VaultWriteResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *VaultWriteResponse) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type VaultReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Redeems an approved control-group request.
+	AccessRequestId string `protobuf:"bytes,2,opt,name=access_request_id,json=accessRequestId,proto3" json:"access_request_id,omitempty"`
+	// Why the secret is being read; recorded in the audit log.
+	AccessReason  string `protobuf:"bytes,3,opt,name=access_reason,json=accessReason,proto3" json:"access_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultReadRequest) Reset() {
+	*x = VaultReadRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultReadRequest) ProtoMessage() {}
+
+func (x *VaultReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultReadRequest.ProtoReflect.Descriptor instead.
+func (*VaultReadRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VaultReadRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *VaultReadRequest) GetAccessRequestId() string {
+	if x != nil {
+		return x.AccessRequestId
+	}
+	return ""
+}
+
+func (x *VaultReadRequest) GetAccessReason() string {
+	if x != nil {
+		return x.AccessReason
+	}
+	return ""
+}
+
+type VaultReadResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Value   string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Version int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Set instead of value when the secret is in a control group and the
+	// read is pending approval.
+	AccessRequestId string `protobuf:"bytes,3,opt,name=access_request_id,json=accessRequestId,proto3" json:"access_request_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VaultReadResponse) Reset() {
+	*x = VaultReadResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultReadResponse) ProtoMessage() {}
+
+func (x *VaultReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultReadResponse.ProtoReflect.Descriptor instead.
+func (*VaultReadResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VaultReadResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *VaultReadResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *VaultReadResponse) GetAccessRequestId() string {
+	if x != nil {
+		return x.AccessRequestId
+	}
+	return ""
+}
+
+type GetSecretVersionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Key             string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Version         int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	AccessRequestId string                 `protobuf:"bytes,3,opt,name=access_request_id,json=accessRequestId,proto3" json:"access_request_id,omitempty"`
+	AccessReason    string                 `protobuf:"bytes,4,opt,name=access_reason,json=accessReason,proto3" json:"access_reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetSecretVersionRequest) Reset() {
+	*x = GetSecretVersionRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretVersionRequest) ProtoMessage() {}
+
+func (x *GetSecretVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretVersionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSecretVersionRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetSecretVersionRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetSecretVersionRequest) GetAccessRequestId() string {
+	if x != nil {
+		return x.AccessRequestId
+	}
+	return ""
+}
+
+func (x *GetSecretVersionRequest) GetAccessReason() string {
+	if x != nil {
+		return x.AccessReason
+	}
+	return ""
+}
+
+type ListSecretVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretVersionsRequest) Reset() {
+	*x = ListSecretVersionsRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretVersionsRequest) ProtoMessage() {}
+
+func (x *ListSecretVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSecretVersionsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListSecretVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []int32                `protobuf:"varint,1,rep,packed,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretVersionsResponse) Reset() {
+	*x = ListSecretVersionsResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretVersionsResponse) ProtoMessage() {}
+
+func (x *ListSecretVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSecretVersionsResponse) GetVersions() []int32 {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type ListSecretsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsRequest) Reset() {
+	*x = ListSecretsRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsRequest) ProtoMessage() {}
+
+func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSecretsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type ListSecretsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsResponse) Reset() {
+	*x = ListSecretsResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsResponse) ProtoMessage() {}
+
+func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSecretsResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type TestIAMPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Resource      string                 `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestIAMPolicyRequest) Reset() {
+	*x = TestIAMPolicyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestIAMPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestIAMPolicyRequest) ProtoMessage() {}
+
+func (x *TestIAMPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestIAMPolicyRequest.ProtoReflect.Descriptor instead.
+func (*TestIAMPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TestIAMPolicyRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *TestIAMPolicyRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TestIAMPolicyRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+type TestIAMPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestIAMPolicyResponse) Reset() {
+	*x = TestIAMPolicyResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestIAMPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestIAMPolicyResponse) ProtoMessage() {}
+
+func (x *TestIAMPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestIAMPolicyResponse.ProtoReflect.Descriptor instead.
+func (*TestIAMPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TestIAMPolicyResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *TestIAMPolicyResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateTokenRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Policies              []string               `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	TtlSeconds            int64                  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	ExplicitMaxTtlSeconds int64                  `protobuf:"varint,3,opt,name=explicit_max_ttl_seconds,json=explicitMaxTtlSeconds,proto3" json:"explicit_max_ttl_seconds,omitempty"`
+	DisplayName           string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Renewable             bool                   `protobuf:"varint,5,opt,name=renewable,proto3" json:"renewable,omitempty"`
+	// Orphan tokens have no parent and survive revocation of the creator.
+	NoParent      bool `protobuf:"varint,6,opt,name=no_parent,json=noParent,proto3" json:"no_parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTokenRequest) Reset() {
+	*x = CreateTokenRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenRequest) ProtoMessage() {}
+
+func (x *CreateTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateTokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateTokenRequest) GetPolicies() []string {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *CreateTokenRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *CreateTokenRequest) GetExplicitMaxTtlSeconds() int64 {
+	if x != nil {
+		return x.ExplicitMaxTtlSeconds
+	}
+	return 0
+}
+
+func (x *CreateTokenRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateTokenRequest) GetRenewable() bool {
+	if x != nil {
+		return x.Renewable
+	}
+	return false
+}
+
+func (x *CreateTokenRequest) GetNoParent() bool {
+	if x != nil {
+		return x.NoParent
+	}
+	return false
+}
+
+type CreateTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Info          *TokenInfo             `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTokenResponse) Reset() {
+	*x = CreateTokenResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenResponse) ProtoMessage() {}
+
+func (x *CreateTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateTokenResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateTokenResponse) GetInfo() *TokenInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type TokenInfo struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Accessor    string                 `protobuf:"bytes,1,opt,name=accessor,proto3" json:"accessor,omitempty"`
+	Policies    []string               `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	DisplayName string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	CreateTime  int64                  `protobuf:"varint,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Unix seconds; zero for tokens that never expire.
+	ExpireTime            int64  `protobuf:"varint,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	TtlSeconds            int64  `protobuf:"varint,6,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	ExplicitMaxTtlSeconds int64  `protobuf:"varint,7,opt,name=explicit_max_ttl_seconds,json=explicitMaxTtlSeconds,proto3" json:"explicit_max_ttl_seconds,omitempty"`
+	Renewable             bool   `protobuf:"varint,8,opt,name=renewable,proto3" json:"renewable,omitempty"`
+	ParentAccessor        string `protobuf:"bytes,9,opt,name=parent_accessor,json=parentAccessor,proto3" json:"parent_accessor,omitempty"`
+	// Principal the token acts for, e.g. in audit records and control
+	// groups. Set by the auth method or sudo caller that issued the token
+	// tree; children created without sudo inherit it.
+	Entity string `protobuf:"bytes,10,opt,name=entity,proto3" json:"entity,omitempty"`
+	// Lease tracking the token's expiry, "auth/token/create/<accessor>";
+	// empty for tokens that never expire.
+	LeaseId       string `protobuf:"bytes,11,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenInfo) Reset() {
+	*x = TokenInfo{}
+	mi := &file_v1_vault_vault_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenInfo) ProtoMessage() {}
+
+func (x *TokenInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenInfo.ProtoReflect.Descriptor instead.
+func (*TokenInfo) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TokenInfo) GetAccessor() string {
+	if x != nil {
+		return x.Accessor
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetPolicies() []string {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *TokenInfo) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *TokenInfo) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *TokenInfo) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *TokenInfo) GetExplicitMaxTtlSeconds() int64 {
+	if x != nil {
+		return x.ExplicitMaxTtlSeconds
+	}
+	return 0
+}
+
+func (x *TokenInfo) GetRenewable() bool {
+	if x != nil {
+		return x.Renewable
+	}
+	return false
+}
+
+func (x *TokenInfo) GetParentAccessor() string {
+	if x != nil {
+		return x.ParentAccessor
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+// An empty token and accessor refer to the calling token.
+type LookupTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Accessor      string                 `protobuf:"bytes,2,opt,name=accessor,proto3" json:"accessor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupTokenRequest) Reset() {
+	*x = LookupTokenRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupTokenRequest) ProtoMessage() {}
+
+func (x *LookupTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupTokenRequest.ProtoReflect.Descriptor instead.
+func (*LookupTokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LookupTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LookupTokenRequest) GetAccessor() string {
+	if x != nil {
+		return x.Accessor
+	}
+	return ""
+}
+
+type RenewTokenRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Token            string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	IncrementSeconds int64                  `protobuf:"varint,2,opt,name=increment_seconds,json=incrementSeconds,proto3" json:"increment_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RenewTokenRequest) Reset() {
+	*x = RenewTokenRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewTokenRequest) ProtoMessage() {}
+
+func (x *RenewTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewTokenRequest.ProtoReflect.Descriptor instead.
+func (*RenewTokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RenewTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RenewTokenRequest) GetIncrementSeconds() int64 {
+	if x != nil {
+		return x.IncrementSeconds
+	}
+	return 0
+}
+
+type RevokeTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Accessor      string                 `protobuf:"bytes,2,opt,name=accessor,proto3" json:"accessor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenRequest) Reset() {
+	*x = RevokeTokenRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenRequest) ProtoMessage() {}
+
+func (x *RevokeTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RevokeTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RevokeTokenRequest) GetAccessor() string {
+	if x != nil {
+		return x.Accessor
+	}
+	return ""
+}
+
+type RevokeTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revoked       int32                  `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenResponse) Reset() {
+	*x = RevokeTokenResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenResponse) ProtoMessage() {}
+
+func (x *RevokeTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTokenResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RevokeTokenResponse) GetRevoked() int32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+type PolicyRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Exact key, or a prefix when it ends in "*".
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// read, create, update, delete, list, sudo or deny.
+	Capabilities []string `protobuf:"bytes,2,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// Reads under this path must carry an access reason.
+	RequireReason bool `protobuf:"varint,3,opt,name=require_reason,json=requireReason,proto3" json:"require_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyRule) Reset() {
+	*x = PolicyRule{}
+	mi := &file_v1_vault_vault_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyRule) ProtoMessage() {}
+
+func (x *PolicyRule) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyRule.ProtoReflect.Descriptor instead.
+func (*PolicyRule) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PolicyRule) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PolicyRule) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *PolicyRule) GetRequireReason() bool {
+	if x != nil {
+		return x.RequireReason
+	}
+	return false
+}
+
+type Policy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rules []*PolicyRule          `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	// When set, the policy grants read access only while the window is open.
+	Window        *AccessWindow `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Policy) Reset() {
+	*x = Policy{}
+	mi := &file_v1_vault_vault_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policy) ProtoMessage() {}
+
+func (x *Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
+func (*Policy) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Policy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Policy) GetRules() []*PolicyRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Policy) GetWindow() *AccessWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type PutPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *Policy                `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutPolicyRequest) Reset() {
+	*x = PutPolicyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutPolicyRequest) ProtoMessage() {}
+
+func (x *PutPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutPolicyRequest.ProtoReflect.Descriptor instead.
+func (*PutPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PutPolicyRequest) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type PutPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutPolicyResponse) Reset() {
+	*x = PutPolicyResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutPolicyResponse) ProtoMessage() {}
+
+func (x *PutPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutPolicyResponse.ProtoReflect.Descriptor instead.
+func (*PutPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{21}
+}
+
+type GetPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPolicyRequest) Reset() {
+	*x = GetPolicyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPolicyRequest) ProtoMessage() {}
+
+func (x *GetPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AppRole struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TokenPolicies      []string               `protobuf:"bytes,2,rep,name=token_policies,json=tokenPolicies,proto3" json:"token_policies,omitempty"`
+	TokenTtlSeconds    int64                  `protobuf:"varint,3,opt,name=token_ttl_seconds,json=tokenTtlSeconds,proto3" json:"token_ttl_seconds,omitempty"`
+	TokenMaxTtlSeconds int64                  `protobuf:"varint,4,opt,name=token_max_ttl_seconds,json=tokenMaxTtlSeconds,proto3" json:"token_max_ttl_seconds,omitempty"`
+	// Zero means a secret-id can be used any number of times.
+	SecretIdNumUses int32 `protobuf:"varint,5,opt,name=secret_id_num_uses,json=secretIdNumUses,proto3" json:"secret_id_num_uses,omitempty"`
+	// Zero means secret-ids never expire.
+	SecretIdTtlSeconds int64    `protobuf:"varint,6,opt,name=secret_id_ttl_seconds,json=secretIdTtlSeconds,proto3" json:"secret_id_ttl_seconds,omitempty"`
+	SecretIdBoundCidrs []string `protobuf:"bytes,7,rep,name=secret_id_bound_cidrs,json=secretIdBoundCidrs,proto3" json:"secret_id_bound_cidrs,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AppRole) Reset() {
+	*x = AppRole{}
+	mi := &file_v1_vault_vault_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppRole) ProtoMessage() {}
+
+func (x *AppRole) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppRole.ProtoReflect.Descriptor instead.
+func (*AppRole) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AppRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppRole) GetTokenPolicies() []string {
+	if x != nil {
+		return x.TokenPolicies
+	}
+	return nil
+}
+
+func (x *AppRole) GetTokenTtlSeconds() int64 {
+	if x != nil {
+		return x.TokenTtlSeconds
+	}
+	return 0
+}
+
+func (x *AppRole) GetTokenMaxTtlSeconds() int64 {
+	if x != nil {
+		return x.TokenMaxTtlSeconds
+	}
+	return 0
+}
+
+func (x *AppRole) GetSecretIdNumUses() int32 {
+	if x != nil {
+		return x.SecretIdNumUses
+	}
+	return 0
+}
+
+func (x *AppRole) GetSecretIdTtlSeconds() int64 {
+	if x != nil {
+		return x.SecretIdTtlSeconds
+	}
+	return 0
+}
+
+func (x *AppRole) GetSecretIdBoundCidrs() []string {
+	if x != nil {
+		return x.SecretIdBoundCidrs
+	}
+	return nil
+}
+
+type PutAppRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *AppRole               `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutAppRoleRequest) Reset() {
+	*x = PutAppRoleRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutAppRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutAppRoleRequest) ProtoMessage() {}
+
+func (x *PutAppRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutAppRoleRequest.ProtoReflect.Descriptor instead.
+func (*PutAppRoleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PutAppRoleRequest) GetRole() *AppRole {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type PutAppRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutAppRoleResponse) Reset() {
+	*x = PutAppRoleResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutAppRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutAppRoleResponse) ProtoMessage() {}
+
+func (x *PutAppRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutAppRoleResponse.ProtoReflect.Descriptor instead.
+func (*PutAppRoleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PutAppRoleResponse) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type GetAppRoleIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleName      string                 `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppRoleIDRequest) Reset() {
+	*x = GetAppRoleIDRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppRoleIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppRoleIDRequest) ProtoMessage() {}
+
+func (x *GetAppRoleIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppRoleIDRequest.ProtoReflect.Descriptor instead.
+func (*GetAppRoleIDRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAppRoleIDRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+type GetAppRoleIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppRoleIDResponse) Reset() {
+	*x = GetAppRoleIDResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppRoleIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppRoleIDResponse) ProtoMessage() {}
+
+func (x *GetAppRoleIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppRoleIDResponse.ProtoReflect.Descriptor instead.
+func (*GetAppRoleIDResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetAppRoleIDResponse) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type GenerateSecretIDRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	RoleName string                 `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	// Narrows the role's bound CIDRs for this secret-id.
+	CidrList      []string          `protobuf:"bytes,2,rep,name=cidr_list,json=cidrList,proto3" json:"cidr_list,omitempty"`
+	Metadata      map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSecretIDRequest) Reset() {
+	*x = GenerateSecretIDRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSecretIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSecretIDRequest) ProtoMessage() {}
+
+func (x *GenerateSecretIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSecretIDRequest.ProtoReflect.Descriptor instead.
+func (*GenerateSecretIDRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GenerateSecretIDRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *GenerateSecretIDRequest) GetCidrList() []string {
+	if x != nil {
+		return x.CidrList
+	}
+	return nil
+}
+
+func (x *GenerateSecretIDRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type GenerateSecretIDResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SecretId         string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	SecretIdAccessor string                 `protobuf:"bytes,2,opt,name=secret_id_accessor,json=secretIdAccessor,proto3" json:"secret_id_accessor,omitempty"`
+	ExpireTime       int64                  `protobuf:"varint,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	NumUses          int32                  `protobuf:"varint,4,opt,name=num_uses,json=numUses,proto3" json:"num_uses,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GenerateSecretIDResponse) Reset() {
+	*x = GenerateSecretIDResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSecretIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSecretIDResponse) ProtoMessage() {}
+
+func (x *GenerateSecretIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSecretIDResponse.ProtoReflect.Descriptor instead.
+func (*GenerateSecretIDResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GenerateSecretIDResponse) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *GenerateSecretIDResponse) GetSecretIdAccessor() string {
+	if x != nil {
+		return x.SecretIdAccessor
+	}
+	return ""
+}
+
+func (x *GenerateSecretIDResponse) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *GenerateSecretIDResponse) GetNumUses() int32 {
+	if x != nil {
+		return x.NumUses
+	}
+	return 0
+}
+
+type DestroySecretIDRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RoleName         string                 `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	SecretIdAccessor string                 `protobuf:"bytes,2,opt,name=secret_id_accessor,json=secretIdAccessor,proto3" json:"secret_id_accessor,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DestroySecretIDRequest) Reset() {
+	*x = DestroySecretIDRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroySecretIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroySecretIDRequest) ProtoMessage() {}
+
+func (x *DestroySecretIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroySecretIDRequest.ProtoReflect.Descriptor instead.
+func (*DestroySecretIDRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DestroySecretIDRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *DestroySecretIDRequest) GetSecretIdAccessor() string {
+	if x != nil {
+		return x.SecretIdAccessor
+	}
+	return ""
+}
+
+type DestroySecretIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroySecretIDResponse) Reset() {
+	*x = DestroySecretIDResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroySecretIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroySecretIDResponse) ProtoMessage() {}
+
+func (x *DestroySecretIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroySecretIDResponse.ProtoReflect.Descriptor instead.
+func (*DestroySecretIDResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{31}
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	SecretId      string                 `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LoginRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+type JWTConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Key sets are merged; keys are looked up by "kid".
+	JwksUrls  []string `protobuf:"bytes,1,rep,name=jwks_urls,json=jwksUrls,proto3" json:"jwks_urls,omitempty"`
+	JwksFiles []string `protobuf:"bytes,2,rep,name=jwks_files,json=jwksFiles,proto3" json:"jwks_files,omitempty"`
+	// Default issuer for roles that do not set their own.
+	BoundIssuer            string `protobuf:"bytes,3,opt,name=bound_issuer,json=boundIssuer,proto3" json:"bound_issuer,omitempty"`
+	ClockSkewLeewaySeconds int64  `protobuf:"varint,4,opt,name=clock_skew_leeway_seconds,json=clockSkewLeewaySeconds,proto3" json:"clock_skew_leeway_seconds,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *JWTConfig) Reset() {
+	*x = JWTConfig{}
+	mi := &file_v1_vault_vault_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWTConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWTConfig) ProtoMessage() {}
+
+func (x *JWTConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWTConfig.ProtoReflect.Descriptor instead.
+func (*JWTConfig) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *JWTConfig) GetJwksUrls() []string {
+	if x != nil {
+		return x.JwksUrls
+	}
+	return nil
+}
+
+func (x *JWTConfig) GetJwksFiles() []string {
+	if x != nil {
+		return x.JwksFiles
+	}
+	return nil
+}
+
+func (x *JWTConfig) GetBoundIssuer() string {
+	if x != nil {
+		return x.BoundIssuer
+	}
+	return ""
+}
+
+func (x *JWTConfig) GetClockSkewLeewaySeconds() int64 {
+	if x != nil {
+		return x.ClockSkewLeewaySeconds
+	}
+	return 0
+}
+
+type PutJWTConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *JWTConfig             `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutJWTConfigRequest) Reset() {
+	*x = PutJWTConfigRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutJWTConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutJWTConfigRequest) ProtoMessage() {}
+
+func (x *PutJWTConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutJWTConfigRequest.ProtoReflect.Descriptor instead.
+func (*PutJWTConfigRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PutJWTConfigRequest) GetConfig() *JWTConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type PutJWTConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutJWTConfigResponse) Reset() {
+	*x = PutJWTConfigResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutJWTConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutJWTConfigResponse) ProtoMessage() {}
+
+func (x *PutJWTConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutJWTConfigResponse.ProtoReflect.Descriptor instead.
+func (*PutJWTConfigResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{35}
+}
+
+type JWTRole struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BoundIssuer string                 `protobuf:"bytes,2,opt,name=bound_issuer,json=boundIssuer,proto3" json:"bound_issuer,omitempty"`
+	// The token's "aud" must contain at least one of these.
+	BoundAudiences []string `protobuf:"bytes,3,rep,name=bound_audiences,json=boundAudiences,proto3" json:"bound_audiences,omitempty"`
+	BoundSubject   string   `protobuf:"bytes,4,opt,name=bound_subject,json=boundSubject,proto3" json:"bound_subject,omitempty"`
+	// Every listed claim must equal (or, for list claims, contain) the value.
+	BoundClaims map[string]string `protobuf:"bytes,5,rep,name=bound_claims,json=boundClaims,proto3" json:"bound_claims,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Claim used as the vault identity; defaults to "sub".
+	UserClaim       string   `protobuf:"bytes,6,opt,name=user_claim,json=userClaim,proto3" json:"user_claim,omitempty"`
+	TokenPolicies   []string `protobuf:"bytes,7,rep,name=token_policies,json=tokenPolicies,proto3" json:"token_policies,omitempty"`
+	TokenTtlSeconds int64    `protobuf:"varint,8,opt,name=token_ttl_seconds,json=tokenTtlSeconds,proto3" json:"token_ttl_seconds,omitempty"`
+	// Claim holding group names, and the policy granted for each group.
+	GroupsClaim   string            `protobuf:"bytes,9,opt,name=groups_claim,json=groupsClaim,proto3" json:"groups_claim,omitempty"`
+	GroupPolicies map[string]string `protobuf:"bytes,10,rep,name=group_policies,json=groupPolicies,proto3" json:"group_policies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWTRole) Reset() {
+	*x = JWTRole{}
+	mi := &file_v1_vault_vault_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWTRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWTRole) ProtoMessage() {}
+
+func (x *JWTRole) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWTRole.ProtoReflect.Descriptor instead.
+func (*JWTRole) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *JWTRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JWTRole) GetBoundIssuer() string {
+	if x != nil {
+		return x.BoundIssuer
+	}
+	return ""
+}
+
+func (x *JWTRole) GetBoundAudiences() []string {
+	if x != nil {
+		return x.BoundAudiences
+	}
+	return nil
+}
+
+func (x *JWTRole) GetBoundSubject() string {
+	if x != nil {
+		return x.BoundSubject
+	}
+	return ""
+}
+
+func (x *JWTRole) GetBoundClaims() map[string]string {
+	if x != nil {
+		return x.BoundClaims
+	}
+	return nil
+}
+
+func (x *JWTRole) GetUserClaim() string {
+	if x != nil {
+		return x.UserClaim
+	}
+	return ""
+}
+
+func (x *JWTRole) GetTokenPolicies() []string {
+	if x != nil {
+		return x.TokenPolicies
+	}
+	return nil
+}
+
+func (x *JWTRole) GetTokenTtlSeconds() int64 {
+	if x != nil {
+		return x.TokenTtlSeconds
+	}
+	return 0
+}
+
+func (x *JWTRole) GetGroupsClaim() string {
+	if x != nil {
+		return x.GroupsClaim
+	}
+	return ""
+}
+
+func (x *JWTRole) GetGroupPolicies() map[string]string {
+	if x != nil {
+		return x.GroupPolicies
+	}
+	return nil
+}
+
+type PutJWTRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *JWTRole               `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutJWTRoleRequest) Reset() {
+	*x = PutJWTRoleRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutJWTRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutJWTRoleRequest) ProtoMessage() {}
+
+func (x *PutJWTRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutJWTRoleRequest.ProtoReflect.Descriptor instead.
+func (*PutJWTRoleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PutJWTRoleRequest) GetRole() *JWTRole {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type PutJWTRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutJWTRoleResponse) Reset() {
+	*x = PutJWTRoleResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutJWTRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutJWTRoleResponse) ProtoMessage() {}
+
+func (x *PutJWTRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutJWTRoleResponse.ProtoReflect.Descriptor instead.
+func (*PutJWTRoleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{38}
+}
+
+type JWTLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Jwt           string                 `protobuf:"bytes,2,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWTLoginRequest) Reset() {
+	*x = JWTLoginRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWTLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWTLoginRequest) ProtoMessage() {}
+
+func (x *JWTLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWTLoginRequest.ProtoReflect.Descriptor instead.
+func (*JWTLoginRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *JWTLoginRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *JWTLoginRequest) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+// CPIConfig enables the "Refusal to Run" check: once trust keys are set,
+// reads must carry a CPI attestation token in the X-Olympus-CPI header.
+type CPIConfig struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	JwksUrls  []string               `protobuf:"bytes,1,rep,name=jwks_urls,json=jwksUrls,proto3" json:"jwks_urls,omitempty"`
+	JwksFiles []string               `protobuf:"bytes,2,rep,name=jwks_files,json=jwksFiles,proto3" json:"jwks_files,omitempty"`
+	Issuer    string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Audience  string                 `protobuf:"bytes,4,opt,name=audience,proto3" json:"audience,omitempty"`
+	// Global Prohibition Registry entries matched against the token's
+	// fingerprint, platform and subject.
+	Prohibited []string `protobuf:"bytes,5,rep,name=prohibited,proto3" json:"prohibited,omitempty"`
+	// Optional registry file, one entry per line; re-read on every check.
+	ProhibitionRegistryFile string `protobuf:"bytes,6,opt,name=prohibition_registry_file,json=prohibitionRegistryFile,proto3" json:"prohibition_registry_file,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CPIConfig) Reset() {
+	*x = CPIConfig{}
+	mi := &file_v1_vault_vault_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPIConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPIConfig) ProtoMessage() {}
+
+func (x *CPIConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPIConfig.ProtoReflect.Descriptor instead.
+func (*CPIConfig) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CPIConfig) GetJwksUrls() []string {
+	if x != nil {
+		return x.JwksUrls
+	}
+	return nil
+}
+
+func (x *CPIConfig) GetJwksFiles() []string {
+	if x != nil {
+		return x.JwksFiles
+	}
+	return nil
+}
+
+func (x *CPIConfig) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *CPIConfig) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *CPIConfig) GetProhibited() []string {
+	if x != nil {
+		return x.Prohibited
+	}
+	return nil
+}
+
+func (x *CPIConfig) GetProhibitionRegistryFile() string {
+	if x != nil {
+		return x.ProhibitionRegistryFile
+	}
+	return ""
+}
+
+type PutCPIConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *CPIConfig             `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutCPIConfigRequest) Reset() {
+	*x = PutCPIConfigRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutCPIConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutCPIConfigRequest) ProtoMessage() {}
+
+func (x *PutCPIConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutCPIConfigRequest.ProtoReflect.Descriptor instead.
+func (*PutCPIConfigRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PutCPIConfigRequest) GetConfig() *CPIConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type PutCPIConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutCPIConfigResponse) Reset() {
+	*x = PutCPIConfigResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutCPIConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutCPIConfigResponse) ProtoMessage() {}
+
+func (x *PutCPIConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutCPIConfigResponse.ProtoReflect.Descriptor instead.
+func (*PutCPIConfigResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{42}
+}
+
+// AccessWindow limits when a secret may be read. All set constraints must
+// hold for the window to be open.
+type AccessWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Cron expression "minute hour day-of-month month day-of-week"; every
+	// matching minute is open.
+	Schedule string `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// IANA zone the schedule is evaluated in; defaults to UTC.
+	TimeZone string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Absolute bounds in unix seconds; zero means unbounded.
+	NotBefore     int64 `protobuf:"varint,3,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter      int64 `protobuf:"varint,4,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessWindow) Reset() {
+	*x = AccessWindow{}
+	mi := &file_v1_vault_vault_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessWindow) ProtoMessage() {}
+
+func (x *AccessWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessWindow.ProtoReflect.Descriptor instead.
+func (*AccessWindow) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AccessWindow) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *AccessWindow) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *AccessWindow) GetNotBefore() int64 {
+	if x != nil {
+		return x.NotBefore
+	}
+	return 0
+}
+
+func (x *AccessWindow) GetNotAfter() int64 {
+	if x != nil {
+		return x.NotAfter
+	}
+	return 0
+}
+
+type PutAccessWindowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Omit to remove the window.
+	Window        *AccessWindow `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutAccessWindowRequest) Reset() {
+	*x = PutAccessWindowRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutAccessWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutAccessWindowRequest) ProtoMessage() {}
+
+func (x *PutAccessWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutAccessWindowRequest.ProtoReflect.Descriptor instead.
+func (*PutAccessWindowRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PutAccessWindowRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PutAccessWindowRequest) GetWindow() *AccessWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type PutAccessWindowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutAccessWindowResponse) Reset() {
+	*x = PutAccessWindowResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutAccessWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutAccessWindowResponse) ProtoMessage() {}
+
+func (x *PutAccessWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutAccessWindowResponse.ProtoReflect.Descriptor instead.
+func (*PutAccessWindowResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{45}
+}
+
+// ControlGroup requires a quorum of approvers before a secret is released.
+type ControlGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Distinct approvers needed, not counting the requester.
+	RequiredApprovals int32 `protobuf:"varint,1,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	// How long a request stays redeemable; defaults to one hour.
+	TtlSeconds    int64 `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlGroup) Reset() {
+	*x = ControlGroup{}
+	mi := &file_v1_vault_vault_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlGroup) ProtoMessage() {}
+
+func (x *ControlGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlGroup.ProtoReflect.Descriptor instead.
+func (*ControlGroup) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ControlGroup) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *ControlGroup) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type PutControlGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Omit to remove the control group.
+	ControlGroup  *ControlGroup `protobuf:"bytes,2,opt,name=control_group,json=controlGroup,proto3" json:"control_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutControlGroupRequest) Reset() {
+	*x = PutControlGroupRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutControlGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutControlGroupRequest) ProtoMessage() {}
+
+func (x *PutControlGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutControlGroupRequest.ProtoReflect.Descriptor instead.
+func (*PutControlGroupRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PutControlGroupRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PutControlGroupRequest) GetControlGroup() *ControlGroup {
+	if x != nil {
+		return x.ControlGroup
+	}
+	return nil
+}
+
+type PutControlGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutControlGroupResponse) Reset() {
+	*x = PutControlGroupResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutControlGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutControlGroupResponse) ProtoMessage() {}
+
+func (x *PutControlGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutControlGroupResponse.ProtoReflect.Descriptor instead.
+func (*PutControlGroupResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{48}
+}
+
+type AccessRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key   string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Principals as "<source>:<identity>", e.g. "token:alice"; tokens
+	// created by another token share its principal.
+	Requester         string   `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	Approvers         []string `protobuf:"bytes,4,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	RequiredApprovals int32    `protobuf:"varint,5,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+	// One of "pending", "approved" or "redeemed".
+	Status        string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ExpireTime    int64  `protobuf:"varint,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessRequest) Reset() {
+	*x = AccessRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRequest) ProtoMessage() {}
+
+func (x *AccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRequest.ProtoReflect.Descriptor instead.
+func (*AccessRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AccessRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetRequester() string {
+	if x != nil {
+		return x.Requester
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *AccessRequest) GetRequiredApprovals() int32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+func (x *AccessRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type ApproveAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAccessRequest) Reset() {
+	*x = ApproveAccessRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAccessRequest) ProtoMessage() {}
+
+func (x *ApproveAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAccessRequest.ProtoReflect.Descriptor instead.
+func (*ApproveAccessRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ApproveAccessRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetAccessRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessRequestRequest) Reset() {
+	*x = GetAccessRequestRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessRequestRequest) ProtoMessage() {}
+
+func (x *GetAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetAccessRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// BreakGlassRequest asks for emergency read access to a prefix. Callers
+// need create on sys/break-glass/<prefix>.
+type BreakGlassRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Secrets to open: a prefix ending in "/" covers everything below it,
+	// anything else a single secret.
+	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Justification string `protobuf:"bytes,2,opt,name=justification,proto3" json:"justification,omitempty"`
+	TicketId      string `protobuf:"bytes,3,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	// Defaults to one hour, at most four.
+	TtlSeconds    int64 `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreakGlassRequest) Reset() {
+	*x = BreakGlassRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreakGlassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakGlassRequest) ProtoMessage() {}
+
+func (x *BreakGlassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakGlassRequest.ProtoReflect.Descriptor instead.
+func (*BreakGlassRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BreakGlassRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *BreakGlassRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *BreakGlassRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *BreakGlassRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type BreakGlassSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prefix        string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Requester     string                 `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	Justification string                 `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
+	TicketId      string                 `protobuf:"bytes,5,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ExpireTime    int64                  `protobuf:"varint,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// "open" until acknowledged after the incident, then "closed".
+	Status          string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	AcknowledgedBy  string `protobuf:"bytes,9,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
+	Acknowledgement string `protobuf:"bytes,10,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BreakGlassSession) Reset() {
+	*x = BreakGlassSession{}
+	mi := &file_v1_vault_vault_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreakGlassSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakGlassSession) ProtoMessage() {}
+
+func (x *BreakGlassSession) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakGlassSession.ProtoReflect.Descriptor instead.
+func (*BreakGlassSession) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BreakGlassSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BreakGlassSession) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *BreakGlassSession) GetRequester() string {
+	if x != nil {
+		return x.Requester
+	}
+	return ""
+}
+
+func (x *BreakGlassSession) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *BreakGlassSession) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *BreakGlassSession) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *BreakGlassSession) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *BreakGlassSession) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BreakGlassSession) GetAcknowledgedBy() string {
+	if x != nil {
+		return x.AcknowledgedBy
+	}
+	return ""
+}
+
+func (x *BreakGlassSession) GetAcknowledgement() string {
+	if x != nil {
+		return x.Acknowledgement
+	}
+	return ""
+}
+
+type BreakGlassResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Session *BreakGlassSession     `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	// Token granting read and list on the prefix until expire_time.
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreakGlassResponse) Reset() {
+	*x = BreakGlassResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreakGlassResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakGlassResponse) ProtoMessage() {}
+
+func (x *BreakGlassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakGlassResponse.ProtoReflect.Descriptor instead.
+func (*BreakGlassResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BreakGlassResponse) GetSession() *BreakGlassSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *BreakGlassResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AcknowledgeBreakGlassRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Post-incident review note; required.
+	Note          string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeBreakGlassRequest) Reset() {
+	*x = AcknowledgeBreakGlassRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeBreakGlassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeBreakGlassRequest) ProtoMessage() {}
+
+func (x *AcknowledgeBreakGlassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeBreakGlassRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeBreakGlassRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AcknowledgeBreakGlassRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AcknowledgeBreakGlassRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ListBreakGlassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IncludeClosed bool                   `protobuf:"varint,1,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBreakGlassRequest) Reset() {
+	*x = ListBreakGlassRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBreakGlassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBreakGlassRequest) ProtoMessage() {}
+
+func (x *ListBreakGlassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBreakGlassRequest.ProtoReflect.Descriptor instead.
+func (*ListBreakGlassRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListBreakGlassRequest) GetIncludeClosed() bool {
+	if x != nil {
+		return x.IncludeClosed
+	}
+	return false
+}
+
+type ListBreakGlassResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*BreakGlassSession   `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBreakGlassResponse) Reset() {
+	*x = ListBreakGlassResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBreakGlassResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBreakGlassResponse) ProtoMessage() {}
+
+func (x *ListBreakGlassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBreakGlassResponse.ProtoReflect.Descriptor instead.
+func (*ListBreakGlassResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListBreakGlassResponse) GetSessions() []*BreakGlassSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type GetAccessHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Most recent records to return; defaults to 100.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessHistoryRequest) Reset() {
+	*x = GetAccessHistoryRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessHistoryRequest) ProtoMessage() {}
+
+func (x *GetAccessHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetAccessHistoryRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetAccessHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AccessRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Identity      string                 `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Outcome       string                 `protobuf:"bytes,7,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessRecord) Reset() {
+	*x = AccessRecord{}
+	mi := &file_v1_vault_vault_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRecord) ProtoMessage() {}
+
+func (x *AccessRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRecord.ProtoReflect.Descriptor instead.
+func (*AccessRecord) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AccessRecord) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *AccessRecord) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AccessRecord) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AccessRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetAccessHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Records       []*AccessRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessHistoryResponse) Reset() {
+	*x = GetAccessHistoryResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessHistoryResponse) ProtoMessage() {}
+
+func (x *GetAccessHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetAccessHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetAccessHistoryResponse) GetRecords() []*AccessRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type GetSecretMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretMetadataRequest) Reset() {
+	*x = GetSecretMetadataRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretMetadataRequest) ProtoMessage() {}
+
+func (x *GetSecretMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetSecretMetadataRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type VersionMetadata struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Version    int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	CreateTime int64                  `protobuf:"varint,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Zero when the version does not expire.
+	ExpireTime int64 `protobuf:"varint,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	Destroyed  bool  `protobuf:"varint,4,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
+	// The CryptoKeyVersion the payload is encrypted with, if any.
+	KmsKeyVersionName string `protobuf:"bytes,5,opt,name=kms_key_version_name,json=kmsKeyVersionName,proto3" json:"kms_key_version_name,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VersionMetadata) Reset() {
+	*x = VersionMetadata{}
+	mi := &file_v1_vault_vault_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionMetadata) ProtoMessage() {}
+
+func (x *VersionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionMetadata.ProtoReflect.Descriptor instead.
+func (*VersionMetadata) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *VersionMetadata) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *VersionMetadata) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *VersionMetadata) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *VersionMetadata) GetDestroyed() bool {
+	if x != nil {
+		return x.Destroyed
+	}
+	return false
+}
+
+func (x *VersionMetadata) GetKmsKeyVersionName() string {
+	if x != nil {
+		return x.KmsKeyVersionName
+	}
+	return ""
+}
+
+type SecretMetadata struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Key            string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	CurrentVersion int32                  `protobuf:"varint,2,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	// Zero when the secret as a whole does not expire.
+	ExpireTime int64              `protobuf:"varint,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	Versions   []*VersionMetadata `protobuf:"bytes,4,rep,name=versions,proto3" json:"versions,omitempty"`
+	// The policy in force, whether the secret's own or the default.
+	Retention *RetentionPolicy `protobuf:"bytes,5,opt,name=retention,proto3" json:"retention,omitempty"`
+	Topics    []*Topic         `protobuf:"bytes,6,rep,name=topics,proto3" json:"topics,omitempty"`
+	// CryptoKey new versions are encrypted with.
+	KmsKeyName    string `protobuf:"bytes,7,opt,name=kms_key_name,json=kmsKeyName,proto3" json:"kms_key_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretMetadata) Reset() {
+	*x = SecretMetadata{}
+	mi := &file_v1_vault_vault_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretMetadata) ProtoMessage() {}
+
+func (x *SecretMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretMetadata.ProtoReflect.Descriptor instead.
+func (*SecretMetadata) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SecretMetadata) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetCurrentVersion() int32 {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return 0
+}
+
+func (x *SecretMetadata) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *SecretMetadata) GetVersions() []*VersionMetadata {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *SecretMetadata) GetRetention() *RetentionPolicy {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *SecretMetadata) GetTopics() []*Topic {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *SecretMetadata) GetKmsKeyName() string {
+	if x != nil {
+		return x.KmsKeyName
+	}
+	return ""
+}
+
+// UpdateSecretMetadataRequest sets when the whole secret expires. Leave
+// both fields zero to clear the expiry.
+type UpdateSecretMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	TtlSeconds    int64                  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	ExpireTime    int64                  `protobuf:"varint,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecretMetadataRequest) Reset() {
+	*x = UpdateSecretMetadataRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretMetadataRequest) ProtoMessage() {}
+
+func (x *UpdateSecretMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretMetadataRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSecretMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateSecretMetadataRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UpdateSecretMetadataRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *UpdateSecretMetadataRequest) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+// RetentionPolicy bounds how many old payloads a secret keeps. Pruned
+// versions are destroyed but keep their number and metadata.
+type RetentionPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Live versions kept; zero means unlimited.
+	MaxVersions int32 `protobuf:"varint,1,opt,name=max_versions,json=maxVersions,proto3" json:"max_versions,omitempty"`
+	// Versions older than this are destroyed; zero means no limit.
+	MaxAgeSeconds int64 `protobuf:"varint,2,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	// The newest N live versions survive regardless of the limits above.
+	// The current version is always kept.
+	KeepLast      int32 `protobuf:"varint,3,opt,name=keep_last,json=keepLast,proto3" json:"keep_last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	mi := &file_v1_vault_vault_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RetentionPolicy) GetMaxVersions() int32 {
+	if x != nil {
+		return x.MaxVersions
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetMaxAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetKeepLast() int32 {
+	if x != nil {
+		return x.KeepLast
+	}
+	return 0
+}
+
+type PutRetentionPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Secret the policy applies to; empty sets the default for all secrets.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Omit to remove the policy.
+	Policy        *RetentionPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutRetentionPolicyRequest) Reset() {
+	*x = PutRetentionPolicyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *PutRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*PutRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PutRetentionPolicyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PutRetentionPolicyRequest) GetPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type PutRetentionPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutRetentionPolicyResponse) Reset() {
+	*x = PutRetentionPolicyResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRetentionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRetentionPolicyResponse) ProtoMessage() {}
+
+func (x *PutRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRetentionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*PutRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{67}
+}
+
+// ReencryptSecretsRequest re-encrypts every secret version protected by a
+// CryptoKey with its current primary version. Changing the primary version
+// already does this; the request retries versions that could not be
+// decrypted at the time, e.g. once their key version is re-enabled.
+type ReencryptSecretsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KmsKeyName    string                 `protobuf:"bytes,1,opt,name=kms_key_name,json=kmsKeyName,proto3" json:"kms_key_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReencryptSecretsRequest) Reset() {
+	*x = ReencryptSecretsRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReencryptSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReencryptSecretsRequest) ProtoMessage() {}
+
+func (x *ReencryptSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReencryptSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ReencryptSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReencryptSecretsRequest) GetKmsKeyName() string {
+	if x != nil {
+		return x.KmsKeyName
+	}
+	return ""
+}
+
+type ReencryptSecretsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Reencrypted int32                  `protobuf:"varint,1,opt,name=reencrypted,proto3" json:"reencrypted,omitempty"`
+	// "<key>@<version>" of versions that could not be decrypted, such as
+	// those whose key version is disabled.
+	Failed        []string `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReencryptSecretsResponse) Reset() {
+	*x = ReencryptSecretsResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReencryptSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReencryptSecretsResponse) ProtoMessage() {}
+
+func (x *ReencryptSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReencryptSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ReencryptSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ReencryptSecretsResponse) GetReencrypted() int32 {
+	if x != nil {
+		return x.Reencrypted
+	}
+	return 0
+}
+
+func (x *ReencryptSecretsResponse) GetFailed() []string {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+// Rotator produces a secret's next value: either a local executable that
+// prints it on stdout, or a RotatorService endpoint.
+type Rotator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Command       []string               `protobuf:"bytes,1,rep,name=command,proto3" json:"command,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rotator) Reset() {
+	*x = Rotator{}
+	mi := &file_v1_vault_vault_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rotator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rotator) ProtoMessage() {}
+
+func (x *Rotator) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rotator.ProtoReflect.Descriptor instead.
+func (*Rotator) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Rotator) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *Rotator) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type Rotation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PeriodSeconds    int64                  `protobuf:"varint,1,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	NextRotationTime int64                  `protobuf:"varint,2,opt,name=next_rotation_time,json=nextRotationTime,proto3" json:"next_rotation_time,omitempty"`
+	Rotator          *Rotator               `protobuf:"bytes,3,opt,name=rotator,proto3" json:"rotator,omitempty"`
+	// Filled in by the vault.
+	LastRotationTime    int64  `protobuf:"varint,4,opt,name=last_rotation_time,json=lastRotationTime,proto3" json:"last_rotation_time,omitempty"`
+	LastError           string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	ConsecutiveFailures int32  `protobuf:"varint,6,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Rotation) Reset() {
+	*x = Rotation{}
+	mi := &file_v1_vault_vault_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rotation) ProtoMessage() {}
+
+func (x *Rotation) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rotation.ProtoReflect.Descriptor instead.
+func (*Rotation) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Rotation) GetPeriodSeconds() int64 {
+	if x != nil {
+		return x.PeriodSeconds
+	}
+	return 0
+}
+
+func (x *Rotation) GetNextRotationTime() int64 {
+	if x != nil {
+		return x.NextRotationTime
+	}
+	return 0
+}
+
+func (x *Rotation) GetRotator() *Rotator {
+	if x != nil {
+		return x.Rotator
+	}
+	return nil
+}
+
+func (x *Rotation) GetLastRotationTime() int64 {
+	if x != nil {
+		return x.LastRotationTime
+	}
+	return 0
+}
+
+func (x *Rotation) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Rotation) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+type PutRotationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Omit to stop rotating the secret.
+	Rotation      *Rotation `protobuf:"bytes,2,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutRotationRequest) Reset() {
+	*x = PutRotationRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRotationRequest) ProtoMessage() {}
+
+func (x *PutRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRotationRequest.ProtoReflect.Descriptor instead.
+func (*PutRotationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PutRotationRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PutRotationRequest) GetRotation() *Rotation {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+type GetRotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRotationRequest) Reset() {
+	*x = GetRotationRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRotationRequest) ProtoMessage() {}
+
+func (x *GetRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRotationRequest.ProtoReflect.Descriptor instead.
+func (*GetRotationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetRotationRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type RotateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Key            string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	CurrentVersion int32                  `protobuf:"varint,2,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RotateRequest) Reset() {
+	*x = RotateRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateRequest) ProtoMessage() {}
+
+func (x *RotateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateRequest.ProtoReflect.Descriptor instead.
+func (*RotateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RotateRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RotateRequest) GetCurrentVersion() int32 {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return 0
+}
+
+type RotateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateResponse) Reset() {
+	*x = RotateResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateResponse) ProtoMessage() {}
+
+func (x *RotateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateResponse.ProtoReflect.Descriptor instead.
+func (*RotateResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RotateResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Topic receives SECRET_ROTATE and SECRET_VERSION_ADD events for a secret,
+// published either to a Pub/Sub emulator or to a plain webhook.
+type Topic struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pub/Sub topic name, "projects/<project>/topics/<topic>".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Base URL of a Pub/Sub emulator, e.g. http://localhost:8085.
+	PubsubEndpoint string `protobuf:"bytes,2,opt,name=pubsub_endpoint,json=pubsubEndpoint,proto3" json:"pubsub_endpoint,omitempty"`
+	// Used instead of pubsub_endpoint to POST events as JSON.
+	WebhookUrl    string `protobuf:"bytes,3,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Topic) Reset() {
+	*x = Topic{}
+	mi := &file_v1_vault_vault_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Topic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topic) ProtoMessage() {}
+
+func (x *Topic) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
+func (*Topic) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Topic) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Topic) GetPubsubEndpoint() string {
+	if x != nil {
+		return x.PubsubEndpoint
+	}
+	return ""
+}
+
+func (x *Topic) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+type PutSecretTopicsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Replaces the secret's bindings; empty removes them.
+	Topics        []*Topic `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutSecretTopicsRequest) Reset() {
+	*x = PutSecretTopicsRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutSecretTopicsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutSecretTopicsRequest) ProtoMessage() {}
+
+func (x *PutSecretTopicsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutSecretTopicsRequest.ProtoReflect.Descriptor instead.
+func (*PutSecretTopicsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PutSecretTopicsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PutSecretTopicsRequest) GetTopics() []*Topic {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type PutSecretTopicsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutSecretTopicsResponse) Reset() {
+	*x = PutSecretTopicsResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutSecretTopicsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutSecretTopicsResponse) ProtoMessage() {}
+
+func (x *PutSecretTopicsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutSecretTopicsResponse.ProtoReflect.Descriptor instead.
+func (*PutSecretTopicsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{78}
+}
+
+// Webhook receives lifecycle events for secrets under prefix as JSON POSTs
+// signed with HMAC-SHA256: the X-Vault-Signature header carries
+// "sha256=" + hex(HMAC(secret, X-Vault-Timestamp + "." + body)).
+type Webhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Signing key; write-only. It is sealed at rest and never returned.
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Prefix string `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Event types to deliver, e.g. SECRET_CREATE, SECRET_VERSION_ADD,
+	// SECRET_UPDATE, SECRET_DELETE, SECRET_ROTATE, SECRET_VERSION_DESTROY.
+	// Empty means all.
+	Events        []string `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_v1_vault_vault_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Webhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type PutWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutWebhookRequest) Reset() {
+	*x = PutWebhookRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutWebhookRequest) ProtoMessage() {}
+
+func (x *PutWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutWebhookRequest.ProtoReflect.Descriptor instead.
+func (*PutWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PutWebhookRequest) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type PutWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutWebhookResponse) Reset() {
+	*x = PutWebhookResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutWebhookResponse) ProtoMessage() {}
+
+func (x *PutWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutWebhookResponse.ProtoReflect.Descriptor instead.
+func (*PutWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{81}
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteWebhookRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{83}
+}
+
+type ListDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "pending" (default) or "dead" for dead-lettered deliveries.
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// Only deliveries to this webhook or topic name.
+	Target        string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveriesRequest) Reset() {
+	*x = ListDeliveriesRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListDeliveriesRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListDeliveriesRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type Delivery struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Target          string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	EventType       string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Key             string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Version         int32                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Attempts        int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptTime int64                  `protobuf:"varint,7,opt,name=next_attempt_time,json=nextAttemptTime,proto3" json:"next_attempt_time,omitempty"`
+	LastError       string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	State           string                 `protobuf:"bytes,9,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Delivery) Reset() {
+	*x = Delivery{}
+	mi := &file_v1_vault_vault_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delivery) ProtoMessage() {}
+
+func (x *Delivery) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delivery.ProtoReflect.Descriptor instead.
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Delivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Delivery) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Delivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *Delivery) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Delivery) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Delivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Delivery) GetNextAttemptTime() int64 {
+	if x != nil {
+		return x.NextAttemptTime
+	}
+	return 0
+}
+
+func (x *Delivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Delivery) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type ListDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*Delivery            `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveriesResponse) Reset() {
+	*x = ListDeliveriesResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListDeliveriesResponse) GetDeliveries() []*Delivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// DatabaseConfig is a connection the database engine creates users on.
+type DatabaseConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Driver plugin: any database/sql driver linked into VaultManager, e.g.
+	// "pgx" for PostgreSQL or "sqlite", or "embedded", which runs the
+	// statements on the SQLite file at connection_url.
+	Plugin string `protobuf:"bytes,2,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// Write-only; may hold credentials, so it is sealed at rest.
+	ConnectionUrl string `protobuf:"bytes,3,opt,name=connection_url,json=connectionUrl,proto3" json:"connection_url,omitempty"`
+	// Roles allowed to use this connection; "*" allows all.
+	AllowedRoles  []string `protobuf:"bytes,4,rep,name=allowed_roles,json=allowedRoles,proto3" json:"allowed_roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseConfig) Reset() {
+	*x = DatabaseConfig{}
+	mi := &file_v1_vault_vault_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseConfig) ProtoMessage() {}
+
+func (x *DatabaseConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseConfig.ProtoReflect.Descriptor instead.
+func (*DatabaseConfig) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DatabaseConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseConfig) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *DatabaseConfig) GetConnectionUrl() string {
+	if x != nil {
+		return x.ConnectionUrl
+	}
+	return ""
+}
+
+func (x *DatabaseConfig) GetAllowedRoles() []string {
+	if x != nil {
+		return x.AllowedRoles
+	}
+	return nil
+}
+
+type PutDatabaseConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *DatabaseConfig        `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutDatabaseConfigRequest) Reset() {
+	*x = PutDatabaseConfigRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDatabaseConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDatabaseConfigRequest) ProtoMessage() {}
+
+func (x *PutDatabaseConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDatabaseConfigRequest.ProtoReflect.Descriptor instead.
+func (*PutDatabaseConfigRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *PutDatabaseConfigRequest) GetConfig() *DatabaseConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type PutDatabaseConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutDatabaseConfigResponse) Reset() {
+	*x = PutDatabaseConfigResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDatabaseConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDatabaseConfigResponse) ProtoMessage() {}
+
+func (x *PutDatabaseConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDatabaseConfigResponse.ProtoReflect.Descriptor instead.
+func (*PutDatabaseConfigResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{89}
+}
+
+// DatabaseRole templates the users created for GetDatabaseCredentials.
+// Statements may reference {{name}}, {{password}} and {{expiration}};
+// renew statements run with the new expiration when the lease is renewed.
+// Each statement is executed as written, in one transaction per operation.
+type DatabaseRole struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DbName               string                 `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CreationStatements   []string               `protobuf:"bytes,3,rep,name=creation_statements,json=creationStatements,proto3" json:"creation_statements,omitempty"`
+	RevocationStatements []string               `protobuf:"bytes,4,rep,name=revocation_statements,json=revocationStatements,proto3" json:"revocation_statements,omitempty"`
+	DefaultTtlSeconds    int64                  `protobuf:"varint,5,opt,name=default_ttl_seconds,json=defaultTtlSeconds,proto3" json:"default_ttl_seconds,omitempty"`
+	MaxTtlSeconds        int64                  `protobuf:"varint,6,opt,name=max_ttl_seconds,json=maxTtlSeconds,proto3" json:"max_ttl_seconds,omitempty"`
+	RenewStatements      []string               `protobuf:"bytes,7,rep,name=renew_statements,json=renewStatements,proto3" json:"renew_statements,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DatabaseRole) Reset() {
+	*x = DatabaseRole{}
+	mi := &file_v1_vault_vault_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseRole) ProtoMessage() {}
+
+func (x *DatabaseRole) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseRole.ProtoReflect.Descriptor instead.
+func (*DatabaseRole) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DatabaseRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DatabaseRole) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *DatabaseRole) GetCreationStatements() []string {
+	if x != nil {
+		return x.CreationStatements
+	}
+	return nil
+}
+
+func (x *DatabaseRole) GetRevocationStatements() []string {
+	if x != nil {
+		return x.RevocationStatements
+	}
+	return nil
+}
+
+func (x *DatabaseRole) GetDefaultTtlSeconds() int64 {
+	if x != nil {
+		return x.DefaultTtlSeconds
+	}
+	return 0
+}
+
+func (x *DatabaseRole) GetMaxTtlSeconds() int64 {
+	if x != nil {
+		return x.MaxTtlSeconds
+	}
+	return 0
+}
+
+func (x *DatabaseRole) GetRenewStatements() []string {
+	if x != nil {
+		return x.RenewStatements
+	}
+	return nil
+}
+
+type PutDatabaseRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *DatabaseRole          `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutDatabaseRoleRequest) Reset() {
+	*x = PutDatabaseRoleRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDatabaseRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDatabaseRoleRequest) ProtoMessage() {}
+
+func (x *PutDatabaseRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDatabaseRoleRequest.ProtoReflect.Descriptor instead.
+func (*PutDatabaseRoleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PutDatabaseRoleRequest) GetRole() *DatabaseRole {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type PutDatabaseRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutDatabaseRoleResponse) Reset() {
+	*x = PutDatabaseRoleResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutDatabaseRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutDatabaseRoleResponse) ProtoMessage() {}
+
+func (x *PutDatabaseRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutDatabaseRoleResponse.ProtoReflect.Descriptor instead.
+func (*PutDatabaseRoleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{92}
+}
+
+type GetDatabaseCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDatabaseCredentialsRequest) Reset() {
+	*x = GetDatabaseCredentialsRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDatabaseCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDatabaseCredentialsRequest) ProtoMessage() {}
+
+func (x *GetDatabaseCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDatabaseCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*GetDatabaseCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetDatabaseCredentialsRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type DatabaseCredentials struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Username             string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	LeaseId              string                 `protobuf:"bytes,3,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	LeaseDurationSeconds int64                  `protobuf:"varint,4,opt,name=lease_duration_seconds,json=leaseDurationSeconds,proto3" json:"lease_duration_seconds,omitempty"`
+	ExpireTime           int64                  `protobuf:"varint,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DatabaseCredentials) Reset() {
+	*x = DatabaseCredentials{}
+	mi := &file_v1_vault_vault_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseCredentials) ProtoMessage() {}
+
+func (x *DatabaseCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseCredentials.ProtoReflect.Descriptor instead.
+func (*DatabaseCredentials) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DatabaseCredentials) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DatabaseCredentials) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DatabaseCredentials) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *DatabaseCredentials) GetLeaseDurationSeconds() int64 {
+	if x != nil {
+		return x.LeaseDurationSeconds
+	}
+	return 0
+}
+
+func (x *DatabaseCredentials) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+// Lease tracks a dynamic credential or token until it expires or is
+// revoked.
+type Lease struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId    string                 `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	IssueTime  int64                  `protobuf:"varint,2,opt,name=issue_time,json=issueTime,proto3" json:"issue_time,omitempty"`
+	ExpireTime int64                  `protobuf:"varint,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Latest time renewals can extend the lease to.
+	MaxExpireTime  int64  `protobuf:"varint,4,opt,name=max_expire_time,json=maxExpireTime,proto3" json:"max_expire_time,omitempty"`
+	Renewable      bool   `protobuf:"varint,5,opt,name=renewable,proto3" json:"renewable,omitempty"`
+	RevokeAttempts int32  `protobuf:"varint,6,opt,name=revoke_attempts,json=revokeAttempts,proto3" json:"revoke_attempts,omitempty"`
+	LastError      string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Lease) Reset() {
+	*x = Lease{}
+	mi := &file_v1_vault_vault_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lease) ProtoMessage() {}
+
+func (x *Lease) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
+func (*Lease) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Lease) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *Lease) GetIssueTime() int64 {
+	if x != nil {
+		return x.IssueTime
+	}
+	return 0
+}
+
+func (x *Lease) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *Lease) GetMaxExpireTime() int64 {
+	if x != nil {
+		return x.MaxExpireTime
+	}
+	return 0
+}
+
+func (x *Lease) GetRenewable() bool {
+	if x != nil {
+		return x.Renewable
+	}
+	return false
+}
+
+func (x *Lease) GetRevokeAttempts() int32 {
+	if x != nil {
+		return x.RevokeAttempts
+	}
+	return 0
+}
+
+func (x *Lease) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type RenewLeaseRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId string                 `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// Requested extension from now; defaults to the lease's original TTL.
+	IncrementSeconds int64 `protobuf:"varint,2,opt,name=increment_seconds,json=incrementSeconds,proto3" json:"increment_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RenewLeaseRequest) Reset() {
+	*x = RenewLeaseRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLeaseRequest) ProtoMessage() {}
+
+func (x *RenewLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLeaseRequest.ProtoReflect.Descriptor instead.
+func (*RenewLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RenewLeaseRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *RenewLeaseRequest) GetIncrementSeconds() int64 {
+	if x != nil {
+		return x.IncrementSeconds
+	}
+	return 0
+}
+
+type RevokeLeaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId       string                 `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeLeaseRequest) Reset() {
+	*x = RevokeLeaseRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLeaseRequest) ProtoMessage() {}
+
+func (x *RevokeLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLeaseRequest.ProtoReflect.Descriptor instead.
+func (*RevokeLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RevokeLeaseRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+type RevokeLeaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeLeaseResponse) Reset() {
+	*x = RevokeLeaseResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLeaseResponse) ProtoMessage() {}
+
+func (x *RevokeLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLeaseResponse.ProtoReflect.Descriptor instead.
+func (*RevokeLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{98}
+}
+
+type RevokePrefixRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// e.g. "database/creds/readonly/" or "auth/token/".
+	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePrefixRequest) Reset() {
+	*x = RevokePrefixRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePrefixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePrefixRequest) ProtoMessage() {}
+
+func (x *RevokePrefixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePrefixRequest.ProtoReflect.Descriptor instead.
+func (*RevokePrefixRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RevokePrefixRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type RevokePrefixResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Revoked int32                  `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// Leases whose engine could not revoke them; they are retried by the
+	// expiration scheduler.
+	Failed        []string `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePrefixResponse) Reset() {
+	*x = RevokePrefixResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePrefixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePrefixResponse) ProtoMessage() {}
+
+func (x *RevokePrefixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePrefixResponse.ProtoReflect.Descriptor instead.
+func (*RevokePrefixResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RevokePrefixResponse) GetRevoked() int32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+func (x *RevokePrefixResponse) GetFailed() []string {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+// PasswordPolicy describes how GenerateSecret builds a value.
+type PasswordPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// "password" (default), "passphrase" or "api_key".
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Characters of a password, words of a passphrase, or random characters
+	// after the prefix of an API key. Defaults to 20, 6 and 32.
+	Length int32 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	// Password character classes; the password draws from their union and
+	// contains at least min_chars of each. Defaults to lower and upper case
+	// letters, digits and symbols, one of each.
+	Rules []*CharsetRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	// Passphrase word list and the separator between words (default "-").
+	Words     []string `protobuf:"bytes,5,rep,name=words,proto3" json:"words,omitempty"`
+	Separator string   `protobuf:"bytes,6,opt,name=separator,proto3" json:"separator,omitempty"`
+	// Fixed prefix of an API key, e.g. "olp_".
+	Prefix        string `protobuf:"bytes,7,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordPolicy) Reset() {
+	*x = PasswordPolicy{}
+	mi := &file_v1_vault_vault_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordPolicy) ProtoMessage() {}
+
+func (x *PasswordPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordPolicy.ProtoReflect.Descriptor instead.
+func (*PasswordPolicy) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PasswordPolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PasswordPolicy) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *PasswordPolicy) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetRules() []*CharsetRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *PasswordPolicy) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *PasswordPolicy) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *PasswordPolicy) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type CharsetRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Charset       string                 `protobuf:"bytes,1,opt,name=charset,proto3" json:"charset,omitempty"`
+	MinChars      int32                  `protobuf:"varint,2,opt,name=min_chars,json=minChars,proto3" json:"min_chars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharsetRule) Reset() {
+	*x = CharsetRule{}
+	mi := &file_v1_vault_vault_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharsetRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharsetRule) ProtoMessage() {}
+
+func (x *CharsetRule) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharsetRule.ProtoReflect.Descriptor instead.
+func (*CharsetRule) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CharsetRule) GetCharset() string {
+	if x != nil {
+		return x.Charset
+	}
+	return ""
+}
+
+func (x *CharsetRule) GetMinChars() int32 {
+	if x != nil {
+		return x.MinChars
+	}
+	return 0
+}
+
+type PutPasswordPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *PasswordPolicy        `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutPasswordPolicyRequest) Reset() {
+	*x = PutPasswordPolicyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutPasswordPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutPasswordPolicyRequest) ProtoMessage() {}
+
+func (x *PutPasswordPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutPasswordPolicyRequest.ProtoReflect.Descriptor instead.
+func (*PutPasswordPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *PutPasswordPolicyRequest) GetPolicy() *PasswordPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type PutPasswordPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutPasswordPolicyResponse) Reset() {
+	*x = PutPasswordPolicyResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutPasswordPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutPasswordPolicyResponse) ProtoMessage() {}
+
+func (x *PutPasswordPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutPasswordPolicyResponse.ProtoReflect.Descriptor instead.
+func (*PutPasswordPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{104}
+}
+
+type GetPasswordPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPasswordPolicyRequest) Reset() {
+	*x = GetPasswordPolicyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPasswordPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPasswordPolicyRequest) ProtoMessage() {}
+
+func (x *GetPasswordPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPasswordPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPasswordPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetPasswordPolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GenerateSecretRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Policy string                 `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Secret to store the value in as a new version. The value is then not
+	// returned to the caller.
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	TtlSeconds    int64  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	ExpireTime    int64  `protobuf:"varint,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	KmsKeyName    string `protobuf:"bytes,5,opt,name=kms_key_name,json=kmsKeyName,proto3" json:"kms_key_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSecretRequest) Reset() {
+	*x = GenerateSecretRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSecretRequest) ProtoMessage() {}
+
+func (x *GenerateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSecretRequest.ProtoReflect.Descriptor instead.
+func (*GenerateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GenerateSecretRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *GenerateSecretRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GenerateSecretRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *GenerateSecretRequest) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *GenerateSecretRequest) GetKmsKeyName() string {
+	if x != nil {
+		return x.KmsKeyName
+	}
+	return ""
+}
+
+type GenerateSecretResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty when the value was written to key.
+	Value         string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Version       int32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	ExpireTime    int64  `protobuf:"varint,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSecretResponse) Reset() {
+	*x = GenerateSecretResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSecretResponse) ProtoMessage() {}
+
+func (x *GenerateSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSecretResponse.ProtoReflect.Descriptor instead.
+func (*GenerateSecretResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GenerateSecretResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *GenerateSecretResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GenerateSecretResponse) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type TransitKeyVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitKeyVersion) Reset() {
+	*x = TransitKeyVersion{}
+	mi := &file_v1_vault_vault_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitKeyVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitKeyVersion) ProtoMessage() {}
+
+func (x *TransitKeyVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitKeyVersion.ProtoReflect.Descriptor instead.
+func (*TransitKeyVersion) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *TransitKeyVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TransitKeyVersion) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type TransitKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// "aes256-gcm96", "ed25519", "ecdsa-p256", "rsa-2048" or "rsa-4096".
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	LatestVersion int32  `protobuf:"varint,3,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// Ciphertexts from older versions are rejected.
+	MinDecryptionVersion int32 `protobuf:"varint,4,opt,name=min_decryption_version,json=minDecryptionVersion,proto3" json:"min_decryption_version,omitempty"`
+	// Encryption requires a context, from which a per-context key is derived.
+	Derived bool `protobuf:"varint,5,opt,name=derived,proto3" json:"derived,omitempty"`
+	// Identical plaintext and context always produce identical ciphertext.
+	ConvergentEncryption bool                 `protobuf:"varint,6,opt,name=convergent_encryption,json=convergentEncryption,proto3" json:"convergent_encryption,omitempty"`
+	Versions             []*TransitKeyVersion `protobuf:"bytes,7,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TransitKey) Reset() {
+	*x = TransitKey{}
+	mi := &file_v1_vault_vault_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitKey) ProtoMessage() {}
+
+func (x *TransitKey) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitKey.ProtoReflect.Descriptor instead.
+func (*TransitKey) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *TransitKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitKey) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TransitKey) GetLatestVersion() int32 {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return 0
+}
+
+func (x *TransitKey) GetMinDecryptionVersion() int32 {
+	if x != nil {
+		return x.MinDecryptionVersion
+	}
+	return 0
+}
+
+func (x *TransitKey) GetDerived() bool {
+	if x != nil {
+		return x.Derived
+	}
+	return false
+}
+
+func (x *TransitKey) GetConvergentEncryption() bool {
+	if x != nil {
+		return x.ConvergentEncryption
+	}
+	return false
+}
+
+func (x *TransitKey) GetVersions() []*TransitKeyVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type CreateTransitKeyRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type    string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Derived bool                   `protobuf:"varint,3,opt,name=derived,proto3" json:"derived,omitempty"`
+	// Requires derived.
+	ConvergentEncryption bool `protobuf:"varint,4,opt,name=convergent_encryption,json=convergentEncryption,proto3" json:"convergent_encryption,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateTransitKeyRequest) Reset() {
+	*x = CreateTransitKeyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransitKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransitKeyRequest) ProtoMessage() {}
+
+func (x *CreateTransitKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransitKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransitKeyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CreateTransitKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTransitKeyRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateTransitKeyRequest) GetDerived() bool {
+	if x != nil {
+		return x.Derived
+	}
+	return false
+}
+
+func (x *CreateTransitKeyRequest) GetConvergentEncryption() bool {
+	if x != nil {
+		return x.ConvergentEncryption
+	}
+	return false
+}
+
+type GetTransitKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransitKeyRequest) Reset() {
+	*x = GetTransitKeyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransitKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransitKeyRequest) ProtoMessage() {}
+
+func (x *GetTransitKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransitKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetTransitKeyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetTransitKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RotateTransitKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateTransitKeyRequest) Reset() {
+	*x = RotateTransitKeyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTransitKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTransitKeyRequest) ProtoMessage() {}
+
+func (x *RotateTransitKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTransitKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateTransitKeyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RotateTransitKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateTransitKeyConfigRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MinDecryptionVersion int32                  `protobuf:"varint,2,opt,name=min_decryption_version,json=minDecryptionVersion,proto3" json:"min_decryption_version,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateTransitKeyConfigRequest) Reset() {
+	*x = UpdateTransitKeyConfigRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransitKeyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransitKeyConfigRequest) ProtoMessage() {}
+
+func (x *UpdateTransitKeyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransitKeyConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransitKeyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *UpdateTransitKeyConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTransitKeyConfigRequest) GetMinDecryptionVersion() int32 {
+	if x != nil {
+		return x.MinDecryptionVersion
+	}
+	return 0
+}
+
+type TransitEncryptRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Plaintext []byte                 `protobuf:"bytes,2,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	// Required for derived keys.
+	Context []byte `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	// Defaults to the latest version.
+	KeyVersion    int32 `protobuf:"varint,4,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitEncryptRequest) Reset() {
+	*x = TransitEncryptRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitEncryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitEncryptRequest) ProtoMessage() {}
+
+func (x *TransitEncryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitEncryptRequest.ProtoReflect.Descriptor instead.
+func (*TransitEncryptRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *TransitEncryptRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitEncryptRequest) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+func (x *TransitEncryptRequest) GetContext() []byte {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *TransitEncryptRequest) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type TransitEncryptResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "vault:v<version>:<base64>".
+	Ciphertext    string `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	KeyVersion    int32  `protobuf:"varint,2,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitEncryptResponse) Reset() {
+	*x = TransitEncryptResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitEncryptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitEncryptResponse) ProtoMessage() {}
+
+func (x *TransitEncryptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitEncryptResponse.ProtoReflect.Descriptor instead.
+func (*TransitEncryptResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *TransitEncryptResponse) GetCiphertext() string {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return ""
+}
+
+func (x *TransitEncryptResponse) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type TransitDecryptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ciphertext    string                 `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Context       []byte                 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitDecryptRequest) Reset() {
+	*x = TransitDecryptRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitDecryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitDecryptRequest) ProtoMessage() {}
+
+func (x *TransitDecryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitDecryptRequest.ProtoReflect.Descriptor instead.
+func (*TransitDecryptRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *TransitDecryptRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitDecryptRequest) GetCiphertext() string {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return ""
+}
+
+func (x *TransitDecryptRequest) GetContext() []byte {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type TransitDecryptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plaintext     []byte                 `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitDecryptResponse) Reset() {
+	*x = TransitDecryptResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitDecryptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitDecryptResponse) ProtoMessage() {}
+
+func (x *TransitDecryptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitDecryptResponse.ProtoReflect.Descriptor instead.
+func (*TransitDecryptResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *TransitDecryptResponse) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+type TransitRewrapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ciphertext    string                 `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Context       []byte                 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	KeyVersion    int32                  `protobuf:"varint,4,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitRewrapRequest) Reset() {
+	*x = TransitRewrapRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitRewrapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitRewrapRequest) ProtoMessage() {}
+
+func (x *TransitRewrapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitRewrapRequest.ProtoReflect.Descriptor instead.
+func (*TransitRewrapRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *TransitRewrapRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitRewrapRequest) GetCiphertext() string {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return ""
+}
+
+func (x *TransitRewrapRequest) GetContext() []byte {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *TransitRewrapRequest) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type TransitSignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Input []byte                 `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	// Defaults to the latest version.
+	KeyVersion int32 `protobuf:"varint,3,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	// "sha2-256" (default), "sha2-384" or "sha2-512". Ignored for ed25519,
+	// which signs the input itself.
+	HashAlgorithm string `protobuf:"bytes,4,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"`
+	// RSA only: "pss" (default) or "pkcs1v15".
+	SignatureAlgorithm string `protobuf:"bytes,5,opt,name=signature_algorithm,json=signatureAlgorithm,proto3" json:"signature_algorithm,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TransitSignRequest) Reset() {
+	*x = TransitSignRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitSignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitSignRequest) ProtoMessage() {}
+
+func (x *TransitSignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitSignRequest.ProtoReflect.Descriptor instead.
+func (*TransitSignRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *TransitSignRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitSignRequest) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *TransitSignRequest) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+func (x *TransitSignRequest) GetHashAlgorithm() string {
+	if x != nil {
+		return x.HashAlgorithm
+	}
+	return ""
+}
+
+func (x *TransitSignRequest) GetSignatureAlgorithm() string {
+	if x != nil {
+		return x.SignatureAlgorithm
+	}
+	return ""
+}
+
+type TransitSignResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "vault:v<version>:<base64>".
+	Signature     string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	KeyVersion    int32  `protobuf:"varint,2,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitSignResponse) Reset() {
+	*x = TransitSignResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitSignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitSignResponse) ProtoMessage() {}
+
+func (x *TransitSignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitSignResponse.ProtoReflect.Descriptor instead.
+func (*TransitSignResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *TransitSignResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *TransitSignResponse) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type TransitVerifyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Input []byte                 `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	// Exactly one of signature or hmac is set.
+	Signature          string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Hmac               string `protobuf:"bytes,4,opt,name=hmac,proto3" json:"hmac,omitempty"`
+	HashAlgorithm      string `protobuf:"bytes,5,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"`
+	SignatureAlgorithm string `protobuf:"bytes,6,opt,name=signature_algorithm,json=signatureAlgorithm,proto3" json:"signature_algorithm,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TransitVerifyRequest) Reset() {
+	*x = TransitVerifyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitVerifyRequest) ProtoMessage() {}
+
+func (x *TransitVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitVerifyRequest.ProtoReflect.Descriptor instead.
+func (*TransitVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *TransitVerifyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitVerifyRequest) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *TransitVerifyRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *TransitVerifyRequest) GetHmac() string {
+	if x != nil {
+		return x.Hmac
+	}
+	return ""
+}
+
+func (x *TransitVerifyRequest) GetHashAlgorithm() string {
+	if x != nil {
+		return x.HashAlgorithm
+	}
+	return ""
+}
+
+func (x *TransitVerifyRequest) GetSignatureAlgorithm() string {
+	if x != nil {
+		return x.SignatureAlgorithm
+	}
+	return ""
+}
+
+type TransitVerifyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitVerifyResponse) Reset() {
+	*x = TransitVerifyResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitVerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitVerifyResponse) ProtoMessage() {}
+
+func (x *TransitVerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitVerifyResponse.ProtoReflect.Descriptor instead.
+func (*TransitVerifyResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *TransitVerifyResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+type TransitHMACRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Input      []byte                 `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	KeyVersion int32                  `protobuf:"varint,3,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	// "sha2-256" (default), "sha2-384" or "sha2-512".
+	Algorithm     string `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitHMACRequest) Reset() {
+	*x = TransitHMACRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitHMACRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitHMACRequest) ProtoMessage() {}
+
+func (x *TransitHMACRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitHMACRequest.ProtoReflect.Descriptor instead.
+func (*TransitHMACRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *TransitHMACRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitHMACRequest) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *TransitHMACRequest) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+func (x *TransitHMACRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type TransitHMACResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "vault:v<version>:<base64>".
+	Hmac          string `protobuf:"bytes,1,opt,name=hmac,proto3" json:"hmac,omitempty"`
+	KeyVersion    int32  `protobuf:"varint,2,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitHMACResponse) Reset() {
+	*x = TransitHMACResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitHMACResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitHMACResponse) ProtoMessage() {}
+
+func (x *TransitHMACResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitHMACResponse.ProtoReflect.Descriptor instead.
+func (*TransitHMACResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *TransitHMACResponse) GetHmac() string {
+	if x != nil {
+		return x.Hmac
+	}
+	return ""
+}
+
+func (x *TransitHMACResponse) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type GetTransitPublicKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Defaults to the latest version.
+	KeyVersion    int32 `protobuf:"varint,2,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransitPublicKeyRequest) Reset() {
+	*x = GetTransitPublicKeyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransitPublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransitPublicKeyRequest) ProtoMessage() {}
+
+func (x *GetTransitPublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransitPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetTransitPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetTransitPublicKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetTransitPublicKeyRequest) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type TransitPublicKey struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type    string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Version int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// PEM-encoded PKIX public key.
+	PublicKey     string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitPublicKey) Reset() {
+	*x = TransitPublicKey{}
+	mi := &file_v1_vault_vault_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitPublicKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitPublicKey) ProtoMessage() {}
+
+func (x *TransitPublicKey) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitPublicKey.ProtoReflect.Descriptor instead.
+func (*TransitPublicKey) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *TransitPublicKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransitPublicKey) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TransitPublicKey) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TransitPublicKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type GenerateRootRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CommonName string                 `protobuf:"bytes,1,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	// Defaults to ten years.
+	TtlSeconds int64 `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	// "ecdsa-p256" (default), "ed25519", "rsa-2048" or "rsa-4096".
+	KeyType string `protobuf:"bytes,3,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	// URLs of the CRL published by this CA, embedded in the certificates it
+	// issues; VaultManager serves it at /v1/pki/crl.
+	CrlDistributionPoints []string `protobuf:"bytes,4,rep,name=crl_distribution_points,json=crlDistributionPoints,proto3" json:"crl_distribution_points,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GenerateRootRequest) Reset() {
+	*x = GenerateRootRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRootRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRootRequest) ProtoMessage() {}
+
+func (x *GenerateRootRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRootRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRootRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GenerateRootRequest) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *GenerateRootRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *GenerateRootRequest) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *GenerateRootRequest) GetCrlDistributionPoints() []string {
+	if x != nil {
+		return x.CrlDistributionPoints
+	}
+	return nil
+}
+
+type GenerateIntermediateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CommonName string                 `protobuf:"bytes,1,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	// Defaults to five years, and never outlives the root.
+	TtlSeconds            int64    `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	KeyType               string   `protobuf:"bytes,3,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	CrlDistributionPoints []string `protobuf:"bytes,4,rep,name=crl_distribution_points,json=crlDistributionPoints,proto3" json:"crl_distribution_points,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GenerateIntermediateRequest) Reset() {
+	*x = GenerateIntermediateRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateIntermediateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateIntermediateRequest) ProtoMessage() {}
+
+func (x *GenerateIntermediateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateIntermediateRequest.ProtoReflect.Descriptor instead.
+func (*GenerateIntermediateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GenerateIntermediateRequest) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *GenerateIntermediateRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *GenerateIntermediateRequest) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *GenerateIntermediateRequest) GetCrlDistributionPoints() []string {
+	if x != nil {
+		return x.CrlDistributionPoints
+	}
+	return nil
+}
+
+type GetCARequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "root" or "intermediate"; defaults to the issuing CA.
+	Issuer        string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCARequest) Reset() {
+	*x = GetCARequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCARequest) ProtoMessage() {}
+
+func (x *GetCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCARequest.ProtoReflect.Descriptor instead.
+func (*GetCARequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetCARequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+type CACertificate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "root" or "intermediate".
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// PEM-encoded.
+	Certificate  string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	SerialNumber string `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	ExpireTime   int64  `protobuf:"varint,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The certificate followed by the certificates that issued it.
+	CaChain       []string `protobuf:"bytes,5,rep,name=ca_chain,json=caChain,proto3" json:"ca_chain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CACertificate) Reset() {
+	*x = CACertificate{}
+	mi := &file_v1_vault_vault_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CACertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CACertificate) ProtoMessage() {}
+
+func (x *CACertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CACertificate.ProtoReflect.Descriptor instead.
+func (*CACertificate) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *CACertificate) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *CACertificate) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *CACertificate) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *CACertificate) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *CACertificate) GetCaChain() []string {
+	if x != nil {
+		return x.CaChain
+	}
+	return nil
+}
+
+// PKIRole is the template leaf certificates are issued from. Certificates
+// come from the intermediate CA once there is one, the root otherwise.
+type PKIRole struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// DNS names the role may issue for.
+	AllowedDomains []string `protobuf:"bytes,2,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	// Names equal to an allowed domain.
+	AllowBareDomains bool `protobuf:"varint,3,opt,name=allow_bare_domains,json=allowBareDomains,proto3" json:"allow_bare_domains,omitempty"`
+	// Names below an allowed domain, including "*." wildcards.
+	AllowSubdomains bool `protobuf:"varint,4,opt,name=allow_subdomains,json=allowSubdomains,proto3" json:"allow_subdomains,omitempty"`
+	AllowIpSans     bool `protobuf:"varint,5,opt,name=allow_ip_sans,json=allowIpSans,proto3" json:"allow_ip_sans,omitempty"`
+	// Default lifetime; defaults to 24 hours.
+	TtlSeconds int64 `protobuf:"varint,6,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	// Defaults to ttl_seconds.
+	MaxTtlSeconds int64 `protobuf:"varint,7,opt,name=max_ttl_seconds,json=maxTtlSeconds,proto3" json:"max_ttl_seconds,omitempty"`
+	// Key type for IssueCertificate; see GenerateRootRequest.
+	KeyType string `protobuf:"bytes,8,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	// "digital_signature", "key_encipherment", "key_agreement" or
+	// "content_commitment"; defaults to the first two.
+	KeyUsage []string `protobuf:"bytes,9,rep,name=key_usage,json=keyUsage,proto3" json:"key_usage,omitempty"`
+	// "server_auth", "client_auth", "code_signing" or "email_protection";
+	// defaults to server_auth and client_auth.
+	ExtKeyUsage   []string `protobuf:"bytes,10,rep,name=ext_key_usage,json=extKeyUsage,proto3" json:"ext_key_usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PKIRole) Reset() {
+	*x = PKIRole{}
+	mi := &file_v1_vault_vault_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PKIRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PKIRole) ProtoMessage() {}
+
+func (x *PKIRole) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PKIRole.ProtoReflect.Descriptor instead.
+func (*PKIRole) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *PKIRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PKIRole) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+func (x *PKIRole) GetAllowBareDomains() bool {
+	if x != nil {
+		return x.AllowBareDomains
+	}
+	return false
+}
+
+func (x *PKIRole) GetAllowSubdomains() bool {
+	if x != nil {
+		return x.AllowSubdomains
+	}
+	return false
+}
+
+func (x *PKIRole) GetAllowIpSans() bool {
+	if x != nil {
+		return x.AllowIpSans
+	}
+	return false
+}
+
+func (x *PKIRole) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *PKIRole) GetMaxTtlSeconds() int64 {
+	if x != nil {
+		return x.MaxTtlSeconds
+	}
+	return 0
+}
+
+func (x *PKIRole) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *PKIRole) GetKeyUsage() []string {
+	if x != nil {
+		return x.KeyUsage
+	}
+	return nil
+}
+
+func (x *PKIRole) GetExtKeyUsage() []string {
+	if x != nil {
+		return x.ExtKeyUsage
+	}
+	return nil
+}
+
+type PutPKIRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutPKIRoleResponse) Reset() {
+	*x = PutPKIRoleResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutPKIRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutPKIRoleResponse) ProtoMessage() {}
+
+func (x *PutPKIRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutPKIRoleResponse.ProtoReflect.Descriptor instead.
+func (*PutPKIRoleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{132}
+}
+
+type GetPKIRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPKIRoleRequest) Reset() {
+	*x = GetPKIRoleRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPKIRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPKIRoleRequest) ProtoMessage() {}
+
+func (x *GetPKIRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPKIRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetPKIRoleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetPKIRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type IssueCertificateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Role       string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	CommonName string                 `protobuf:"bytes,2,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	// Additional DNS names; the common name is always included.
+	AltNames []string `protobuf:"bytes,3,rep,name=alt_names,json=altNames,proto3" json:"alt_names,omitempty"`
+	IpSans   []string `protobuf:"bytes,4,rep,name=ip_sans,json=ipSans,proto3" json:"ip_sans,omitempty"`
+	// Defaults to the role's ttl_seconds.
+	TtlSeconds    int64 `protobuf:"varint,5,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueCertificateRequest) Reset() {
+	*x = IssueCertificateRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCertificateRequest) ProtoMessage() {}
+
+func (x *IssueCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCertificateRequest.ProtoReflect.Descriptor instead.
+func (*IssueCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *IssueCertificateRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *IssueCertificateRequest) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *IssueCertificateRequest) GetAltNames() []string {
+	if x != nil {
+		return x.AltNames
+	}
+	return nil
+}
+
+func (x *IssueCertificateRequest) GetIpSans() []string {
+	if x != nil {
+		return x.IpSans
+	}
+	return nil
+}
+
+func (x *IssueCertificateRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type SignCertificateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Role  string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// PEM-encoded PKCS #10 request; its names are used unless overridden.
+	Csr           string   `protobuf:"bytes,2,opt,name=csr,proto3" json:"csr,omitempty"`
+	CommonName    string   `protobuf:"bytes,3,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	AltNames      []string `protobuf:"bytes,4,rep,name=alt_names,json=altNames,proto3" json:"alt_names,omitempty"`
+	IpSans        []string `protobuf:"bytes,5,rep,name=ip_sans,json=ipSans,proto3" json:"ip_sans,omitempty"`
+	TtlSeconds    int64    `protobuf:"varint,6,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignCertificateRequest) Reset() {
+	*x = SignCertificateRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignCertificateRequest) ProtoMessage() {}
+
+func (x *SignCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignCertificateRequest.ProtoReflect.Descriptor instead.
+func (*SignCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *SignCertificateRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SignCertificateRequest) GetCsr() string {
+	if x != nil {
+		return x.Csr
+	}
+	return ""
+}
+
+func (x *SignCertificateRequest) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *SignCertificateRequest) GetAltNames() []string {
+	if x != nil {
+		return x.AltNames
+	}
+	return nil
+}
+
+func (x *SignCertificateRequest) GetIpSans() []string {
+	if x != nil {
+		return x.IpSans
+	}
+	return nil
+}
+
+func (x *SignCertificateRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type IssuedCertificate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PEM-encoded.
+	Certificate string `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// PEM-encoded PKCS #8 key; only set by IssueCertificate and never stored.
+	PrivateKey string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	IssuingCa  string `protobuf:"bytes,3,opt,name=issuing_ca,json=issuingCa,proto3" json:"issuing_ca,omitempty"`
+	// The issuing CA followed by the CAs above it.
+	CaChain       []string `protobuf:"bytes,4,rep,name=ca_chain,json=caChain,proto3" json:"ca_chain,omitempty"`
+	SerialNumber  string   `protobuf:"bytes,5,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	ExpireTime    int64    `protobuf:"varint,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssuedCertificate) Reset() {
+	*x = IssuedCertificate{}
+	mi := &file_v1_vault_vault_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssuedCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuedCertificate) ProtoMessage() {}
+
+func (x *IssuedCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuedCertificate.ProtoReflect.Descriptor instead.
+func (*IssuedCertificate) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *IssuedCertificate) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *IssuedCertificate) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *IssuedCertificate) GetIssuingCa() string {
+	if x != nil {
+		return x.IssuingCa
+	}
+	return ""
+}
+
+func (x *IssuedCertificate) GetCaChain() []string {
+	if x != nil {
+		return x.CaChain
+	}
+	return nil
+}
+
+func (x *IssuedCertificate) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *IssuedCertificate) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type RevokeCertificateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Colon-separated hex, as returned when the certificate was issued.
+	SerialNumber  string `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCertificateRequest) Reset() {
+	*x = RevokeCertificateRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCertificateRequest) ProtoMessage() {}
+
+func (x *RevokeCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCertificateRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *RevokeCertificateRequest) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+type RevokeCertificateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RevocationTime int64                  `protobuf:"varint,1,opt,name=revocation_time,json=revocationTime,proto3" json:"revocation_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RevokeCertificateResponse) Reset() {
+	*x = RevokeCertificateResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCertificateResponse) ProtoMessage() {}
+
+func (x *RevokeCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCertificateResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *RevokeCertificateResponse) GetRevocationTime() int64 {
+	if x != nil {
+		return x.RevocationTime
+	}
+	return 0
+}
+
+type GetCRLRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "root" or "intermediate"; defaults to the issuing CA.
+	Issuer        string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCRLRequest) Reset() {
+	*x = GetCRLRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCRLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCRLRequest) ProtoMessage() {}
+
+func (x *GetCRLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCRLRequest.ProtoReflect.Descriptor instead.
+func (*GetCRLRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetCRLRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+type CRL struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Issuer string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// PEM-encoded X.509 CRL.
+	Crl           string `protobuf:"bytes,2,opt,name=crl,proto3" json:"crl,omitempty"`
+	CrlNumber     int64  `protobuf:"varint,3,opt,name=crl_number,json=crlNumber,proto3" json:"crl_number,omitempty"`
+	ThisUpdate    int64  `protobuf:"varint,4,opt,name=this_update,json=thisUpdate,proto3" json:"this_update,omitempty"`
+	NextUpdate    int64  `protobuf:"varint,5,opt,name=next_update,json=nextUpdate,proto3" json:"next_update,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CRL) Reset() {
+	*x = CRL{}
+	mi := &file_v1_vault_vault_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CRL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CRL) ProtoMessage() {}
+
+func (x *CRL) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CRL.ProtoReflect.Descriptor instead.
+func (*CRL) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *CRL) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *CRL) GetCrl() string {
+	if x != nil {
+		return x.Crl
+	}
+	return ""
+}
+
+func (x *CRL) GetCrlNumber() int64 {
+	if x != nil {
+		return x.CrlNumber
+	}
+	return 0
+}
+
+func (x *CRL) GetThisUpdate() int64 {
+	if x != nil {
+		return x.ThisUpdate
+	}
+	return 0
+}
+
+func (x *CRL) GetNextUpdate() int64 {
+	if x != nil {
+		return x.NextUpdate
+	}
+	return 0
+}
+
+type GenerateSSHCARequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "ed25519" (default), "ecdsa-p256", "rsa-2048" or "rsa-4096".
+	KeyType       string `protobuf:"bytes,1,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSSHCARequest) Reset() {
+	*x = GenerateSSHCARequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSSHCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSSHCARequest) ProtoMessage() {}
+
+func (x *GenerateSSHCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSSHCARequest.ProtoReflect.Descriptor instead.
+func (*GenerateSSHCARequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *GenerateSSHCARequest) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+type GetSSHCAPublicKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSSHCAPublicKeyRequest) Reset() {
+	*x = GetSSHCAPublicKeyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSSHCAPublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSSHCAPublicKeyRequest) ProtoMessage() {}
+
+func (x *GetSSHCAPublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSSHCAPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetSSHCAPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{142}
+}
+
+type SSHCAPublicKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// authorized_keys format, for sshd's TrustedUserCAKeys or a
+	// "@cert-authority" line in known_hosts. VaultManager also serves it at
+	// /v1/ssh/public_key.
+	PublicKey     string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSHCAPublicKey) Reset() {
+	*x = SSHCAPublicKey{}
+	mi := &file_v1_vault_vault_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSHCAPublicKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHCAPublicKey) ProtoMessage() {}
+
+func (x *SSHCAPublicKey) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHCAPublicKey.ProtoReflect.Descriptor instead.
+func (*SSHCAPublicKey) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *SSHCAPublicKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+// SSHRole is the template public keys are signed with.
+type SSHRole struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// "user" (default) or "host".
+	CertType string `protobuf:"bytes,2,opt,name=cert_type,json=certType,proto3" json:"cert_type,omitempty"`
+	// User certificates: principals that may be requested; "*" allows any.
+	AllowedUsers []string `protobuf:"bytes,3,rep,name=allowed_users,json=allowedUsers,proto3" json:"allowed_users,omitempty"`
+	// User certificates: principal used when the request names none.
+	DefaultUser string `protobuf:"bytes,4,opt,name=default_user,json=defaultUser,proto3" json:"default_user,omitempty"`
+	// Host certificates: principals must equal one of these domains or,
+	// with allow_subdomains, lie below one.
+	AllowedDomains  []string `protobuf:"bytes,5,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	AllowSubdomains bool     `protobuf:"varint,6,opt,name=allow_subdomains,json=allowSubdomains,proto3" json:"allow_subdomains,omitempty"`
+	// Extensions requests may set; "*" allows any.
+	AllowedExtensions []string `protobuf:"bytes,7,rep,name=allowed_extensions,json=allowedExtensions,proto3" json:"allowed_extensions,omitempty"`
+	// Extensions used when the request sets none, e.g. "permit-pty".
+	DefaultExtensions map[string]string `protobuf:"bytes,8,rep,name=default_extensions,json=defaultExtensions,proto3" json:"default_extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Critical options set on every certificate, e.g. "force-command" or
+	// "source-address".
+	CriticalOptions map[string]string `protobuf:"bytes,9,rep,name=critical_options,json=criticalOptions,proto3" json:"critical_options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Default lifetime; defaults to one hour.
+	TtlSeconds int64 `protobuf:"varint,10,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	// Defaults to ttl_seconds.
+	MaxTtlSeconds int64 `protobuf:"varint,11,opt,name=max_ttl_seconds,json=maxTtlSeconds,proto3" json:"max_ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SSHRole) Reset() {
+	*x = SSHRole{}
+	mi := &file_v1_vault_vault_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SSHRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHRole) ProtoMessage() {}
+
+func (x *SSHRole) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHRole.ProtoReflect.Descriptor instead.
+func (*SSHRole) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *SSHRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SSHRole) GetCertType() string {
+	if x != nil {
+		return x.CertType
+	}
+	return ""
+}
+
+func (x *SSHRole) GetAllowedUsers() []string {
+	if x != nil {
+		return x.AllowedUsers
+	}
+	return nil
+}
+
+func (x *SSHRole) GetDefaultUser() string {
+	if x != nil {
+		return x.DefaultUser
+	}
+	return ""
+}
+
+func (x *SSHRole) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+func (x *SSHRole) GetAllowSubdomains() bool {
+	if x != nil {
+		return x.AllowSubdomains
+	}
+	return false
+}
+
+func (x *SSHRole) GetAllowedExtensions() []string {
+	if x != nil {
+		return x.AllowedExtensions
+	}
+	return nil
+}
+
+func (x *SSHRole) GetDefaultExtensions() map[string]string {
+	if x != nil {
+		return x.DefaultExtensions
+	}
+	return nil
+}
+
+func (x *SSHRole) GetCriticalOptions() map[string]string {
+	if x != nil {
+		return x.CriticalOptions
+	}
+	return nil
+}
+
+func (x *SSHRole) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *SSHRole) GetMaxTtlSeconds() int64 {
+	if x != nil {
+		return x.MaxTtlSeconds
+	}
+	return 0
+}
+
+type PutSSHRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutSSHRoleResponse) Reset() {
+	*x = PutSSHRoleResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutSSHRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutSSHRoleResponse) ProtoMessage() {}
+
+func (x *PutSSHRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutSSHRoleResponse.ProtoReflect.Descriptor instead.
+func (*PutSSHRoleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{145}
+}
+
+type GetSSHRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSSHRoleRequest) Reset() {
+	*x = GetSSHRoleRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSSHRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSSHRoleRequest) ProtoMessage() {}
+
+func (x *GetSSHRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSSHRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetSSHRoleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetSSHRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SignSSHKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Role  string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// authorized_keys format.
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Defaults to the role's default_user.
+	ValidPrincipals []string `protobuf:"bytes,3,rep,name=valid_principals,json=validPrincipals,proto3" json:"valid_principals,omitempty"`
+	// Defaults to the role's ttl_seconds and is capped at its maximum.
+	TtlSeconds int64 `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	// Replaces the role's default_extensions.
+	Extensions map[string]string `protobuf:"bytes,5,rep,name=extensions,proto3" json:"extensions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Logged by sshd on login; defaults to the role and caller identity.
+	KeyId         string `protobuf:"bytes,6,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignSSHKeyRequest) Reset() {
+	*x = SignSSHKeyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignSSHKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignSSHKeyRequest) ProtoMessage() {}
+
+func (x *SignSSHKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignSSHKeyRequest.ProtoReflect.Descriptor instead.
+func (*SignSSHKeyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *SignSSHKeyRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SignSSHKeyRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SignSSHKeyRequest) GetValidPrincipals() []string {
+	if x != nil {
+		return x.ValidPrincipals
+	}
+	return nil
+}
+
+func (x *SignSSHKeyRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *SignSSHKeyRequest) GetExtensions() map[string]string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *SignSSHKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type SignedSSHKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// authorized_keys format, for the "-cert.pub" file next to the key.
+	SignedKey     string `protobuf:"bytes,1,opt,name=signed_key,json=signedKey,proto3" json:"signed_key,omitempty"`
+	SerialNumber  uint64 `protobuf:"varint,2,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	ExpireTime    int64  `protobuf:"varint,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignedSSHKey) Reset() {
+	*x = SignedSSHKey{}
+	mi := &file_v1_vault_vault_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedSSHKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedSSHKey) ProtoMessage() {}
+
+func (x *SignedSSHKey) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedSSHKey.ProtoReflect.Descriptor instead.
+func (*SignedSSHKey) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *SignedSSHKey) GetSignedKey() string {
+	if x != nil {
+		return x.SignedKey
+	}
+	return ""
+}
+
+func (x *SignedSSHKey) GetSerialNumber() uint64 {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return 0
+}
+
+func (x *SignedSSHKey) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type OIDCConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "iss" of issued tokens, e.g. "https://vault.olympus:8092/v1/identity/oidc".
+	// Discovery is served at <issuer>/.well-known/openid-configuration.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// "RS256" (default), "ES256" or "EdDSA"; takes effect at the next key
+	// rotation.
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// How often the signing key rotates; defaults to 24 hours.
+	RotationPeriodSeconds int64 `protobuf:"varint,3,opt,name=rotation_period_seconds,json=rotationPeriodSeconds,proto3" json:"rotation_period_seconds,omitempty"`
+	// How long a rotated key stays in the JWKS; defaults to 24 hours and
+	// should exceed the longest token TTL.
+	VerificationTtlSeconds int64 `protobuf:"varint,4,opt,name=verification_ttl_seconds,json=verificationTtlSeconds,proto3" json:"verification_ttl_seconds,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OIDCConfig) Reset() {
+	*x = OIDCConfig{}
+	mi := &file_v1_vault_vault_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCConfig) ProtoMessage() {}
+
+func (x *OIDCConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCConfig.ProtoReflect.Descriptor instead.
+func (*OIDCConfig) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *OIDCConfig) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *OIDCConfig) GetRotationPeriodSeconds() int64 {
+	if x != nil {
+		return x.RotationPeriodSeconds
+	}
+	return 0
+}
+
+func (x *OIDCConfig) GetVerificationTtlSeconds() int64 {
+	if x != nil {
+		return x.VerificationTtlSeconds
+	}
+	return 0
+}
+
+type PutOIDCConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutOIDCConfigResponse) Reset() {
+	*x = PutOIDCConfigResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutOIDCConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutOIDCConfigResponse) ProtoMessage() {}
+
+func (x *PutOIDCConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutOIDCConfigResponse.ProtoReflect.Descriptor instead.
+func (*PutOIDCConfigResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{150}
+}
+
+type OIDCRole struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// "aud" of issued tokens; defaults to the role name.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// JSON object of extra claims. Strings may reference {{identity.name}},
+	// {{identity.source}}, {{identity.policies}} and
+	// {{identity.token_accessor}}; a string that is exactly
+	// {{identity.policies}} becomes a list. Registered claims such as "sub"
+	// and "exp" cannot be set.
+	Template string `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	// Defaults to one hour.
+	TtlSeconds    int64 `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OIDCRole) Reset() {
+	*x = OIDCRole{}
+	mi := &file_v1_vault_vault_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OIDCRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCRole) ProtoMessage() {}
+
+func (x *OIDCRole) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCRole.ProtoReflect.Descriptor instead.
+func (*OIDCRole) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *OIDCRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OIDCRole) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OIDCRole) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *OIDCRole) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type PutOIDCRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutOIDCRoleResponse) Reset() {
+	*x = PutOIDCRoleResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutOIDCRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutOIDCRoleResponse) ProtoMessage() {}
+
+func (x *PutOIDCRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutOIDCRoleResponse.ProtoReflect.Descriptor instead.
+func (*PutOIDCRoleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{152}
+}
+
+type GetOIDCRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOIDCRoleRequest) Reset() {
+	*x = GetOIDCRoleRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOIDCRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOIDCRoleRequest) ProtoMessage() {}
+
+func (x *GetOIDCRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOIDCRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetOIDCRoleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *GetOIDCRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GenerateIdentityTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateIdentityTokenRequest) Reset() {
+	*x = GenerateIdentityTokenRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateIdentityTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateIdentityTokenRequest) ProtoMessage() {}
+
+func (x *GenerateIdentityTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateIdentityTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateIdentityTokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *GenerateIdentityTokenRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type IdentityToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Compact JWS; "sub" is the caller's vault identity.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ClientId      string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	TtlSeconds    int64  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	ExpireTime    int64  `protobuf:"varint,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityToken) Reset() {
+	*x = IdentityToken{}
+	mi := &file_v1_vault_vault_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityToken) ProtoMessage() {}
+
+func (x *IdentityToken) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityToken.ProtoReflect.Descriptor instead.
+func (*IdentityToken) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *IdentityToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *IdentityToken) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *IdentityToken) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *IdentityToken) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type RotateOIDCKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateOIDCKeyRequest) Reset() {
+	*x = RotateOIDCKeyRequest{}
+	mi := &file_v1_vault_vault_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateOIDCKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateOIDCKeyRequest) ProtoMessage() {}
+
+func (x *RotateOIDCKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateOIDCKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateOIDCKeyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{156}
+}
+
+type RotateOIDCKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "kid" of the new signing key.
+	KeyId         string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateOIDCKeyResponse) Reset() {
+	*x = RotateOIDCKeyResponse{}
+	mi := &file_v1_vault_vault_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateOIDCKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateOIDCKeyResponse) ProtoMessage() {}
+
+func (x *RotateOIDCKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_vault_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateOIDCKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateOIDCKeyResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_vault_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *RotateOIDCKeyResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+var File_v1_vault_vault_proto protoreflect.FileDescriptor
+
+const file_v1_vault_vault_proto_rawDesc = "" +
+	"\n" +
+	"\x14v1/vault/vault.proto\x12\bvault.v1\"\x9f\x01\n" +
+	"\x11VaultWriteRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x03R\n" +
+	"ttlSeconds\x12\x1f\n" +
+	"\vexpire_time\x18\x04 \x01(\x03R\n" +
+	"expireTime\x12 \n" +
+	"\fkms_key_name\x18\x05 \x01(\tR\n" +
+	"kmsKeyName\"O\n" +
+	"\x12VaultWriteResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1f\n" +
+	"\vexpire_time\x18\x02 \x01(\x03R\n" +
+	"expireTime\"u\n" +
+	"\x10VaultReadRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x11access_request_id\x18\x02 \x01(\tR\x0faccessRequestId\x12#\n" +
+	"\raccess_reason\x18\x03 \x01(\tR\faccessReason\"o\n" +
+	"\x11VaultReadResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12*\n" +
+	"\x11access_request_id\x18\x03 \x01(\tR\x0faccessRequestId\"\x96\x01\n" +
+	"\x17GetSecretVersionRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12*\n" +
+	"\x11access_request_id\x18\x03 \x01(\tR\x0faccessRequestId\x12#\n" +
+	"\raccess_reason\x18\x04 \x01(\tR\faccessReason\"-\n" +
+	"\x19ListSecretVersionsRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"8\n" +
+	"\x1aListSecretVersionsResponse\x12\x1a\n" +
+	"\bversions\x18\x01 \x03(\x05R\bversions\",\n" +
+	"\x12ListSecretsRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\")\n" +
+	"\x13ListSecretsResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"f\n" +
+	"\x14TestIAMPolicyRequest\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\"I\n" +
+	"\x15TestIAMPolicyResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xe8\x01\n" +
+	"\x12CreateTokenRequest\x12\x1a\n" +
+	"\bpolicies\x18\x01 \x03(\tR\bpolicies\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x03R\n" +
+	"ttlSeconds\x127\n" +
+	"\x18explicit_max_ttl_seconds\x18\x03 \x01(\x03R\x15explicitMaxTtlSeconds\x12!\n" +
+	"\fdisplay_name\x18\x04 \x01(\tR\vdisplayName\x12\x1c\n" +
+	"\trenewable\x18\x05 \x01(\bR\trenewable\x12\x1b\n" +
+	"\tno_parent\x18\x06 \x01(\bR\bnoParent\"T\n" +
+	"\x13CreateTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12'\n" +
+	"\x04info\x18\x02 \x01(\v2\x13.vault.v1.TokenInfoR\x04info\"\xfc\x02\n" +
+	"\tTokenInfo\x12\x1a\n" +
+	"\baccessor\x18\x01 \x01(\tR\baccessor\x12\x1a\n" +
+	"\bpolicies\x18\x02 \x03(\tR\bpolicies\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x1f\n" +
+	"\vcreate_time\x18\x04 \x01(\x03R\n" +
+	"createTime\x12\x1f\n" +
+	"\vexpire_time\x18\x05 \x01(\x03R\n" +
+	"expireTime\x12\x1f\n" +
+	"\vttl_seconds\x18\x06 \x01(\x03R\n" +
+	"ttlSeconds\x127\n" +
+	"\x18explicit_max_ttl_seconds\x18\a \x01(\x03R\x15explicitMaxTtlSeconds\x12\x1c\n" +
+	"\trenewable\x18\b \x01(\bR\trenewable\x12'\n" +
+	"\x0fparent_accessor\x18\t \x01(\tR\x0eparentAccessor\x12\x16\n" +
+	"\x06entity\x18\n" +
+	" \x01(\tR\x06entity\x12\x19\n" +
+	"\blease_id\x18\v \x01(\tR\aleaseId\"F\n" +
+	"\x12LookupTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\baccessor\x18\x02 \x01(\tR\baccessor\"V\n" +
+	"\x11RenewTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12+\n" +
+	"\x11increment_seconds\x18\x02 \x01(\x03R\x10incrementSeconds\"F\n" +
+	"\x12RevokeTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\baccessor\x18\x02 \x01(\tR\baccessor\"/\n" +
+	"\x13RevokeTokenResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x05R\arevoked\"k\n" +
+	"\n" +
+	"PolicyRule\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\"\n" +
+	"\fcapabilities\x18\x02 \x03(\tR\fcapabilities\x12%\n" +
+	"\x0erequire_reason\x18\x03 \x01(\bR\rrequireReason\"x\n" +
+	"\x06Policy\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12*\n" +
+	"\x05rules\x18\x02 \x03(\v2\x14.vault.v1.PolicyRuleR\x05rules\x12.\n" +
+	"\x06window\x18\x03 \x01(\v2\x16.vault.v1.AccessWindowR\x06window\"<\n" +
+	"\x10PutPolicyRequest\x12(\n" +
+	"\x06policy\x18\x01 \x01(\v2\x10.vault.v1.PolicyR\x06policy\"\x13\n" +
+	"\x11PutPolicyResponse\"&\n" +
+	"\x10GetPolicyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xb6\x02\n" +
+	"\aAppRole\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0etoken_policies\x18\x02 \x03(\tR\rtokenPolicies\x12*\n" +
+	"\x11token_ttl_seconds\x18\x03 \x01(\x03R\x0ftokenTtlSeconds\x121\n" +
+	"\x15token_max_ttl_seconds\x18\x04 \x01(\x03R\x12tokenMaxTtlSeconds\x12+\n" +
+	"\x12secret_id_num_uses\x18\x05 \x01(\x05R\x0fsecretIdNumUses\x121\n" +
+	"\x15secret_id_ttl_seconds\x18\x06 \x01(\x03R\x12secretIdTtlSeconds\x121\n" +
+	"\x15secret_id_bound_cidrs\x18\a \x03(\tR\x12secretIdBoundCidrs\":\n" +
+	"\x11PutAppRoleRequest\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.vault.v1.AppRoleR\x04role\"-\n" +
+	"\x12PutAppRoleResponse\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\"2\n" +
+	"\x13GetAppRoleIDRequest\x12\x1b\n" +
+	"\trole_name\x18\x01 \x01(\tR\broleName\"/\n" +
+	"\x14GetAppRoleIDResponse\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\"\xdd\x01\n" +
+	"\x17GenerateSecretIDRequest\x12\x1b\n" +
+	"\trole_name\x18\x01 \x01(\tR\broleName\x12\x1b\n" +
+	"\tcidr_list\x18\x02 \x03(\tR\bcidrList\x12K\n" +
+	"\bmetadata\x18\x03 \x03(\v2/.vault.v1.GenerateSecretIDRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa1\x01\n" +
+	"\x18GenerateSecretIDResponse\x12\x1b\n" +
+	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12,\n" +
+	"\x12secret_id_accessor\x18\x02 \x01(\tR\x10secretIdAccessor\x12\x1f\n" +
+	"\vexpire_time\x18\x03 \x01(\x03R\n" +
+	"expireTime\x12\x19\n" +
+	"\bnum_uses\x18\x04 \x01(\x05R\anumUses\"c\n" +
+	"\x16DestroySecretIDRequest\x12\x1b\n" +
+	"\trole_name\x18\x01 \x01(\tR\broleName\x12,\n" +
+	"\x12secret_id_accessor\x18\x02 \x01(\tR\x10secretIdAccessor\"\x19\n" +
+	"\x17DestroySecretIDResponse\"D\n" +
+	"\fLoginRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x1b\n" +
+	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\"\xa5\x01\n" +
+	"\tJWTConfig\x12\x1b\n" +
+	"\tjwks_urls\x18\x01 \x03(\tR\bjwksUrls\x12\x1d\n" +
+	"\n" +
+	"jwks_files\x18\x02 \x03(\tR\tjwksFiles\x12!\n" +
+	"\fbound_issuer\x18\x03 \x01(\tR\vboundIssuer\x129\n" +
+	"\x19clock_skew_leeway_seconds\x18\x04 \x01(\x03R\x16clockSkewLeewaySeconds\"B\n" +
+	"\x13PutJWTConfigRequest\x12+\n" +
+	"\x06config\x18\x01 \x01(\v2\x13.vault.v1.JWTConfigR\x06config\"\x16\n" +
+	"\x14PutJWTConfigResponse\"\xb9\x04\n" +
+	"\aJWTRole\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fbound_issuer\x18\x02 \x01(\tR\vboundIssuer\x12'\n" +
+	"\x0fbound_audiences\x18\x03 \x03(\tR\x0eboundAudiences\x12#\n" +
+	"\rbound_subject\x18\x04 \x01(\tR\fboundSubject\x12E\n" +
+	"\fbound_claims\x18\x05 \x03(\v2\".vault.v1.JWTRole.BoundClaimsEntryR\vboundClaims\x12\x1d\n" +
+	"\n" +
+	"user_claim\x18\x06 \x01(\tR\tuserClaim\x12%\n" +
+	"\x0etoken_policies\x18\a \x03(\tR\rtokenPolicies\x12*\n" +
+	"\x11token_ttl_seconds\x18\b \x01(\x03R\x0ftokenTtlSeconds\x12!\n" +
+	"\fgroups_claim\x18\t \x01(\tR\vgroupsClaim\x12K\n" +
+	"\x0egroup_policies\x18\n" +
+	" \x03(\v2$.vault.v1.JWTRole.GroupPoliciesEntryR\rgroupPolicies\x1a>\n" +
+	"\x10BoundClaimsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
+	"\x12GroupPoliciesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\":\n" +
+	"\x11PutJWTRoleRequest\x12%\n" +
+	"\x04role\x18\x01 \x01(\v2\x11.vault.v1.JWTRoleR\x04role\"\x14\n" +
+	"\x12PutJWTRoleResponse\"7\n" +
+	"\x0fJWTLoginRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x10\n" +
+	"\x03jwt\x18\x02 \x01(\tR\x03jwt\"\xd7\x01\n" +
+	"\tCPIConfig\x12\x1b\n" +
+	"\tjwks_urls\x18\x01 \x03(\tR\bjwksUrls\x12\x1d\n" +
+	"\n" +
+	"jwks_files\x18\x02 \x03(\tR\tjwksFiles\x12\x16\n" +
+	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12\x1a\n" +
+	"\baudience\x18\x04 \x01(\tR\baudience\x12\x1e\n" +
+	"\n" +
+	"prohibited\x18\x05 \x03(\tR\n" +
+	"prohibited\x12:\n" +
+	"\x19prohibition_registry_file\x18\x06 \x01(\tR\x17prohibitionRegistryFile\"B\n" +
+	"\x13PutCPIConfigRequest\x12+\n" +
+	"\x06config\x18\x01 \x01(\v2\x13.vault.v1.CPIConfigR\x06config\"\x16\n" +
+	"\x14PutCPIConfigResponse\"\x83\x01\n" +
+	"\fAccessWindow\x12\x1a\n" +
+	"\bschedule\x18\x01 \x01(\tR\bschedule\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x12\x1d\n" +
+	"\n" +
+	"not_before\x18\x03 \x01(\x03R\tnotBefore\x12\x1b\n" +
+	"\tnot_after\x18\x04 \x01(\x03R\bnotAfter\"Z\n" +
+	"\x16PutAccessWindowRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x06window\x18\x02 \x01(\v2\x16.vault.v1.AccessWindowR\x06window\"\x19\n" +
+	"\x17PutAccessWindowResponse\"^\n" +
+	"\fControlGroup\x12-\n" +
+	"\x12required_approvals\x18\x01 \x01(\x05R\x11requiredApprovals\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x03R\n" +
+	"ttlSeconds\"g\n" +
+	"\x16PutControlGroupRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12;\n" +
+	"\rcontrol_group\x18\x02 \x01(\v2\x16.vault.v1.ControlGroupR\fcontrolGroup\"\x19\n" +
+	"\x17PutControlGroupResponse\"\xd5\x01\n" +
+	"\rAccessRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1c\n" +
+	"\trequester\x18\x03 \x01(\tR\trequester\x12\x1c\n" +
+	"\tapprovers\x18\x04 \x03(\tR\tapprovers\x12-\n" +
+	"\x12required_approvals\x18\x05 \x01(\x05R\x11requiredApprovals\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
+	"\vexpire_time\x18\a \x01(\x03R\n" +
+	"expireTime\"5\n" +
+	"\x14ApproveAccessRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"8\n" +
+	"\x17GetAccessRequestRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"\x8f\x01\n" +
+	"\x11BreakGlassRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12$\n" +
+	"\rjustification\x18\x02 \x01(\tR\rjustification\x12\x1b\n" +
+	"\tticket_id\x18\x03 \x01(\tR\bticketId\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x03R\n" +
+	"ttlSeconds\"\xc9\x02\n" +
+	"\x11BreakGlassSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x1c\n" +
+	"\trequester\x18\x03 \x01(\tR\trequester\x12$\n" +
+	"\rjustification\x18\x04 \x01(\tR\rjustification\x12\x1b\n" +
+	"\tticket_id\x18\x05 \x01(\tR\bticketId\x12\x1f\n" +
+	"\vcreate_time\x18\x06 \x01(\x03R\n" +
+	"createTime\x12\x1f\n" +
+	"\vexpire_time\x18\a \x01(\x03R\n" +
+	"expireTime\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12'\n" +
+	"\x0facknowledged_by\x18\t \x01(\tR\x0eacknowledgedBy\x12(\n" +
+	"\x0facknowledgement\x18\n" +
+	" \x01(\tR\x0facknowledgement\"a\n" +
+	"\x12BreakGlassResponse\x125\n" +
+	"\asession\x18\x01 \x01(\v2\x1b.vault.v1.BreakGlassSessionR\asession\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"B\n" +
+	"\x1cAcknowledgeBreakGlassRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\">\n" +
+	"\x15ListBreakGlassRequest\x12%\n" +
+	"\x0einclude_closed\x18\x01 \x01(\bR\rincludeClosed\"Q\n" +
+	"\x16ListBreakGlassResponse\x127\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1b.vault.v1.BreakGlassSessionR\bsessions\"A\n" +
+	"\x17GetAccessHistoryRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xd6\x01\n" +
+	"\fAccessRecord\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\x03R\x04time\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x1a\n" +
+	"\bidentity\x18\x04 \x01(\tR\bidentity\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x18\n" +
+	"\aoutcome\x18\a \x01(\tR\aoutcome\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"L\n" +
+	"\x18GetAccessHistoryResponse\x120\n" +
+	"\arecords\x18\x01 \x03(\v2\x16.vault.v1.AccessRecordR\arecords\",\n" +
+	"\x18GetSecretMetadataRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\xbc\x01\n" +
+	"\x0fVersionMetadata\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1f\n" +
+	"\vcreate_time\x18\x02 \x01(\x03R\n" +
+	"createTime\x12\x1f\n" +
+	"\vexpire_time\x18\x03 \x01(\x03R\n" +
+	"expireTime\x12\x1c\n" +
+	"\tdestroyed\x18\x04 \x01(\bR\tdestroyed\x12/\n" +
+	"\x14kms_key_version_name\x18\x05 \x01(\tR\x11kmsKeyVersionName\"\xa7\x02\n" +
+	"\x0eSecretMetadata\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x0fcurrent_version\x18\x02 \x01(\x05R\x0ecurrentVersion\x12\x1f\n" +
+	"\vexpire_time\x18\x03 \x01(\x03R\n" +
+	"expireTime\x125\n" +
+	"\bversions\x18\x04 \x03(\v2\x19.vault.v1.VersionMetadataR\bversions\x127\n" +
+	"\tretention\x18\x05 \x01(\v2\x19.vault.v1.RetentionPolicyR\tretention\x12'\n" +
+	"\x06topics\x18\x06 \x03(\v2\x0f.vault.v1.TopicR\x06topics\x12 \n" +
+	"\fkms_key_name\x18\a \x01(\tR\n" +
+	"kmsKeyName\"q\n" +
+	"\x1bUpdateSecretMetadataRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x03R\n" +
+	"ttlSeconds\x12\x1f\n" +
+	"\vexpire_time\x18\x03 \x01(\x03R\n" +
+	"expireTime\"y\n" +
+	"\x0fRetentionPolicy\x12!\n" +
+	"\fmax_versions\x18\x01 \x01(\x05R\vmaxVersions\x12&\n" +
+	"\x0fmax_age_seconds\x18\x02 \x01(\x03R\rmaxAgeSeconds\x12\x1b\n" +
+	"\tkeep_last\x18\x03 \x01(\x05R\bkeepLast\"`\n" +
+	"\x19PutRetentionPolicyRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x121\n" +
+	"\x06policy\x18\x02 \x01(\v2\x19.vault.v1.RetentionPolicyR\x06policy\"\x1c\n" +
+	"\x1aPutRetentionPolicyResponse\";\n" +
+	"\x17ReencryptSecretsRequest\x12 \n" +
+	"\fkms_key_name\x18\x01 \x01(\tR\n" +
+	"kmsKeyName\"T\n" +
+	"\x18ReencryptSecretsResponse\x12 \n" +
+	"\vreencrypted\x18\x01 \x01(\x05R\vreencrypted\x12\x16\n" +
+	"\x06failed\x18\x02 \x03(\tR\x06failed\"?\n" +
+	"\aRotator\x12\x18\n" +
+	"\acommand\x18\x01 \x03(\tR\acommand\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\"\x8c\x02\n" +
+	"\bRotation\x12%\n" +
+	"\x0eperiod_seconds\x18\x01 \x01(\x03R\rperiodSeconds\x12,\n" +
+	"\x12next_rotation_time\x18\x02 \x01(\x03R\x10nextRotationTime\x12+\n" +
+	"\arotator\x18\x03 \x01(\v2\x11.vault.v1.RotatorR\arotator\x12,\n" +
+	"\x12last_rotation_time\x18\x04 \x01(\x03R\x10lastRotationTime\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x05 \x01(\tR\tlastError\x121\n" +
+	"\x14consecutive_failures\x18\x06 \x01(\x05R\x13consecutiveFailures\"V\n" +
+	"\x12PutRotationRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\brotation\x18\x02 \x01(\v2\x12.vault.v1.RotationR\brotation\"&\n" +
+	"\x12GetRotationRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"J\n" +
+	"\rRotateRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x0fcurrent_version\x18\x02 \x01(\x05R\x0ecurrentVersion\"&\n" +
+	"\x0eRotateResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"e\n" +
+	"\x05Topic\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
+	"\x0fpubsub_endpoint\x18\x02 \x01(\tR\x0epubsubEndpoint\x12\x1f\n" +
+	"\vwebhook_url\x18\x03 \x01(\tR\n" +
+	"webhookUrl\"S\n" +
+	"\x16PutSecretTopicsRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x06topics\x18\x02 \x03(\v2\x0f.vault.v1.TopicR\x06topics\"\x19\n" +
+	"\x17PutSecretTopicsResponse\"w\n" +
+	"\aWebhook\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06events\x18\x05 \x03(\tR\x06events\"@\n" +
+	"\x11PutWebhookRequest\x12+\n" +
+	"\awebhook\x18\x01 \x01(\v2\x11.vault.v1.WebhookR\awebhook\"\x14\n" +
+	"\x12PutWebhookResponse\"*\n" +
+	"\x14DeleteWebhookRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x17\n" +
+	"\x15DeleteWebhookResponse\"E\n" +
+	"\x15ListDeliveriesRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"\xfa\x01\n" +
+	"\bDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12*\n" +
+	"\x11next_attempt_time\x18\a \x01(\x03R\x0fnextAttemptTime\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12\x14\n" +
+	"\x05state\x18\t \x01(\tR\x05state\"L\n" +
+	"\x16ListDeliveriesResponse\x122\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x12.vault.v1.DeliveryR\n" +
+	"deliveries\"\x88\x01\n" +
+	"\x0eDatabaseConfig\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06plugin\x18\x02 \x01(\tR\x06plugin\x12%\n" +
+	"\x0econnection_url\x18\x03 \x01(\tR\rconnectionUrl\x12#\n" +
+	"\rallowed_roles\x18\x04 \x03(\tR\fallowedRoles\"L\n" +
+	"\x18PutDatabaseConfigRequest\x120\n" +
+	"\x06config\x18\x01 \x01(\v2\x18.vault.v1.DatabaseConfigR\x06config\"\x1b\n" +
+	"\x19PutDatabaseConfigResponse\"\xa4\x02\n" +
+	"\fDatabaseRole\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\adb_name\x18\x02 \x01(\tR\x06dbName\x12/\n" +
+	"\x13creation_statements\x18\x03 \x03(\tR\x12creationStatements\x123\n" +
+	"\x15revocation_statements\x18\x04 \x03(\tR\x14revocationStatements\x12.\n" +
+	"\x13default_ttl_seconds\x18\x05 \x01(\x03R\x11defaultTtlSeconds\x12&\n" +
+	"\x0fmax_ttl_seconds\x18\x06 \x01(\x03R\rmaxTtlSeconds\x12)\n" +
+	"\x10renew_statements\x18\a \x03(\tR\x0frenewStatements\"D\n" +
+	"\x16PutDatabaseRoleRequest\x12*\n" +
+	"\x04role\x18\x01 \x01(\v2\x16.vault.v1.DatabaseRoleR\x04role\"\x19\n" +
+	"\x17PutDatabaseRoleResponse\"3\n" +
+	"\x1dGetDatabaseCredentialsRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\"\xbf\x01\n" +
+	"\x13DatabaseCredentials\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x19\n" +
+	"\blease_id\x18\x03 \x01(\tR\aleaseId\x124\n" +
+	"\x16lease_duration_seconds\x18\x04 \x01(\x03R\x14leaseDurationSeconds\x12\x1f\n" +
+	"\vexpire_time\x18\x05 \x01(\x03R\n" +
+	"expireTime\"\xf0\x01\n" +
+	"\x05Lease\x12\x19\n" +
+	"\blease_id\x18\x01 \x01(\tR\aleaseId\x12\x1d\n" +
+	"\n" +
+	"issue_time\x18\x02 \x01(\x03R\tissueTime\x12\x1f\n" +
+	"\vexpire_time\x18\x03 \x01(\x03R\n" +
+	"expireTime\x12&\n" +
+	"\x0fmax_expire_time\x18\x04 \x01(\x03R\rmaxExpireTime\x12\x1c\n" +
+	"\trenewable\x18\x05 \x01(\bR\trenewable\x12'\n" +
+	"\x0frevoke_attempts\x18\x06 \x01(\x05R\x0erevokeAttempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\"[\n" +
+	"\x11RenewLeaseRequest\x12\x19\n" +
+	"\blease_id\x18\x01 \x01(\tR\aleaseId\x12+\n" +
+	"\x11increment_seconds\x18\x02 \x01(\x03R\x10incrementSeconds\"/\n" +
+	"\x12RevokeLeaseRequest\x12\x19\n" +
+	"\blease_id\x18\x01 \x01(\tR\aleaseId\"\x15\n" +
+	"\x13RevokeLeaseResponse\"-\n" +
+	"\x13RevokePrefixRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\"H\n" +
+	"\x14RevokePrefixResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x05R\arevoked\x12\x16\n" +
+	"\x06failed\x18\x02 \x03(\tR\x06failed\"\xcd\x01\n" +
+	"\x0ePasswordPolicy\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x05R\x06length\x12+\n" +
+	"\x05rules\x18\x04 \x03(\v2\x15.vault.v1.CharsetRuleR\x05rules\x12\x14\n" +
+	"\x05words\x18\x05 \x03(\tR\x05words\x12\x1c\n" +
+	"\tseparator\x18\x06 \x01(\tR\tseparator\x12\x16\n" +
+	"\x06prefix\x18\a \x01(\tR\x06prefix\"D\n" +
+	"\vCharsetRule\x12\x18\n" +
+	"\acharset\x18\x01 \x01(\tR\acharset\x12\x1b\n" +
+	"\tmin_chars\x18\x02 \x01(\x05R\bminChars\"L\n" +
+	"\x18PutPasswordPolicyRequest\x120\n" +
+	"\x06policy\x18\x01 \x01(\v2\x18.vault.v1.PasswordPolicyR\x06policy\"\x1b\n" +
+	"\x19PutPasswordPolicyResponse\".\n" +
+	"\x18GetPasswordPolicyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xa5\x01\n" +
+	"\x15GenerateSecretRequest\x12\x16\n" +
+	"\x06policy\x18\x01 \x01(\tR\x06policy\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x03R\n" +
+	"ttlSeconds\x12\x1f\n" +
+	"\vexpire_time\x18\x04 \x01(\x03R\n" +
+	"expireTime\x12 \n" +
+	"\fkms_key_name\x18\x05 \x01(\tR\n" +
+	"kmsKeyName\"i\n" +
+	"\x16GenerateSecretResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x1f\n" +
+	"\vexpire_time\x18\x03 \x01(\x03R\n" +
+	"expireTime\"N\n" +
+	"\x11TransitKeyVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1f\n" +
+	"\vcreate_time\x18\x02 \x01(\x03R\n" +
+	"createTime\"\x99\x02\n" +
+	"\n" +
+	"TransitKey\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12%\n" +
+	"\x0elatest_version\x18\x03 \x01(\x05R\rlatestVersion\x124\n" +
+	"\x16min_decryption_version\x18\x04 \x01(\x05R\x14minDecryptionVersion\x12\x18\n" +
+	"\aderived\x18\x05 \x01(\bR\aderived\x123\n" +
+	"\x15convergent_encryption\x18\x06 \x01(\bR\x14convergentEncryption\x127\n" +
+	"\bversions\x18\a \x03(\v2\x1b.vault.v1.TransitKeyVersionR\bversions\"\x90\x01\n" +
+	"\x17CreateTransitKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\aderived\x18\x03 \x01(\bR\aderived\x123\n" +
+	"\x15convergent_encryption\x18\x04 \x01(\bR\x14convergentEncryption\"*\n" +
+	"\x14GetTransitKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"-\n" +
+	"\x17RotateTransitKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"i\n" +
+	"\x1dUpdateTransitKeyConfigRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x124\n" +
+	"\x16min_decryption_version\x18\x02 \x01(\x05R\x14minDecryptionVersion\"\x84\x01\n" +
+	"\x15TransitEncryptRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tplaintext\x18\x02 \x01(\fR\tplaintext\x12\x18\n" +
+	"\acontext\x18\x03 \x01(\fR\acontext\x12\x1f\n" +
+	"\vkey_version\x18\x04 \x01(\x05R\n" +
+	"keyVersion\"Y\n" +
+	"\x16TransitEncryptResponse\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x01 \x01(\tR\n" +
+	"ciphertext\x12\x1f\n" +
+	"\vkey_version\x18\x02 \x01(\x05R\n" +
+	"keyVersion\"e\n" +
+	"\x15TransitDecryptRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x02 \x01(\tR\n" +
+	"ciphertext\x12\x18\n" +
+	"\acontext\x18\x03 \x01(\fR\acontext\"6\n" +
+	"\x16TransitDecryptResponse\x12\x1c\n" +
+	"\tplaintext\x18\x01 \x01(\fR\tplaintext\"\x85\x01\n" +
+	"\x14TransitRewrapRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x02 \x01(\tR\n" +
+	"ciphertext\x12\x18\n" +
+	"\acontext\x18\x03 \x01(\fR\acontext\x12\x1f\n" +
+	"\vkey_version\x18\x04 \x01(\x05R\n" +
+	"keyVersion\"\xb7\x01\n" +
+	"\x12TransitSignRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05input\x18\x02 \x01(\fR\x05input\x12\x1f\n" +
+	"\vkey_version\x18\x03 \x01(\x05R\n" +
+	"keyVersion\x12%\n" +
+	"\x0ehash_algorithm\x18\x04 \x01(\tR\rhashAlgorithm\x12/\n" +
+	"\x13signature_algorithm\x18\x05 \x01(\tR\x12signatureAlgorithm\"T\n" +
+	"\x13TransitSignResponse\x12\x1c\n" +
+	"\tsignature\x18\x01 \x01(\tR\tsignature\x12\x1f\n" +
+	"\vkey_version\x18\x02 \x01(\x05R\n" +
+	"keyVersion\"\xca\x01\n" +
+	"\x14TransitVerifyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05input\x18\x02 \x01(\fR\x05input\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x12\n" +
+	"\x04hmac\x18\x04 \x01(\tR\x04hmac\x12%\n" +
+	"\x0ehash_algorithm\x18\x05 \x01(\tR\rhashAlgorithm\x12/\n" +
+	"\x13signature_algorithm\x18\x06 \x01(\tR\x12signatureAlgorithm\"-\n" +
+	"\x15TransitVerifyResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\"}\n" +
+	"\x12TransitHMACRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05input\x18\x02 \x01(\fR\x05input\x12\x1f\n" +
+	"\vkey_version\x18\x03 \x01(\x05R\n" +
+	"keyVersion\x12\x1c\n" +
+	"\talgorithm\x18\x04 \x01(\tR\talgorithm\"J\n" +
+	"\x13TransitHMACResponse\x12\x12\n" +
+	"\x04hmac\x18\x01 \x01(\tR\x04hmac\x12\x1f\n" +
+	"\vkey_version\x18\x02 \x01(\x05R\n" +
+	"keyVersion\"Q\n" +
+	"\x1aGetTransitPublicKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vkey_version\x18\x02 \x01(\x05R\n" +
+	"keyVersion\"s\n" +
+	"\x10TransitPublicKey\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\"\xaa\x01\n" +
+	"\x13GenerateRootRequest\x12\x1f\n" +
+	"\vcommon_name\x18\x01 \x01(\tR\n" +
+	"commonName\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x03R\n" +
+	"ttlSeconds\x12\x19\n" +
+	"\bkey_type\x18\x03 \x01(\tR\akeyType\x126\n" +
+	"\x17crl_distribution_points\x18\x04 \x03(\tR\x15crlDistributionPoints\"\xb2\x01\n" +
+	"\x1bGenerateIntermediateRequest\x12\x1f\n" +
+	"\vcommon_name\x18\x01 \x01(\tR\n" +
+	"commonName\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x03R\n" +
+	"ttlSeconds\x12\x19\n" +
+	"\bkey_type\x18\x03 \x01(\tR\akeyType\x126\n" +
+	"\x17crl_distribution_points\x18\x04 \x03(\tR\x15crlDistributionPoints\"&\n" +
+	"\fGetCARequest\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\"\xaa\x01\n" +
+	"\rCACertificate\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12 \n" +
+	"\vcertificate\x18\x02 \x01(\tR\vcertificate\x12#\n" +
+	"\rserial_number\x18\x03 \x01(\tR\fserialNumber\x12\x1f\n" +
+	"\vexpire_time\x18\x04 \x01(\x03R\n" +
+	"expireTime\x12\x19\n" +
+	"\bca_chain\x18\x05 \x03(\tR\acaChain\"\xe8\x02\n" +
+	"\aPKIRole\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
+	"\x0fallowed_domains\x18\x02 \x03(\tR\x0eallowedDomains\x12,\n" +
+	"\x12allow_bare_domains\x18\x03 \x01(\bR\x10allowBareDomains\x12)\n" +
+	"\x10allow_subdomains\x18\x04 \x01(\bR\x0fallowSubdomains\x12\"\n" +
+	"\rallow_ip_sans\x18\x05 \x01(\bR\vallowIpSans\x12\x1f\n" +
+	"\vttl_seconds\x18\x06 \x01(\x03R\n" +
+	"ttlSeconds\x12&\n" +
+	"\x0fmax_ttl_seconds\x18\a \x01(\x03R\rmaxTtlSeconds\x12\x19\n" +
+	"\bkey_type\x18\b \x01(\tR\akeyType\x12\x1b\n" +
+	"\tkey_usage\x18\t \x03(\tR\bkeyUsage\x12\"\n" +
+	"\rext_key_usage\x18\n" +
+	" \x03(\tR\vextKeyUsage\"\x14\n" +
+	"\x12PutPKIRoleResponse\"'\n" +
+	"\x11GetPKIRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xa5\x01\n" +
+	"\x17IssueCertificateRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1f\n" +
+	"\vcommon_name\x18\x02 \x01(\tR\n" +
+	"commonName\x12\x1b\n" +
+	"\talt_names\x18\x03 \x03(\tR\baltNames\x12\x17\n" +
+	"\aip_sans\x18\x04 \x03(\tR\x06ipSans\x12\x1f\n" +
+	"\vttl_seconds\x18\x05 \x01(\x03R\n" +
+	"ttlSeconds\"\xb6\x01\n" +
+	"\x16SignCertificateRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x10\n" +
+	"\x03csr\x18\x02 \x01(\tR\x03csr\x12\x1f\n" +
+	"\vcommon_name\x18\x03 \x01(\tR\n" +
+	"commonName\x12\x1b\n" +
+	"\talt_names\x18\x04 \x03(\tR\baltNames\x12\x17\n" +
+	"\aip_sans\x18\x05 \x03(\tR\x06ipSans\x12\x1f\n" +
+	"\vttl_seconds\x18\x06 \x01(\x03R\n" +
+	"ttlSeconds\"\xd6\x01\n" +
+	"\x11IssuedCertificate\x12 \n" +
+	"\vcertificate\x18\x01 \x01(\tR\vcertificate\x12\x1f\n" +
+	"\vprivate_key\x18\x02 \x01(\tR\n" +
+	"privateKey\x12\x1d\n" +
+	"\n" +
+	"issuing_ca\x18\x03 \x01(\tR\tissuingCa\x12\x19\n" +
+	"\bca_chain\x18\x04 \x03(\tR\acaChain\x12#\n" +
+	"\rserial_number\x18\x05 \x01(\tR\fserialNumber\x12\x1f\n" +
+	"\vexpire_time\x18\x06 \x01(\x03R\n" +
+	"expireTime\"?\n" +
+	"\x18RevokeCertificateRequest\x12#\n" +
+	"\rserial_number\x18\x01 \x01(\tR\fserialNumber\"D\n" +
+	"\x19RevokeCertificateResponse\x12'\n" +
+	"\x0frevocation_time\x18\x01 \x01(\x03R\x0erevocationTime\"'\n" +
+	"\rGetCRLRequest\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\"\x90\x01\n" +
+	"\x03CRL\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x10\n" +
+	"\x03crl\x18\x02 \x01(\tR\x03crl\x12\x1d\n" +
+	"\n" +
+	"crl_number\x18\x03 \x01(\x03R\tcrlNumber\x12\x1f\n" +
+	"\vthis_update\x18\x04 \x01(\x03R\n" +
+	"thisUpdate\x12\x1f\n" +
+	"\vnext_update\x18\x05 \x01(\x03R\n" +
+	"nextUpdate\"1\n" +
+	"\x14GenerateSSHCARequest\x12\x19\n" +
+	"\bkey_type\x18\x01 \x01(\tR\akeyType\"\x1a\n" +
+	"\x18GetSSHCAPublicKeyRequest\"/\n" +
+	"\x0eSSHCAPublicKey\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\tR\tpublicKey\"\x84\x05\n" +
+	"\aSSHRole\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tcert_type\x18\x02 \x01(\tR\bcertType\x12#\n" +
+	"\rallowed_users\x18\x03 \x03(\tR\fallowedUsers\x12!\n" +
+	"\fdefault_user\x18\x04 \x01(\tR\vdefaultUser\x12'\n" +
+	"\x0fallowed_domains\x18\x05 \x03(\tR\x0eallowedDomains\x12)\n" +
+	"\x10allow_subdomains\x18\x06 \x01(\bR\x0fallowSubdomains\x12-\n" +
+	"\x12allowed_extensions\x18\a \x03(\tR\x11allowedExtensions\x12W\n" +
+	"\x12default_extensions\x18\b \x03(\v2(.vault.v1.SSHRole.DefaultExtensionsEntryR\x11defaultExtensions\x12Q\n" +
+	"\x10critical_options\x18\t \x03(\v2&.vault.v1.SSHRole.CriticalOptionsEntryR\x0fcriticalOptions\x12\x1f\n" +
+	"\vttl_seconds\x18\n" +
+	" \x01(\x03R\n" +
+	"ttlSeconds\x12&\n" +
+	"\x0fmax_ttl_seconds\x18\v \x01(\x03R\rmaxTtlSeconds\x1aD\n" +
+	"\x16DefaultExtensionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aB\n" +
+	"\x14CriticalOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x14\n" +
+	"\x12PutSSHRoleResponse\"'\n" +
+	"\x11GetSSHRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xb5\x02\n" +
+	"\x11SignSSHKeyRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12)\n" +
+	"\x10valid_principals\x18\x03 \x03(\tR\x0fvalidPrincipals\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x03R\n" +
+	"ttlSeconds\x12K\n" +
+	"\n" +
+	"extensions\x18\x05 \x03(\v2+.vault.v1.SignSSHKeyRequest.ExtensionsEntryR\n" +
+	"extensions\x12\x15\n" +
+	"\x06key_id\x18\x06 \x01(\tR\x05keyId\x1a=\n" +
+	"\x0fExtensionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"s\n" +
+	"\fSignedSSHKey\x12\x1d\n" +
+	"\n" +
+	"signed_key\x18\x01 \x01(\tR\tsignedKey\x12#\n" +
+	"\rserial_number\x18\x02 \x01(\x04R\fserialNumber\x12\x1f\n" +
+	"\vexpire_time\x18\x03 \x01(\x03R\n" +
+	"expireTime\"\xb4\x01\n" +
+	"\n" +
+	"OIDCConfig\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x1c\n" +
+	"\talgorithm\x18\x02 \x01(\tR\talgorithm\x126\n" +
+	"\x17rotation_period_seconds\x18\x03 \x01(\x03R\x15rotationPeriodSeconds\x128\n" +
+	"\x18verification_ttl_seconds\x18\x04 \x01(\x03R\x16verificationTtlSeconds\"\x17\n" +
+	"\x15PutOIDCConfigResponse\"x\n" +
+	"\bOIDCRole\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1a\n" +
+	"\btemplate\x18\x03 \x01(\tR\btemplate\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x03R\n" +
+	"ttlSeconds\"\x15\n" +
+	"\x13PutOIDCRoleResponse\"(\n" +
+	"\x12GetOIDCRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"2\n" +
+	"\x1cGenerateIdentityTokenRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\"\x84\x01\n" +
+	"\rIdentityToken\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x03R\n" +
+	"ttlSeconds\x12\x1f\n" +
+	"\vexpire_time\x18\x04 \x01(\x03R\n" +
+	"expireTime\"\x16\n" +
+	"\x14RotateOIDCKeyRequest\".\n" +
+	"\x15RotateOIDCKeyResponse\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId2\x8b\x1e\n" +
+	"\fVaultService\x12G\n" +
+	"\n" +
+	"VaultWrite\x12\x1b.vault.v1.VaultWriteRequest\x1a\x1c.vault.v1.VaultWriteResponse\x12D\n" +
+	"\tVaultRead\x12\x1a.vault.v1.VaultReadRequest\x1a\x1b.vault.v1.VaultReadResponse\x12R\n" +
+	"\x10GetSecretVersion\x12!.vault.v1.GetSecretVersionRequest\x1a\x1b.vault.v1.VaultReadResponse\x12_\n" +
+	"\x12ListSecretVersions\x12#.vault.v1.ListSecretVersionsRequest\x1a$.vault.v1.ListSecretVersionsResponse\x12J\n" +
+	"\vListSecrets\x12\x1c.vault.v1.ListSecretsRequest\x1a\x1d.vault.v1.ListSecretsResponse\x12P\n" +
+	"\rTestIAMPolicy\x12\x1e.vault.v1.TestIAMPolicyRequest\x1a\x1f.vault.v1.TestIAMPolicyResponse\x12J\n" +
+	"\vCreateToken\x12\x1c.vault.v1.CreateTokenRequest\x1a\x1d.vault.v1.CreateTokenResponse\x12@\n" +
+	"\vLookupToken\x12\x1c.vault.v1.LookupTokenRequest\x1a\x13.vault.v1.TokenInfo\x12>\n" +
+	"\n" +
+	"RenewToken\x12\x1b.vault.v1.RenewTokenRequest\x1a\x13.vault.v1.TokenInfo\x12J\n" +
+	"\vRevokeToken\x12\x1c.vault.v1.RevokeTokenRequest\x1a\x1d.vault.v1.RevokeTokenResponse\x12D\n" +
+	"\tPutPolicy\x12\x1a.vault.v1.PutPolicyRequest\x1a\x1b.vault.v1.PutPolicyResponse\x129\n" +
+	"\tGetPolicy\x12\x1a.vault.v1.GetPolicyRequest\x1a\x10.vault.v1.Policy\x12G\n" +
+	"\n" +
+	"PutAppRole\x12\x1b.vault.v1.PutAppRoleRequest\x1a\x1c.vault.v1.PutAppRoleResponse\x12M\n" +
+	"\fGetAppRoleID\x12\x1d.vault.v1.GetAppRoleIDRequest\x1a\x1e.vault.v1.GetAppRoleIDResponse\x12Y\n" +
+	"\x10GenerateSecretID\x12!.vault.v1.GenerateSecretIDRequest\x1a\".vault.v1.GenerateSecretIDResponse\x12V\n" +
+	"\x0fDestroySecretID\x12 .vault.v1.DestroySecretIDRequest\x1a!.vault.v1.DestroySecretIDResponse\x12>\n" +
+	"\x05Login\x12\x16.vault.v1.LoginRequest\x1a\x1d.vault.v1.CreateTokenResponse\x12M\n" +
+	"\fPutJWTConfig\x12\x1d.vault.v1.PutJWTConfigRequest\x1a\x1e.vault.v1.PutJWTConfigResponse\x12G\n" +
+	"\n" +
+	"PutJWTRole\x12\x1b.vault.v1.PutJWTRoleRequest\x1a\x1c.vault.v1.PutJWTRoleResponse\x12D\n" +
+	"\bJWTLogin\x12\x19.vault.v1.JWTLoginRequest\x1a\x1d.vault.v1.CreateTokenResponse\x12M\n" +
+	"\fPutCPIConfig\x12\x1d.vault.v1.PutCPIConfigRequest\x1a\x1e.vault.v1.PutCPIConfigResponse\x12V\n" +
+	"\x0fPutAccessWindow\x12 .vault.v1.PutAccessWindowRequest\x1a!.vault.v1.PutAccessWindowResponse\x12V\n" +
+	"\x0fPutControlGroup\x12 .vault.v1.PutControlGroupRequest\x1a!.vault.v1.PutControlGroupResponse\x12H\n" +
+	"\rApproveAccess\x12\x1e.vault.v1.ApproveAccessRequest\x1a\x17.vault.v1.AccessRequest\x12N\n" +
+	"\x10GetAccessRequest\x12!.vault.v1.GetAccessRequestRequest\x1a\x17.vault.v1.AccessRequest\x12G\n" +
+	"\n" +
+	"BreakGlass\x12\x1b.vault.v1.BreakGlassRequest\x1a\x1c.vault.v1.BreakGlassResponse\x12\\\n" +
+	"\x15AcknowledgeBreakGlass\x12&.vault.v1.AcknowledgeBreakGlassRequest\x1a\x1b.vault.v1.BreakGlassSession\x12S\n" +
+	"\x0eListBreakGlass\x12\x1f.vault.v1.ListBreakGlassRequest\x1a .vault.v1.ListBreakGlassResponse\x12Y\n" +
+	"\x10GetAccessHistory\x12!.vault.v1.GetAccessHistoryRequest\x1a\".vault.v1.GetAccessHistoryResponse\x12Q\n" +
+	"\x11GetSecretMetadata\x12\".vault.v1.GetSecretMetadataRequest\x1a\x18.vault.v1.SecretMetadata\x12W\n" +
+	"\x14UpdateSecretMetadata\x12%.vault.v1.UpdateSecretMetadataRequest\x1a\x18.vault.v1.SecretMetadata\x12_\n" +
+	"\x12PutRetentionPolicy\x12#.vault.v1.PutRetentionPolicyRequest\x1a$.vault.v1.PutRetentionPolicyResponse\x12?\n" +
+	"\vPutRotation\x12\x1c.vault.v1.PutRotationRequest\x1a\x12.vault.v1.Rotation\x12?\n" +
+	"\vGetRotation\x12\x1c.vault.v1.GetRotationRequest\x1a\x12.vault.v1.Rotation\x12V\n" +
+	"\x0fPutSecretTopics\x12 .vault.v1.PutSecretTopicsRequest\x1a!.vault.v1.PutSecretTopicsResponse\x12G\n" +
+	"\n" +
+	"PutWebhook\x12\x1b.vault.v1.PutWebhookRequest\x1a\x1c.vault.v1.PutWebhookResponse\x12P\n" +
+	"\rDeleteWebhook\x12\x1e.vault.v1.DeleteWebhookRequest\x1a\x1f.vault.v1.DeleteWebhookResponse\x12S\n" +
+	"\x0eListDeliveries\x12\x1f.vault.v1.ListDeliveriesRequest\x1a .vault.v1.ListDeliveriesResponse\x12\\\n" +
+	"\x11PutDatabaseConfig\x12\".vault.v1.PutDatabaseConfigRequest\x1a#.vault.v1.PutDatabaseConfigResponse\x12V\n" +
+	"\x0fPutDatabaseRole\x12 .vault.v1.PutDatabaseRoleRequest\x1a!.vault.v1.PutDatabaseRoleResponse\x12`\n" +
+	"\x16GetDatabaseCredentials\x12'.vault.v1.GetDatabaseCredentialsRequest\x1a\x1d.vault.v1.DatabaseCredentials\x12:\n" +
+	"\n" +
+	"RenewLease\x12\x1b.vault.v1.RenewLeaseRequest\x1a\x0f.vault.v1.Lease\x12J\n" +
+	"\vRevokeLease\x12\x1c.vault.v1.RevokeLeaseRequest\x1a\x1d.vault.v1.RevokeLeaseResponse\x12M\n" +
+	"\fRevokePrefix\x12\x1d.vault.v1.RevokePrefixRequest\x1a\x1e.vault.v1.RevokePrefixResponse\x12Y\n" +
+	"\x10ReencryptSecrets\x12!.vault.v1.ReencryptSecretsRequest\x1a\".vault.v1.ReencryptSecretsResponse\x12\\\n" +
+	"\x11PutPasswordPolicy\x12\".vault.v1.PutPasswordPolicyRequest\x1a#.vault.v1.PutPasswordPolicyResponse\x12Q\n" +
+	"\x11GetPasswordPolicy\x12\".vault.v1.GetPasswordPolicyRequest\x1a\x18.vault.v1.PasswordPolicy\x12S\n" +
+	"\x0eGenerateSecret\x12\x1f.vault.v1.GenerateSecretRequest\x1a .vault.v1.GenerateSecretResponse2M\n" +
+	"\x0eRotatorService\x12;\n" +
+	"\x06Rotate\x12\x17.vault.v1.RotateRequest\x1a\x18.vault.v1.RotateResponse2\xbd\x06\n" +
+	"\x0eTransitService\x12D\n" +
+	"\tCreateKey\x12!.vault.v1.CreateTransitKeyRequest\x1a\x14.vault.v1.TransitKey\x12>\n" +
+	"\x06GetKey\x12\x1e.vault.v1.GetTransitKeyRequest\x1a\x14.vault.v1.TransitKey\x12D\n" +
+	"\tRotateKey\x12!.vault.v1.RotateTransitKeyRequest\x1a\x14.vault.v1.TransitKey\x12P\n" +
+	"\x0fUpdateKeyConfig\x12'.vault.v1.UpdateTransitKeyConfigRequest\x1a\x14.vault.v1.TransitKey\x12L\n" +
+	"\aEncrypt\x12\x1f.vault.v1.TransitEncryptRequest\x1a .vault.v1.TransitEncryptResponse\x12L\n" +
+	"\aDecrypt\x12\x1f.vault.v1.TransitDecryptRequest\x1a .vault.v1.TransitDecryptResponse\x12J\n" +
+	"\x06Rewrap\x12\x1e.vault.v1.TransitRewrapRequest\x1a .vault.v1.TransitEncryptResponse\x12C\n" +
+	"\x04Sign\x12\x1c.vault.v1.TransitSignRequest\x1a\x1d.vault.v1.TransitSignResponse\x12I\n" +
+	"\x06Verify\x12\x1e.vault.v1.TransitVerifyRequest\x1a\x1f.vault.v1.TransitVerifyResponse\x12C\n" +
+	"\x04HMAC\x12\x1c.vault.v1.TransitHMACRequest\x1a\x1d.vault.v1.TransitHMACResponse\x12P\n" +
+	"\fGetPublicKey\x12$.vault.v1.GetTransitPublicKeyRequest\x1a\x1a.vault.v1.TransitPublicKey2\x93\x05\n" +
+	"\n" +
+	"PKIService\x12F\n" +
+	"\fGenerateRoot\x12\x1d.vault.v1.GenerateRootRequest\x1a\x17.vault.v1.CACertificate\x12V\n" +
+	"\x14GenerateIntermediate\x12%.vault.v1.GenerateIntermediateRequest\x1a\x17.vault.v1.CACertificate\x128\n" +
+	"\x05GetCA\x12\x16.vault.v1.GetCARequest\x1a\x17.vault.v1.CACertificate\x12:\n" +
+	"\aPutRole\x12\x11.vault.v1.PKIRole\x1a\x1c.vault.v1.PutPKIRoleResponse\x129\n" +
+	"\aGetRole\x12\x1b.vault.v1.GetPKIRoleRequest\x1a\x11.vault.v1.PKIRole\x12R\n" +
+	"\x10IssueCertificate\x12!.vault.v1.IssueCertificateRequest\x1a\x1b.vault.v1.IssuedCertificate\x12P\n" +
+	"\x0fSignCertificate\x12 .vault.v1.SignCertificateRequest\x1a\x1b.vault.v1.IssuedCertificate\x12\\\n" +
+	"\x11RevokeCertificate\x12\".vault.v1.RevokeCertificateRequest\x1a#.vault.v1.RevokeCertificateResponse\x120\n" +
+	"\x06GetCRL\x12\x17.vault.v1.GetCRLRequest\x1a\r.vault.v1.CRL2\xde\x02\n" +
+	"\n" +
+	"SSHService\x12F\n" +
+	"\n" +
+	"GenerateCA\x12\x1e.vault.v1.GenerateSSHCARequest\x1a\x18.vault.v1.SSHCAPublicKey\x12N\n" +
+	"\x0eGetCAPublicKey\x12\".vault.v1.GetSSHCAPublicKeyRequest\x1a\x18.vault.v1.SSHCAPublicKey\x12:\n" +
+	"\aPutRole\x12\x11.vault.v1.SSHRole\x1a\x1c.vault.v1.PutSSHRoleResponse\x129\n" +
+	"\aGetRole\x12\x1b.vault.v1.GetSSHRoleRequest\x1a\x11.vault.v1.SSHRole\x12A\n" +
+	"\n" +
+	"SignSSHKey\x12\x1b.vault.v1.SignSSHKeyRequest\x1a\x16.vault.v1.SignedSSHKey2\xec\x02\n" +
+	"\vOIDCService\x12B\n" +
+	"\tPutConfig\x12\x14.vault.v1.OIDCConfig\x1a\x1f.vault.v1.PutOIDCConfigResponse\x12<\n" +
+	"\aPutRole\x12\x12.vault.v1.OIDCRole\x1a\x1d.vault.v1.PutOIDCRoleResponse\x12;\n" +
+	"\aGetRole\x12\x1c.vault.v1.GetOIDCRoleRequest\x1a\x12.vault.v1.OIDCRole\x12P\n" +
+	"\rGenerateToken\x12&.vault.v1.GenerateIdentityTokenRequest\x1a\x17.vault.v1.IdentityToken\x12L\n" +
+	"\tRotateKey\x12\x1e.vault.v1.RotateOIDCKeyRequest\x1a\x1f.vault.v1.RotateOIDCKeyResponseB'Z%OlympusGCP-Vault/gen/v1/vault;vaultv1b\x06proto3"
+
+var (
+	file_v1_vault_vault_proto_rawDescOnce sync.Once
+	file_v1_vault_vault_proto_rawDescData []byte
+)
+
+func file_v1_vault_vault_proto_rawDescGZIP() []byte {
+	file_v1_vault_vault_proto_rawDescOnce.Do(func() {
+		file_v1_vault_vault_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_vault_vault_proto_rawDesc), len(file_v1_vault_vault_proto_rawDesc)))
+	})
+	return file_v1_vault_vault_proto_rawDescData
+}
+
+var file_v1_vault_vault_proto_msgTypes = make([]protoimpl.MessageInfo, 164)
+var file_v1_vault_vault_proto_goTypes = []any{
+	(*VaultWriteRequest)(nil),             // 0: vault.v1.VaultWriteRequest
+	(*VaultWriteResponse)(nil),            // 1: vault.v1.VaultWriteResponse
+	(*VaultReadRequest)(nil),              // 2: vault.v1.VaultReadRequest
+	(*VaultReadResponse)(nil),             // 3: vault.v1.VaultReadResponse
+	(*GetSecretVersionRequest)(nil),       // 4: vault.v1.GetSecretVersionRequest
+	(*ListSecretVersionsRequest)(nil),     // 5: vault.v1.ListSecretVersionsRequest
+	(*ListSecretVersionsResponse)(nil),    // 6: vault.v1.ListSecretVersionsResponse
+	(*ListSecretsRequest)(nil),            // 7: vault.v1.ListSecretsRequest
+	(*ListSecretsResponse)(nil),           // 8: vault.v1.ListSecretsResponse
+	(*TestIAMPolicyRequest)(nil),          // 9: vault.v1.TestIAMPolicyRequest
+	(*TestIAMPolicyResponse)(nil),         // 10: vault.v1.TestIAMPolicyResponse
+	(*CreateTokenRequest)(nil),            // 11: vault.v1.CreateTokenRequest
+	(*CreateTokenResponse)(nil),           // 12: vault.v1.CreateTokenResponse
+	(*TokenInfo)(nil),                     // 13: vault.v1.TokenInfo
+	(*LookupTokenRequest)(nil),            // 14: vault.v1.LookupTokenRequest
+	(*RenewTokenRequest)(nil),             // 15: vault.v1.RenewTokenRequest
+	(*RevokeTokenRequest)(nil),            // 16: vault.v1.RevokeTokenRequest
+	(*RevokeTokenResponse)(nil),           // 17: vault.v1.RevokeTokenResponse
+	(*PolicyRule)(nil),                    // 18: vault.v1.PolicyRule
+	(*Policy)(nil),                        // 19: vault.v1.Policy
+	(*PutPolicyRequest)(nil),              // 20: vault.v1.PutPolicyRequest
+	(*PutPolicyResponse)(nil),             // 21: vault.v1.PutPolicyResponse
+	(*GetPolicyRequest)(nil),              // 22: vault.v1.GetPolicyRequest
+	(*AppRole)(nil),                       // 23: vault.v1.AppRole
+	(*PutAppRoleRequest)(nil),             // 24: vault.v1.PutAppRoleRequest
+	(*PutAppRoleResponse)(nil),            // 25: vault.v1.PutAppRoleResponse
+	(*GetAppRoleIDRequest)(nil),           // 26: vault.v1.GetAppRoleIDRequest
+	(*GetAppRoleIDResponse)(nil),          // 27: vault.v1.GetAppRoleIDResponse
+	(*GenerateSecretIDRequest)(nil),       // 28: vault.v1.GenerateSecretIDRequest
+	(*GenerateSecretIDResponse)(nil),      // 29: vault.v1.GenerateSecretIDResponse
+	(*DestroySecretIDRequest)(nil),        // 30: vault.v1.DestroySecretIDRequest
+	(*DestroySecretIDResponse)(nil),       // 31: vault.v1.DestroySecretIDResponse
+	(*LoginRequest)(nil),                  // 32: vault.v1.LoginRequest
+	(*JWTConfig)(nil),                     // 33: vault.v1.JWTConfig
+	(*PutJWTConfigRequest)(nil),           // 34: vault.v1.PutJWTConfigRequest
+	(*PutJWTConfigResponse)(nil),          // 35: vault.v1.PutJWTConfigResponse
+	(*JWTRole)(nil),                       // 36: vault.v1.JWTRole
+	(*PutJWTRoleRequest)(nil),             // 37: vault.v1.PutJWTRoleRequest
+	(*PutJWTRoleResponse)(nil),            // 38: vault.v1.PutJWTRoleResponse
+	(*JWTLoginRequest)(nil),               // 39: vault.v1.JWTLoginRequest
+	(*CPIConfig)(nil),                     // 40: vault.v1.CPIConfig
+	(*PutCPIConfigRequest)(nil),           // 41: vault.v1.PutCPIConfigRequest
+	(*PutCPIConfigResponse)(nil),          // 42: vault.v1.PutCPIConfigResponse
+	(*AccessWindow)(nil),                  // 43: vault.v1.AccessWindow
+	(*PutAccessWindowRequest)(nil),        // 44: vault.v1.PutAccessWindowRequest
+	(*PutAccessWindowResponse)(nil),       // 45: vault.v1.PutAccessWindowResponse
+	(*ControlGroup)(nil),                  // 46: vault.v1.ControlGroup
+	(*PutControlGroupRequest)(nil),        // 47: vault.v1.PutControlGroupRequest
+	(*PutControlGroupResponse)(nil),       // 48: vault.v1.PutControlGroupResponse
+	(*AccessRequest)(nil),                 // 49: vault.v1.AccessRequest
+	(*ApproveAccessRequest)(nil),          // 50: vault.v1.ApproveAccessRequest
+	(*GetAccessRequestRequest)(nil),       // 51: vault.v1.GetAccessRequestRequest
+	(*BreakGlassRequest)(nil),             // 52: vault.v1.BreakGlassRequest
+	(*BreakGlassSession)(nil),             // 53: vault.v1.BreakGlassSession
+	(*BreakGlassResponse)(nil),            // 54: vault.v1.BreakGlassResponse
+	(*AcknowledgeBreakGlassRequest)(nil),  // 55: vault.v1.AcknowledgeBreakGlassRequest
+	(*ListBreakGlassRequest)(nil),         // 56: vault.v1.ListBreakGlassRequest
+	(*ListBreakGlassResponse)(nil),        // 57: vault.v1.ListBreakGlassResponse
+	(*GetAccessHistoryRequest)(nil),       // 58: vault.v1.GetAccessHistoryRequest
+	(*AccessRecord)(nil),                  // 59: vault.v1.AccessRecord
+	(*GetAccessHistoryResponse)(nil),      // 60: vault.v1.GetAccessHistoryResponse
+	(*GetSecretMetadataRequest)(nil),      // 61: vault.v1.GetSecretMetadataRequest
+	(*VersionMetadata)(nil),               // 62: vault.v1.VersionMetadata
+	(*SecretMetadata)(nil),                // 63: vault.v1.SecretMetadata
+	(*UpdateSecretMetadataRequest)(nil),   // 64: vault.v1.UpdateSecretMetadataRequest
+	(*RetentionPolicy)(nil),               // 65: vault.v1.RetentionPolicy
+	(*PutRetentionPolicyRequest)(nil),     // 66: vault.v1.PutRetentionPolicyRequest
+	(*PutRetentionPolicyResponse)(nil),    // 67: vault.v1.PutRetentionPolicyResponse
+	(*ReencryptSecretsRequest)(nil),       // 68: vault.v1.ReencryptSecretsRequest
+	(*ReencryptSecretsResponse)(nil),      // 69: vault.v1.ReencryptSecretsResponse
+	(*Rotator)(nil),                       // 70: vault.v1.Rotator
+	(*Rotation)(nil),                      // 71: vault.v1.Rotation
+	(*PutRotationRequest)(nil),            // 72: vault.v1.PutRotationRequest
+	(*GetRotationRequest)(nil),            // 73: vault.v1.GetRotationRequest
+	(*RotateRequest)(nil),                 // 74: vault.v1.RotateRequest
+	(*RotateResponse)(nil),                // 75: vault.v1.RotateResponse
+	(*Topic)(nil),                         // 76: vault.v1.Topic
+	(*PutSecretTopicsRequest)(nil),        // 77: vault.v1.PutSecretTopicsRequest
+	(*PutSecretTopicsResponse)(nil),       // 78: vault.v1.PutSecretTopicsResponse
+	(*Webhook)(nil),                       // 79: vault.v1.Webhook
+	(*PutWebhookRequest)(nil),             // 80: vault.v1.PutWebhookRequest
+	(*PutWebhookResponse)(nil),            // 81: vault.v1.PutWebhookResponse
+	(*DeleteWebhookRequest)(nil),          // 82: vault.v1.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),         // 83: vault.v1.DeleteWebhookResponse
+	(*ListDeliveriesRequest)(nil),         // 84: vault.v1.ListDeliveriesRequest
+	(*Delivery)(nil),                      // 85: vault.v1.Delivery
+	(*ListDeliveriesResponse)(nil),        // 86: vault.v1.ListDeliveriesResponse
+	(*DatabaseConfig)(nil),                // 87: vault.v1.DatabaseConfig
+	(*PutDatabaseConfigRequest)(nil),      // 88: vault.v1.PutDatabaseConfigRequest
+	(*PutDatabaseConfigResponse)(nil),     // 89: vault.v1.PutDatabaseConfigResponse
+	(*DatabaseRole)(nil),                  // 90: vault.v1.DatabaseRole
+	(*PutDatabaseRoleRequest)(nil),        // 91: vault.v1.PutDatabaseRoleRequest
+	(*PutDatabaseRoleResponse)(nil),       // 92: vault.v1.PutDatabaseRoleResponse
+	(*GetDatabaseCredentialsRequest)(nil), // 93: vault.v1.GetDatabaseCredentialsRequest
+	(*DatabaseCredentials)(nil),           // 94: vault.v1.DatabaseCredentials
+	(*Lease)(nil),                         // 95: vault.v1.Lease
+	(*RenewLeaseRequest)(nil),             // 96: vault.v1.RenewLeaseRequest
+	(*RevokeLeaseRequest)(nil),            // 97: vault.v1.RevokeLeaseRequest
+	(*RevokeLeaseResponse)(nil),           // 98: vault.v1.RevokeLeaseResponse
+	(*RevokePrefixRequest)(nil),           // 99: vault.v1.RevokePrefixRequest
+	(*RevokePrefixResponse)(nil),          // 100: vault.v1.RevokePrefixResponse
+	(*PasswordPolicy)(nil),                // 101: vault.v1.PasswordPolicy
+	(*CharsetRule)(nil),                   // 102: vault.v1.CharsetRule
+	(*PutPasswordPolicyRequest)(nil),      // 103: vault.v1.PutPasswordPolicyRequest
+	(*PutPasswordPolicyResponse)(nil),     // 104: vault.v1.PutPasswordPolicyResponse
+	(*GetPasswordPolicyRequest)(nil),      // 105: vault.v1.GetPasswordPolicyRequest
+	(*GenerateSecretRequest)(nil),         // 106: vault.v1.GenerateSecretRequest
+	(*GenerateSecretResponse)(nil),        // 107: vault.v1.GenerateSecretResponse
+	(*TransitKeyVersion)(nil),             // 108: vault.v1.TransitKeyVersion
+	(*TransitKey)(nil),                    // 109: vault.v1.TransitKey
+	(*CreateTransitKeyRequest)(nil),       // 110: vault.v1.CreateTransitKeyRequest
+	(*GetTransitKeyRequest)(nil),          // 111: vault.v1.GetTransitKeyRequest
+	(*RotateTransitKeyRequest)(nil),       // 112: vault.v1.RotateTransitKeyRequest
+	(*UpdateTransitKeyConfigRequest)(nil), // 113: vault.v1.UpdateTransitKeyConfigRequest
+	(*TransitEncryptRequest)(nil),         // 114: vault.v1.TransitEncryptRequest
+	(*TransitEncryptResponse)(nil),        // 115: vault.v1.TransitEncryptResponse
+	(*TransitDecryptRequest)(nil),         // 116: vault.v1.TransitDecryptRequest
+	(*TransitDecryptResponse)(nil),        // 117: vault.v1.TransitDecryptResponse
+	(*TransitRewrapRequest)(nil),          // 118: vault.v1.TransitRewrapRequest
+	(*TransitSignRequest)(nil),            // 119: vault.v1.TransitSignRequest
+	(*TransitSignResponse)(nil),           // 120: vault.v1.TransitSignResponse
+	(*TransitVerifyRequest)(nil),          // 121: vault.v1.TransitVerifyRequest
+	(*TransitVerifyResponse)(nil),         // 122: vault.v1.TransitVerifyResponse
+	(*TransitHMACRequest)(nil),            // 123: vault.v1.TransitHMACRequest
+	(*TransitHMACResponse)(nil),           // 124: vault.v1.TransitHMACResponse
+	(*GetTransitPublicKeyRequest)(nil),    // 125: vault.v1.GetTransitPublicKeyRequest
+	(*TransitPublicKey)(nil),              // 126: vault.v1.TransitPublicKey
+	(*GenerateRootRequest)(nil),           // 127: vault.v1.GenerateRootRequest
+	(*GenerateIntermediateRequest)(nil),   // 128: vault.v1.GenerateIntermediateRequest
+	(*GetCARequest)(nil),                  // 129: vault.v1.GetCARequest
+	(*CACertificate)(nil),                 // 130: vault.v1.CACertificate
+	(*PKIRole)(nil),                       // 131: vault.v1.PKIRole
+	(*PutPKIRoleResponse)(nil),            // 132: vault.v1.PutPKIRoleResponse
+	(*GetPKIRoleRequest)(nil),             // 133: vault.v1.GetPKIRoleRequest
+	(*IssueCertificateRequest)(nil),       // 134: vault.v1.IssueCertificateRequest
+	(*SignCertificateRequest)(nil),        // 135: vault.v1.SignCertificateRequest
+	(*IssuedCertificate)(nil),             // 136: vault.v1.IssuedCertificate
+	(*RevokeCertificateRequest)(nil),      // 137: vault.v1.RevokeCertificateRequest
+	(*RevokeCertificateResponse)(nil),     // 138: vault.v1.RevokeCertificateResponse
+	(*GetCRLRequest)(nil),                 // 139: vault.v1.GetCRLRequest
+	(*CRL)(nil),                           // 140: vault.v1.CRL
+	(*GenerateSSHCARequest)(nil),          // 141: vault.v1.GenerateSSHCARequest
+	(*GetSSHCAPublicKeyRequest)(nil),      // 142: vault.v1.GetSSHCAPublicKeyRequest
+	(*SSHCAPublicKey)(nil),                // 143: vault.v1.SSHCAPublicKey
+	(*SSHRole)(nil),                       // 144: vault.v1.SSHRole
+	(*PutSSHRoleResponse)(nil),            // 145: vault.v1.PutSSHRoleResponse
+	(*GetSSHRoleRequest)(nil),             // 146: vault.v1.GetSSHRoleRequest
+	(*SignSSHKeyRequest)(nil),             // 147: vault.v1.SignSSHKeyRequest
+	(*SignedSSHKey)(nil),                  // 148: vault.v1.SignedSSHKey
+	(*OIDCConfig)(nil),                    // 149: vault.v1.OIDCConfig
+	(*PutOIDCConfigResponse)(nil),         // 150: vault.v1.PutOIDCConfigResponse
+	(*OIDCRole)(nil),                      // 151: vault.v1.OIDCRole
+	(*PutOIDCRoleResponse)(nil),           // 152: vault.v1.PutOIDCRoleResponse
+	(*GetOIDCRoleRequest)(nil),            // 153: vault.v1.GetOIDCRoleRequest
+	(*GenerateIdentityTokenRequest)(nil),  // 154: vault.v1.GenerateIdentityTokenRequest
+	(*IdentityToken)(nil),                 // 155: vault.v1.IdentityToken
+	(*RotateOIDCKeyRequest)(nil),          // 156: vault.v1.RotateOIDCKeyRequest
+	(*RotateOIDCKeyResponse)(nil),         // 157: vault.v1.RotateOIDCKeyResponse
+	nil,                                   // 158: vault.v1.GenerateSecretIDRequest.MetadataEntry
+	nil,                                   // 159: vault.v1.JWTRole.BoundClaimsEntry
+	nil,                                   // 160: vault.v1.JWTRole.GroupPoliciesEntry
+	nil,                                   // 161: vault.v1.SSHRole.DefaultExtensionsEntry
+	nil,                                   // 162: vault.v1.SSHRole.CriticalOptionsEntry
+	nil,                                   // 163: vault.v1.SignSSHKeyRequest.ExtensionsEntry
+}
+var file_v1_vault_vault_proto_depIdxs = []int32{
+	13,  // 0: vault.v1.CreateTokenResponse.info:type_name -> vault.v1.TokenInfo
+	18,  // 1: vault.v1.Policy.rules:type_name -> vault.v1.PolicyRule
+	43,  // 2: vault.v1.Policy.window:type_name -> vault.v1.AccessWindow
+	19,  // 3: vault.v1.PutPolicyRequest.policy:type_name -> vault.v1.Policy
+	23,  // 4: vault.v1.PutAppRoleRequest.role:type_name -> vault.v1.AppRole
+	158, // 5: vault.v1.GenerateSecretIDRequest.metadata:type_name -> vault.v1.GenerateSecretIDRequest.MetadataEntry
+	33,  // 6: vault.v1.PutJWTConfigRequest.config:type_name -> vault.v1.JWTConfig
+	159, // 7: vault.v1.JWTRole.bound_claims:type_name -> vault.v1.JWTRole.BoundClaimsEntry
+	160, // 8: vault.v1.JWTRole.group_policies:type_name -> vault.v1.JWTRole.GroupPoliciesEntry
+	36,  // 9: vault.v1.PutJWTRoleRequest.role:type_name -> vault.v1.JWTRole
+	40,  // 10: vault.v1.PutCPIConfigRequest.config:type_name -> vault.v1.CPIConfig
+	43,  // 11: vault.v1.PutAccessWindowRequest.window:type_name -> vault.v1.AccessWindow
+	46,  // 12: vault.v1.PutControlGroupRequest.control_group:type_name -> vault.v1.ControlGroup
+	53,  // 13: vault.v1.BreakGlassResponse.session:type_name -> vault.v1.BreakGlassSession
+	53,  // 14: vault.v1.ListBreakGlassResponse.sessions:type_name -> vault.v1.BreakGlassSession
+	59,  // 15: vault.v1.GetAccessHistoryResponse.records:type_name -> vault.v1.AccessRecord
+	62,  // 16: vault.v1.SecretMetadata.versions:type_name -> vault.v1.VersionMetadata
+	65,  // 17: vault.v1.SecretMetadata.retention:type_name -> vault.v1.RetentionPolicy
+	76,  // 18: vault.v1.SecretMetadata.topics:type_name -> vault.v1.Topic
+	65,  // 19: vault.v1.PutRetentionPolicyRequest.policy:type_name -> vault.v1.RetentionPolicy
+	70,  // 20: vault.v1.Rotation.rotator:type_name -> vault.v1.Rotator
+	71,  // 21: vault.v1.PutRotationRequest.rotation:type_name -> vault.v1.Rotation
+	76,  // 22: vault.v1.PutSecretTopicsRequest.topics:type_name -> vault.v1.Topic
+	79,  // 23: vault.v1.PutWebhookRequest.webhook:type_name -> vault.v1.Webhook
+	85,  // 24: vault.v1.ListDeliveriesResponse.deliveries:type_name -> vault.v1.Delivery
+	87,  // 25: vault.v1.PutDatabaseConfigRequest.config:type_name -> vault.v1.DatabaseConfig
+	90,  // 26: vault.v1.PutDatabaseRoleRequest.role:type_name -> vault.v1.DatabaseRole
+	102, // 27: vault.v1.PasswordPolicy.rules:type_name -> vault.v1.CharsetRule
+	101, // 28: vault.v1.PutPasswordPolicyRequest.policy:type_name -> vault.v1.PasswordPolicy
+	108, // 29: vault.v1.TransitKey.versions:type_name -> vault.v1.TransitKeyVersion
+	161, // 30: vault.v1.SSHRole.default_extensions:type_name -> vault.v1.SSHRole.DefaultExtensionsEntry
+	162, // 31: vault.v1.SSHRole.critical_options:type_name -> vault.v1.SSHRole.CriticalOptionsEntry
+	163, // 32: vault.v1.SignSSHKeyRequest.extensions:type_name -> vault.v1.SignSSHKeyRequest.ExtensionsEntry
+	0,   // 33: vault.v1.VaultService.VaultWrite:input_type -> vault.v1.VaultWriteRequest
+	2,   // 34: vault.v1.VaultService.VaultRead:input_type -> vault.v1.VaultReadRequest
+	4,   // 35: vault.v1.VaultService.GetSecretVersion:input_type -> vault.v1.GetSecretVersionRequest
+	5,   // 36: vault.v1.VaultService.ListSecretVersions:input_type -> vault.v1.ListSecretVersionsRequest
+	7,   // 37: vault.v1.VaultService.ListSecrets:input_type -> vault.v1.ListSecretsRequest
+	9,   // 38: vault.v1.VaultService.TestIAMPolicy:input_type -> vault.v1.TestIAMPolicyRequest
+	11,  // 39: vault.v1.VaultService.CreateToken:input_type -> vault.v1.CreateTokenRequest
+	14,  // 40: vault.v1.VaultService.LookupToken:input_type -> vault.v1.LookupTokenRequest
+	15,  // 41: vault.v1.VaultService.RenewToken:input_type -> vault.v1.RenewTokenRequest
+	16,  // 42: vault.v1.VaultService.RevokeToken:input_type -> vault.v1.RevokeTokenRequest
+	20,  // 43: vault.v1.VaultService.PutPolicy:input_type -> vault.v1.PutPolicyRequest
+	22,  // 44: vault.v1.VaultService.GetPolicy:input_type -> vault.v1.GetPolicyRequest
+	24,  // 45: vault.v1.VaultService.PutAppRole:input_type -> vault.v1.PutAppRoleRequest
+	26,  // 46: vault.v1.VaultService.GetAppRoleID:input_type -> vault.v1.GetAppRoleIDRequest
+	28,  // 47: vault.v1.VaultService.GenerateSecretID:input_type -> vault.v1.GenerateSecretIDRequest
+	30,  // 48: vault.v1.VaultService.DestroySecretID:input_type -> vault.v1.DestroySecretIDRequest
+	32,  // 49: vault.v1.VaultService.Login:input_type -> vault.v1.LoginRequest
+	34,  // 50: vault.v1.VaultService.PutJWTConfig:input_type -> vault.v1.PutJWTConfigRequest
+	37,  // 51: vault.v1.VaultService.PutJWTRole:input_type -> vault.v1.PutJWTRoleRequest
+	39,  // 52: vault.v1.VaultService.JWTLogin:input_type -> vault.v1.JWTLoginRequest
+	41,  // 53: vault.v1.VaultService.PutCPIConfig:input_type -> vault.v1.PutCPIConfigRequest
+	44,  // 54: vault.v1.VaultService.PutAccessWindow:input_type -> vault.v1.PutAccessWindowRequest
+	47,  // 55: vault.v1.VaultService.PutControlGroup:input_type -> vault.v1.PutControlGroupRequest
+	50,  // 56: vault.v1.VaultService.ApproveAccess:input_type -> vault.v1.ApproveAccessRequest
+	51,  // 57: vault.v1.VaultService.GetAccessRequest:input_type -> vault.v1.GetAccessRequestRequest
+	52,  // 58: vault.v1.VaultService.BreakGlass:input_type -> vault.v1.BreakGlassRequest
+	55,  // 59: vault.v1.VaultService.AcknowledgeBreakGlass:input_type -> vault.v1.AcknowledgeBreakGlassRequest
+	56,  // 60: vault.v1.VaultService.ListBreakGlass:input_type -> vault.v1.ListBreakGlassRequest
+	58,  // 61: vault.v1.VaultService.GetAccessHistory:input_type -> vault.v1.GetAccessHistoryRequest
+	61,  // 62: vault.v1.VaultService.GetSecretMetadata:input_type -> vault.v1.GetSecretMetadataRequest
+	64,  // 63: vault.v1.VaultService.UpdateSecretMetadata:input_type -> vault.v1.UpdateSecretMetadataRequest
+	66,  // 64: vault.v1.VaultService.PutRetentionPolicy:input_type -> vault.v1.PutRetentionPolicyRequest
+	72,  // 65: vault.v1.VaultService.PutRotation:input_type -> vault.v1.PutRotationRequest
+	73,  // 66: vault.v1.VaultService.GetRotation:input_type -> vault.v1.GetRotationRequest
+	77,  // 67: vault.v1.VaultService.PutSecretTopics:input_type -> vault.v1.PutSecretTopicsRequest
+	80,  // 68: vault.v1.VaultService.PutWebhook:input_type -> vault.v1.PutWebhookRequest
+	82,  // 69: vault.v1.VaultService.DeleteWebhook:input_type -> vault.v1.DeleteWebhookRequest
+	84,  // 70: vault.v1.VaultService.ListDeliveries:input_type -> vault.v1.ListDeliveriesRequest
+	88,  // 71: vault.v1.VaultService.PutDatabaseConfig:input_type -> vault.v1.PutDatabaseConfigRequest
+	91,  // 72: vault.v1.VaultService.PutDatabaseRole:input_type -> vault.v1.PutDatabaseRoleRequest
+	93,  // 73: vault.v1.VaultService.GetDatabaseCredentials:input_type -> vault.v1.GetDatabaseCredentialsRequest
+	96,  // 74: vault.v1.VaultService.RenewLease:input_type -> vault.v1.RenewLeaseRequest
+	97,  // 75: vault.v1.VaultService.RevokeLease:input_type -> vault.v1.RevokeLeaseRequest
+	99,  // 76: vault.v1.VaultService.RevokePrefix:input_type -> vault.v1.RevokePrefixRequest
+	68,  // 77: vault.v1.VaultService.ReencryptSecrets:input_type -> vault.v1.ReencryptSecretsRequest
+	103, // 78: vault.v1.VaultService.PutPasswordPolicy:input_type -> vault.v1.PutPasswordPolicyRequest
+	105, // 79: vault.v1.VaultService.GetPasswordPolicy:input_type -> vault.v1.GetPasswordPolicyRequest
+	106, // 80: vault.v1.VaultService.GenerateSecret:input_type -> vault.v1.GenerateSecretRequest
+	74,  // 81: vault.v1.RotatorService.Rotate:input_type -> vault.v1.RotateRequest
+	110, // 82: vault.v1.TransitService.CreateKey:input_type -> vault.v1.CreateTransitKeyRequest
+	111, // 83: vault.v1.TransitService.GetKey:input_type -> vault.v1.GetTransitKeyRequest
+	112, // 84: vault.v1.TransitService.RotateKey:input_type -> vault.v1.RotateTransitKeyRequest
+	113, // 85: vault.v1.TransitService.UpdateKeyConfig:input_type -> vault.v1.UpdateTransitKeyConfigRequest
+	114, // 86: vault.v1.TransitService.Encrypt:input_type -> vault.v1.TransitEncryptRequest
+	116, // 87: vault.v1.TransitService.Decrypt:input_type -> vault.v1.TransitDecryptRequest
+	118, // 88: vault.v1.TransitService.Rewrap:input_type -> vault.v1.TransitRewrapRequest
+	119, // 89: vault.v1.TransitService.Sign:input_type -> vault.v1.TransitSignRequest
+	121, // 90: vault.v1.TransitService.Verify:input_type -> vault.v1.TransitVerifyRequest
+	123, // 91: vault.v1.TransitService.HMAC:input_type -> vault.v1.TransitHMACRequest
+	125, // 92: vault.v1.TransitService.GetPublicKey:input_type -> vault.v1.GetTransitPublicKeyRequest
+	127, // 93: vault.v1.PKIService.GenerateRoot:input_type -> vault.v1.GenerateRootRequest
+	128, // 94: vault.v1.PKIService.GenerateIntermediate:input_type -> vault.v1.GenerateIntermediateRequest
+	129, // 95: vault.v1.PKIService.GetCA:input_type -> vault.v1.GetCARequest
+	131, // 96: vault.v1.PKIService.PutRole:input_type -> vault.v1.PKIRole
+	133, // 97: vault.v1.PKIService.GetRole:input_type -> vault.v1.GetPKIRoleRequest
+	134, // 98: vault.v1.PKIService.IssueCertificate:input_type -> vault.v1.IssueCertificateRequest
+	135, // 99: vault.v1.PKIService.SignCertificate:input_type -> vault.v1.SignCertificateRequest
+	137, // 100: vault.v1.PKIService.RevokeCertificate:input_type -> vault.v1.RevokeCertificateRequest
+	139, // 101: vault.v1.PKIService.GetCRL:input_type -> vault.v1.GetCRLRequest
+	141, // 102: vault.v1.SSHService.GenerateCA:input_type -> vault.v1.GenerateSSHCARequest
+	142, // 103: vault.v1.SSHService.GetCAPublicKey:input_type -> vault.v1.GetSSHCAPublicKeyRequest
+	144, // 104: vault.v1.SSHService.PutRole:input_type -> vault.v1.SSHRole
+	146, // 105: vault.v1.SSHService.GetRole:input_type -> vault.v1.GetSSHRoleRequest
+	147, // 106: vault.v1.SSHService.SignSSHKey:input_type -> vault.v1.SignSSHKeyRequest
+	149, // 107: vault.v1.OIDCService.PutConfig:input_type -> vault.v1.OIDCConfig
+	151, // 108: vault.v1.OIDCService.PutRole:input_type -> vault.v1.OIDCRole
+	153, // 109: vault.v1.OIDCService.GetRole:input_type -> vault.v1.GetOIDCRoleRequest
+	154, // 110: vault.v1.OIDCService.GenerateToken:input_type -> vault.v1.GenerateIdentityTokenRequest
+	156, // 111: vault.v1.OIDCService.RotateKey:input_type -> vault.v1.RotateOIDCKeyRequest
+	1,   // 112: vault.v1.VaultService.VaultWrite:output_type -> vault.v1.VaultWriteResponse
+	3,   // 113: vault.v1.VaultService.VaultRead:output_type -> vault.v1.VaultReadResponse
+	3,   // 114: vault.v1.VaultService.GetSecretVersion:output_type -> vault.v1.VaultReadResponse
+	6,   // 115: vault.v1.VaultService.ListSecretVersions:output_type -> vault.v1.ListSecretVersionsResponse
+	8,   // 116: vault.v1.VaultService.ListSecrets:output_type -> vault.v1.ListSecretsResponse
+	10,  // 117: vault.v1.VaultService.TestIAMPolicy:output_type -> vault.v1.TestIAMPolicyResponse
+	12,  // 118: vault.v1.VaultService.CreateToken:output_type -> vault.v1.CreateTokenResponse
+	13,  // 119: vault.v1.VaultService.LookupToken:output_type -> vault.v1.TokenInfo
+	13,  // 120: vault.v1.VaultService.RenewToken:output_type -> vault.v1.TokenInfo
+	17,  // 121: vault.v1.VaultService.RevokeToken:output_type -> vault.v1.RevokeTokenResponse
+	21,  // 122: vault.v1.VaultService.PutPolicy:output_type -> vault.v1.PutPolicyResponse
+	19,  // 123: vault.v1.VaultService.GetPolicy:output_type -> vault.v1.Policy
+	25,  // 124: vault.v1.VaultService.PutAppRole:output_type -> vault.v1.PutAppRoleResponse
+	27,  // 125: vault.v1.VaultService.GetAppRoleID:output_type -> vault.v1.GetAppRoleIDResponse
+	29,  // 126: vault.v1.VaultService.GenerateSecretID:output_type -> vault.v1.GenerateSecretIDResponse
+	31,  // 127: vault.v1.VaultService.DestroySecretID:output_type -> vault.v1.DestroySecretIDResponse
+	12,  // 128: vault.v1.VaultService.Login:output_type -> vault.v1.CreateTokenResponse
+	35,  // 129: vault.v1.VaultService.PutJWTConfig:output_type -> vault.v1.PutJWTConfigResponse
+	38,  // 130: vault.v1.VaultService.PutJWTRole:output_type -> vault.v1.PutJWTRoleResponse
+	12,  // 131: vault.v1.VaultService.JWTLogin:output_type -> vault.v1.CreateTokenResponse
+	42,  // 132: vault.v1.VaultService.PutCPIConfig:output_type -> vault.v1.PutCPIConfigResponse
+	45,  // 133: vault.v1.VaultService.PutAccessWindow:output_type -> vault.v1.PutAccessWindowResponse
+	48,  // 134: vault.v1.VaultService.PutControlGroup:output_type -> vault.v1.PutControlGroupResponse
+	49,  // 135: vault.v1.VaultService.ApproveAccess:output_type -> vault.v1.AccessRequest
+	49,  // 136: vault.v1.VaultService.GetAccessRequest:output_type -> vault.v1.AccessRequest
+	54,  // 137: vault.v1.VaultService.BreakGlass:output_type -> vault.v1.BreakGlassResponse
+	53,  // 138: vault.v1.VaultService.AcknowledgeBreakGlass:output_type -> vault.v1.BreakGlassSession
+	57,  // 139: vault.v1.VaultService.ListBreakGlass:output_type -> vault.v1.ListBreakGlassResponse
+	60,  // 140: vault.v1.VaultService.GetAccessHistory:output_type -> vault.v1.GetAccessHistoryResponse
+	63,  // 141: vault.v1.VaultService.GetSecretMetadata:output_type -> vault.v1.SecretMetadata
+	63,  // 142: vault.v1.VaultService.UpdateSecretMetadata:output_type -> vault.v1.SecretMetadata
+	67,  // 143: vault.v1.VaultService.PutRetentionPolicy:output_type -> vault.v1.PutRetentionPolicyResponse
+	71,  // 144: vault.v1.VaultService.PutRotation:output_type -> vault.v1.Rotation
+	71,  // 145: vault.v1.VaultService.GetRotation:output_type -> vault.v1.Rotation
+	78,  // 146: vault.v1.VaultService.PutSecretTopics:output_type -> vault.v1.PutSecretTopicsResponse
+	81,  // 147: vault.v1.VaultService.PutWebhook:output_type -> vault.v1.PutWebhookResponse
+	83,  // 148: vault.v1.VaultService.DeleteWebhook:output_type -> vault.v1.DeleteWebhookResponse
+	86,  // 149: vault.v1.VaultService.ListDeliveries:output_type -> vault.v1.ListDeliveriesResponse
+	89,  // 150: vault.v1.VaultService.PutDatabaseConfig:output_type -> vault.v1.PutDatabaseConfigResponse
+	92,  // 151: vault.v1.VaultService.PutDatabaseRole:output_type -> vault.v1.PutDatabaseRoleResponse
+	94,  // 152: vault.v1.VaultService.GetDatabaseCredentials:output_type -> vault.v1.DatabaseCredentials
+	95,  // 153: vault.v1.VaultService.RenewLease:output_type -> vault.v1.Lease
+	98,  // 154: vault.v1.VaultService.RevokeLease:output_type -> vault.v1.RevokeLeaseResponse
+	100, // 155: vault.v1.VaultService.RevokePrefix:output_type -> vault.v1.RevokePrefixResponse
+	69,  // 156: vault.v1.VaultService.ReencryptSecrets:output_type -> vault.v1.ReencryptSecretsResponse
+	104, // 157: vault.v1.VaultService.PutPasswordPolicy:output_type -> vault.v1.PutPasswordPolicyResponse
+	101, // 158: vault.v1.VaultService.GetPasswordPolicy:output_type -> vault.v1.PasswordPolicy
+	107, // 159: vault.v1.VaultService.GenerateSecret:output_type -> vault.v1.GenerateSecretResponse
+	75,  // 160: vault.v1.RotatorService.Rotate:output_type -> vault.v1.RotateResponse
+	109, // 161: vault.v1.TransitService.CreateKey:output_type -> vault.v1.TransitKey
+	109, // 162: vault.v1.TransitService.GetKey:output_type -> vault.v1.TransitKey
+	109, // 163: vault.v1.TransitService.RotateKey:output_type -> vault.v1.TransitKey
+	109, // 164: vault.v1.TransitService.UpdateKeyConfig:output_type -> vault.v1.TransitKey
+	115, // 165: vault.v1.TransitService.Encrypt:output_type -> vault.v1.TransitEncryptResponse
+	117, // 166: vault.v1.TransitService.Decrypt:output_type -> vault.v1.TransitDecryptResponse
+	115, // 167: vault.v1.TransitService.Rewrap:output_type -> vault.v1.TransitEncryptResponse
+	120, // 168: vault.v1.TransitService.Sign:output_type -> vault.v1.TransitSignResponse
+	122, // 169: vault.v1.TransitService.Verify:output_type -> vault.v1.TransitVerifyResponse
+	124, // 170: vault.v1.TransitService.HMAC:output_type -> vault.v1.TransitHMACResponse
+	126, // 171: vault.v1.TransitService.GetPublicKey:output_type -> vault.v1.TransitPublicKey
+	130, // 172: vault.v1.PKIService.GenerateRoot:output_type -> vault.v1.CACertificate
+	130, // 173: vault.v1.PKIService.GenerateIntermediate:output_type -> vault.v1.CACertificate
+	130, // 174: vault.v1.PKIService.GetCA:output_type -> vault.v1.CACertificate
+	132, // 175: vault.v1.PKIService.PutRole:output_type -> vault.v1.PutPKIRoleResponse
+	131, // 176: vault.v1.PKIService.GetRole:output_type -> vault.v1.PKIRole
+	136, // 177: vault.v1.PKIService.IssueCertificate:output_type -> vault.v1.IssuedCertificate
+	136, // 178: vault.v1.PKIService.SignCertificate:output_type -> vault.v1.IssuedCertificate
+	138, // 179: vault.v1.PKIService.RevokeCertificate:output_type -> vault.v1.RevokeCertificateResponse
+	140, // 180: vault.v1.PKIService.GetCRL:output_type -> vault.v1.CRL
+	143, // 181: vault.v1.SSHService.GenerateCA:output_type -> vault.v1.SSHCAPublicKey
+	143, // 182: vault.v1.SSHService.GetCAPublicKey:output_type -> vault.v1.SSHCAPublicKey
+	145, // 183: vault.v1.SSHService.PutRole:output_type -> vault.v1.PutSSHRoleResponse
+	144, // 184: vault.v1.SSHService.GetRole:output_type -> vault.v1.SSHRole
+	148, // 185: vault.v1.SSHService.SignSSHKey:output_type -> vault.v1.SignedSSHKey
+	150, // 186: vault.v1.OIDCService.PutConfig:output_type -> vault.v1.PutOIDCConfigResponse
+	152, // 187: vault.v1.OIDCService.PutRole:output_type -> vault.v1.PutOIDCRoleResponse
+	151, // 188: vault.v1.OIDCService.GetRole:output_type -> vault.v1.OIDCRole
+	155, // 189: vault.v1.OIDCService.GenerateToken:output_type -> vault.v1.IdentityToken
+	157, // 190: vault.v1.OIDCService.RotateKey:output_type -> vault.v1.RotateOIDCKeyResponse
+	112, // [112:191] is the sub-list for method output_type
+	33,  // [33:112] is the sub-list for method input_type
+	33,  // [33:33] is the sub-list for extension type_name
+	33,  // [33:33] is the sub-list for extension extendee
+	0,   // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_v1_vault_vault_proto_init() }
+func file_v1_vault_vault_proto_init() {
+	if File_v1_vault_vault_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_vault_vault_proto_rawDesc), len(file_v1_vault_vault_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   164,
+			NumExtensions: 0,
+			NumServices:   6,
+		},
+		GoTypes:           file_v1_vault_vault_proto_goTypes,
+		DependencyIndexes: file_v1_vault_vault_proto_depIdxs,
+		MessageInfos:      file_v1_vault_vault_proto_msgTypes,
+	}.Build()
+	File_v1_vault_vault_proto = out.File
+	file_v1_vault_vault_proto_goTypes = nil
+	file_v1_vault_vault_proto_depIdxs = nil
+}
@@ -1,252 +1,2602 @@
-// Code generated by protoc-gen-connect-go. DO NOT EDIT.
-//
-// Source: v1/vault/vault.proto
-
-package vaultv1connect
-
-import (
-	vault "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
-	connect "connectrpc.com/connect"
-	context "context"
-	errors "errors"
-	http "net/http"
-	strings "strings"
-)
-
-// This is a compile-time assertion to ensure that this generated file and the connect package are
-// compatible. If you get a compiler error that this constant is not defined, this code was
-// generated with a version of connect newer than the one compiled into your binary. You can fix the
-// problem by either regenerating this code with an older version of connect or updating the connect
-// version compiled into your binary.
-const _ = connect.IsAtLeastVersion1_13_0
-
-const (
-	// VaultServiceName is the fully-qualified name of the VaultService service.
-	VaultServiceName = "vault.v1.VaultService"
-)
-
-// These constants are the fully-qualified names of the RPCs defined in this package. They're
-// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
-//
-// Note that these are different from the fully-qualified method names used by
-// google.protobuf/reflect/protoreflect. To convert from these constants to
-// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
-// period.
-const (
-	// VaultServiceVaultWriteProcedure is the fully-qualified name of the VaultService's VaultWrite RPC.
-	VaultServiceVaultWriteProcedure = "/vault.v1.VaultService/VaultWrite"
-	// VaultServiceVaultReadProcedure is the fully-qualified name of the VaultService's VaultRead RPC.
-	VaultServiceVaultReadProcedure = "/vault.v1.VaultService/VaultRead"
-	// VaultServiceGetSecretVersionProcedure is the fully-qualified name of the VaultService's
-	// GetSecretVersion RPC.
-	VaultServiceGetSecretVersionProcedure = "/vault.v1.VaultService/GetSecretVersion"
-	// VaultServiceListSecretVersionsProcedure is the fully-qualified name of the VaultService's
-	// ListSecretVersions RPC.
-	VaultServiceListSecretVersionsProcedure = "/vault.v1.VaultService/ListSecretVersions"
-	// VaultServiceListSecretsProcedure is the fully-qualified name of the VaultService's ListSecrets
-	// RPC.
-	VaultServiceListSecretsProcedure = "/vault.v1.VaultService/ListSecrets"
-	// VaultServiceTestIAMPolicyProcedure is the fully-qualified name of the VaultService's
-	// TestIAMPolicy RPC.
-	VaultServiceTestIAMPolicyProcedure = "/vault.v1.VaultService/TestIAMPolicy"
-)
-
-// VaultServiceClient is a client for the vault.v1.VaultService service.
-type VaultServiceClient interface {
-	VaultWrite(context.Context, *connect.Request[vault.VaultWriteRequest]) (*connect.Response[vault.VaultWriteResponse], error)
-	VaultRead(context.Context, *connect.Request[vault.VaultReadRequest]) (*connect.Response[vault.VaultReadResponse], error)
-	GetSecretVersion(context.Context, *connect.Request[vault.GetSecretVersionRequest]) (*connect.Response[vault.VaultReadResponse], error)
-	ListSecretVersions(context.Context, *connect.Request[vault.ListSecretVersionsRequest]) (*connect.Response[vault.ListSecretVersionsResponse], error)
-	ListSecrets(context.Context, *connect.Request[vault.ListSecretsRequest]) (*connect.Response[vault.ListSecretsResponse], error)
-	TestIAMPolicy(context.Context, *connect.Request[vault.TestIAMPolicyRequest]) (*connect.Response[vault.TestIAMPolicyResponse], error)
-}
-
-// NewVaultServiceClient constructs a client for the vault.v1.VaultService service. By default, it
-// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
-// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
-// connect.WithGRPCWeb() options.
-//
-// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
-// http://api.acme.com or https://acme.com/grpc).
-func NewVaultServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) VaultServiceClient {
-	baseURL = strings.TrimRight(baseURL, "/")
-	vaultServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("VaultService").Methods()
-	return &vaultServiceClient{
-		vaultWrite: connect.NewClient[vault.VaultWriteRequest, vault.VaultWriteResponse](
-			httpClient,
-			baseURL+VaultServiceVaultWriteProcedure,
-			connect.WithSchema(vaultServiceMethods.ByName("VaultWrite")),
-			connect.WithClientOptions(opts...),
-		),
-		vaultRead: connect.NewClient[vault.VaultReadRequest, vault.VaultReadResponse](
-			httpClient,
-			baseURL+VaultServiceVaultReadProcedure,
-			connect.WithSchema(vaultServiceMethods.ByName("VaultRead")),
-			connect.WithClientOptions(opts...),
-		),
-		getSecretVersion: connect.NewClient[vault.GetSecretVersionRequest, vault.VaultReadResponse](
-			httpClient,
-			baseURL+VaultServiceGetSecretVersionProcedure,
-			connect.WithSchema(vaultServiceMethods.ByName("GetSecretVersion")),
-			connect.WithClientOptions(opts...),
-		),
-		listSecretVersions: connect.NewClient[vault.ListSecretVersionsRequest, vault.ListSecretVersionsResponse](
-			httpClient,
-			baseURL+VaultServiceListSecretVersionsProcedure,
-			connect.WithSchema(vaultServiceMethods.ByName("ListSecretVersions")),
-			connect.WithClientOptions(opts...),
-		),
-		listSecrets: connect.NewClient[vault.ListSecretsRequest, vault.ListSecretsResponse](
-			httpClient,
-			baseURL+VaultServiceListSecretsProcedure,
-			connect.WithSchema(vaultServiceMethods.ByName("ListSecrets")),
-			connect.WithClientOptions(opts...),
-		),
-		testIAMPolicy: connect.NewClient[vault.TestIAMPolicyRequest, vault.TestIAMPolicyResponse](
-			httpClient,
-			baseURL+VaultServiceTestIAMPolicyProcedure,
-			connect.WithSchema(vaultServiceMethods.ByName("TestIAMPolicy")),
-			connect.WithClientOptions(opts...),
-		),
-	}
-}
-
-// vaultServiceClient implements VaultServiceClient.
-type vaultServiceClient struct {
-	vaultWrite         *connect.Client[vault.VaultWriteRequest, vault.VaultWriteResponse]
-	vaultRead          *connect.Client[vault.VaultReadRequest, vault.VaultReadResponse]
-	getSecretVersion   *connect.Client[vault.GetSecretVersionRequest, vault.VaultReadResponse]
-	listSecretVersions *connect.Client[vault.ListSecretVersionsRequest, vault.ListSecretVersionsResponse]
-	listSecrets        *connect.Client[vault.ListSecretsRequest, vault.ListSecretsResponse]
-	testIAMPolicy      *connect.Client[vault.TestIAMPolicyRequest, vault.TestIAMPolicyResponse]
-}
-
-// VaultWrite calls vault.v1.VaultService.VaultWrite.
-func (c *vaultServiceClient) VaultWrite(ctx context.Context, req *connect.Request[vault.VaultWriteRequest]) (*connect.Response[vault.VaultWriteResponse], error) {
-	return c.vaultWrite.CallUnary(ctx, req)
-}
-
-// VaultRead calls vault.v1.VaultService.VaultRead.
-func (c *vaultServiceClient) VaultRead(ctx context.Context, req *connect.Request[vault.VaultReadRequest]) (*connect.Response[vault.VaultReadResponse], error) {
-	return c.vaultRead.CallUnary(ctx, req)
-}
-
-// GetSecretVersion calls vault.v1.VaultService.GetSecretVersion.
-func (c *vaultServiceClient) GetSecretVersion(ctx context.Context, req *connect.Request[vault.GetSecretVersionRequest]) (*connect.Response[vault.VaultReadResponse], error) {
-	return c.getSecretVersion.CallUnary(ctx, req)
-}
-
-// ListSecretVersions calls vault.v1.VaultService.ListSecretVersions.
-func (c *vaultServiceClient) ListSecretVersions(ctx context.Context, req *connect.Request[vault.ListSecretVersionsRequest]) (*connect.Response[vault.ListSecretVersionsResponse], error) {
-	return c.listSecretVersions.CallUnary(ctx, req)
-}
-
-// ListSecrets calls vault.v1.VaultService.ListSecrets.
-func (c *vaultServiceClient) ListSecrets(ctx context.Context, req *connect.Request[vault.ListSecretsRequest]) (*connect.Response[vault.ListSecretsResponse], error) {
-	return c.listSecrets.CallUnary(ctx, req)
-}
-
-// TestIAMPolicy calls vault.v1.VaultService.TestIAMPolicy.
-func (c *vaultServiceClient) TestIAMPolicy(ctx context.Context, req *connect.Request[vault.TestIAMPolicyRequest]) (*connect.Response[vault.TestIAMPolicyResponse], error) {
-	return c.testIAMPolicy.CallUnary(ctx, req)
-}
-
-// VaultServiceHandler is an implementation of the vault.v1.VaultService service.
-type VaultServiceHandler interface {
-	VaultWrite(context.Context, *connect.Request[vault.VaultWriteRequest]) (*connect.Response[vault.VaultWriteResponse], error)
-	VaultRead(context.Context, *connect.Request[vault.VaultReadRequest]) (*connect.Response[vault.VaultReadResponse], error)
-	GetSecretVersion(context.Context, *connect.Request[vault.GetSecretVersionRequest]) (*connect.Response[vault.VaultReadResponse], error)
-	ListSecretVersions(context.Context, *connect.Request[vault.ListSecretVersionsRequest]) (*connect.Response[vault.ListSecretVersionsResponse], error)
-	ListSecrets(context.Context, *connect.Request[vault.ListSecretsRequest]) (*connect.Response[vault.ListSecretsResponse], error)
-	TestIAMPolicy(context.Context, *connect.Request[vault.TestIAMPolicyRequest]) (*connect.Response[vault.TestIAMPolicyResponse], error)
-}
-
-// NewVaultServiceHandler builds an HTTP handler from the service implementation. It returns the
-// path on which to mount the handler and the handler itself.
-//
-// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
-// and JSON codecs. They also support gzip compression.
-func NewVaultServiceHandler(svc VaultServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
-	vaultServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("VaultService").Methods()
-	vaultServiceVaultWriteHandler := connect.NewUnaryHandler(
-		VaultServiceVaultWriteProcedure,
-		svc.VaultWrite,
-		connect.WithSchema(vaultServiceMethods.ByName("VaultWrite")),
-		connect.WithHandlerOptions(opts...),
-	)
-	vaultServiceVaultReadHandler := connect.NewUnaryHandler(
-		VaultServiceVaultReadProcedure,
-		svc.VaultRead,
-		connect.WithSchema(vaultServiceMethods.ByName("VaultRead")),
-		connect.WithHandlerOptions(opts...),
-	)
-	vaultServiceGetSecretVersionHandler := connect.NewUnaryHandler(
-		VaultServiceGetSecretVersionProcedure,
-		svc.GetSecretVersion,
-		connect.WithSchema(vaultServiceMethods.ByName("GetSecretVersion")),
-		connect.WithHandlerOptions(opts...),
-	)
-	vaultServiceListSecretVersionsHandler := connect.NewUnaryHandler(
-		VaultServiceListSecretVersionsProcedure,
-		svc.ListSecretVersions,
-		connect.WithSchema(vaultServiceMethods.ByName("ListSecretVersions")),
-		connect.WithHandlerOptions(opts...),
-	)
-	vaultServiceListSecretsHandler := connect.NewUnaryHandler(
-		VaultServiceListSecretsProcedure,
-		svc.ListSecrets,
-		connect.WithSchema(vaultServiceMethods.ByName("ListSecrets")),
-		connect.WithHandlerOptions(opts...),
-	)
-	vaultServiceTestIAMPolicyHandler := connect.NewUnaryHandler(
-		VaultServiceTestIAMPolicyProcedure,
-		svc.TestIAMPolicy,
-		connect.WithSchema(vaultServiceMethods.ByName("TestIAMPolicy")),
-		connect.WithHandlerOptions(opts...),
-	)
-	return "/vault.v1.VaultService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		switch r.URL.Path {
-		case VaultServiceVaultWriteProcedure:
-			vaultServiceVaultWriteHandler.ServeHTTP(w, r)
-		case VaultServiceVaultReadProcedure:
-			vaultServiceVaultReadHandler.ServeHTTP(w, r)
-		case VaultServiceGetSecretVersionProcedure:
-			vaultServiceGetSecretVersionHandler.ServeHTTP(w, r)
-		case VaultServiceListSecretVersionsProcedure:
-			vaultServiceListSecretVersionsHandler.ServeHTTP(w, r)
-		case VaultServiceListSecretsProcedure:
-			vaultServiceListSecretsHandler.ServeHTTP(w, r)
-		case VaultServiceTestIAMPolicyProcedure:
-			vaultServiceTestIAMPolicyHandler.ServeHTTP(w, r)
-		default:
-			http.NotFound(w, r)
-		}
-	})
-}
-
-// UnimplementedVaultServiceHandler returns CodeUnimplemented from all methods.
-type UnimplementedVaultServiceHandler struct{}
-
-func (UnimplementedVaultServiceHandler) VaultWrite(context.Context, *connect.Request[vault.VaultWriteRequest]) (*connect.Response[vault.VaultWriteResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.VaultWrite is not implemented"))
-}
-
-func (UnimplementedVaultServiceHandler) VaultRead(context.Context, *connect.Request[vault.VaultReadRequest]) (*connect.Response[vault.VaultReadResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.VaultRead is not implemented"))
-}
-
-func (UnimplementedVaultServiceHandler) GetSecretVersion(context.Context, *connect.Request[vault.GetSecretVersionRequest]) (*connect.Response[vault.VaultReadResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.GetSecretVersion is not implemented"))
-}
-
-func (UnimplementedVaultServiceHandler) ListSecretVersions(context.Context, *connect.Request[vault.ListSecretVersionsRequest]) (*connect.Response[vault.ListSecretVersionsResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.ListSecretVersions is not implemented"))
-}
-
-func (UnimplementedVaultServiceHandler) ListSecrets(context.Context, *connect.Request[vault.ListSecretsRequest]) (*connect.Response[vault.ListSecretsResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.ListSecrets is not implemented"))
-}
-
-func (UnimplementedVaultServiceHandler) TestIAMPolicy(context.Context, *connect.Request[vault.TestIAMPolicyRequest]) (*connect.Response[vault.TestIAMPolicyResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.TestIAMPolicy is not implemented"))
-}
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: v1/vault/vault.proto
+
+package vaultv1connect
+
+import (
+	vault "olympus.fleet/00SDLC/OlympusGCP-Vault/40000-Communication-Contracts/40400-Protocol-Synthetics/connect-rpc/gen/v1/vault"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// VaultServiceName is the fully-qualified name of the VaultService service.
+	VaultServiceName = "vault.v1.VaultService"
+	// RotatorServiceName is the fully-qualified name of the RotatorService service.
+	RotatorServiceName = "vault.v1.RotatorService"
+	// TransitServiceName is the fully-qualified name of the TransitService service.
+	TransitServiceName = "vault.v1.TransitService"
+	// PKIServiceName is the fully-qualified name of the PKIService service.
+	PKIServiceName = "vault.v1.PKIService"
+	// SSHServiceName is the fully-qualified name of the SSHService service.
+	SSHServiceName = "vault.v1.SSHService"
+	// OIDCServiceName is the fully-qualified name of the OIDCService service.
+	OIDCServiceName = "vault.v1.OIDCService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// VaultServiceVaultWriteProcedure is the fully-qualified name of the VaultService's VaultWrite RPC.
+	VaultServiceVaultWriteProcedure = "/vault.v1.VaultService/VaultWrite"
+	// VaultServiceVaultReadProcedure is the fully-qualified name of the VaultService's VaultRead RPC.
+	VaultServiceVaultReadProcedure = "/vault.v1.VaultService/VaultRead"
+	// VaultServiceGetSecretVersionProcedure is the fully-qualified name of the VaultService's
+	// GetSecretVersion RPC.
+	VaultServiceGetSecretVersionProcedure = "/vault.v1.VaultService/GetSecretVersion"
+	// VaultServiceListSecretVersionsProcedure is the fully-qualified name of the VaultService's
+	// ListSecretVersions RPC.
+	VaultServiceListSecretVersionsProcedure = "/vault.v1.VaultService/ListSecretVersions"
+	// VaultServiceListSecretsProcedure is the fully-qualified name of the VaultService's ListSecrets
+	// RPC.
+	VaultServiceListSecretsProcedure = "/vault.v1.VaultService/ListSecrets"
+	// VaultServiceTestIAMPolicyProcedure is the fully-qualified name of the VaultService's
+	// TestIAMPolicy RPC.
+	VaultServiceTestIAMPolicyProcedure = "/vault.v1.VaultService/TestIAMPolicy"
+	// VaultServiceCreateTokenProcedure is the fully-qualified name of the VaultService's CreateToken
+	// RPC.
+	VaultServiceCreateTokenProcedure = "/vault.v1.VaultService/CreateToken"
+	// VaultServiceLookupTokenProcedure is the fully-qualified name of the VaultService's LookupToken
+	// RPC.
+	VaultServiceLookupTokenProcedure = "/vault.v1.VaultService/LookupToken"
+	// VaultServiceRenewTokenProcedure is the fully-qualified name of the VaultService's RenewToken RPC.
+	VaultServiceRenewTokenProcedure = "/vault.v1.VaultService/RenewToken"
+	// VaultServiceRevokeTokenProcedure is the fully-qualified name of the VaultService's RevokeToken
+	// RPC.
+	VaultServiceRevokeTokenProcedure = "/vault.v1.VaultService/RevokeToken"
+	// VaultServicePutPolicyProcedure is the fully-qualified name of the VaultService's PutPolicy RPC.
+	VaultServicePutPolicyProcedure = "/vault.v1.VaultService/PutPolicy"
+	// VaultServiceGetPolicyProcedure is the fully-qualified name of the VaultService's GetPolicy RPC.
+	VaultServiceGetPolicyProcedure = "/vault.v1.VaultService/GetPolicy"
+	// VaultServicePutAppRoleProcedure is the fully-qualified name of the VaultService's PutAppRole RPC.
+	VaultServicePutAppRoleProcedure = "/vault.v1.VaultService/PutAppRole"
+	// VaultServiceGetAppRoleIDProcedure is the fully-qualified name of the VaultService's GetAppRoleID
+	// RPC.
+	VaultServiceGetAppRoleIDProcedure = "/vault.v1.VaultService/GetAppRoleID"
+	// VaultServiceGenerateSecretIDProcedure is the fully-qualified name of the VaultService's
+	// GenerateSecretID RPC.
+	VaultServiceGenerateSecretIDProcedure = "/vault.v1.VaultService/GenerateSecretID"
+	// VaultServiceDestroySecretIDProcedure is the fully-qualified name of the VaultService's
+	// DestroySecretID RPC.
+	VaultServiceDestroySecretIDProcedure = "/vault.v1.VaultService/DestroySecretID"
+	// VaultServiceLoginProcedure is the fully-qualified name of the VaultService's Login RPC.
+	VaultServiceLoginProcedure = "/vault.v1.VaultService/Login"
+	// VaultServicePutJWTConfigProcedure is the fully-qualified name of the VaultService's PutJWTConfig
+	// RPC.
+	VaultServicePutJWTConfigProcedure = "/vault.v1.VaultService/PutJWTConfig"
+	// VaultServicePutJWTRoleProcedure is the fully-qualified name of the VaultService's PutJWTRole RPC.
+	VaultServicePutJWTRoleProcedure = "/vault.v1.VaultService/PutJWTRole"
+	// VaultServiceJWTLoginProcedure is the fully-qualified name of the VaultService's JWTLogin RPC.
+	VaultServiceJWTLoginProcedure = "/vault.v1.VaultService/JWTLogin"
+	// VaultServicePutCPIConfigProcedure is the fully-qualified name of the VaultService's PutCPIConfig
+	// RPC.
+	VaultServicePutCPIConfigProcedure = "/vault.v1.VaultService/PutCPIConfig"
+	// VaultServicePutAccessWindowProcedure is the fully-qualified name of the VaultService's
+	// PutAccessWindow RPC.
+	VaultServicePutAccessWindowProcedure = "/vault.v1.VaultService/PutAccessWindow"
+	// VaultServicePutControlGroupProcedure is the fully-qualified name of the VaultService's
+	// PutControlGroup RPC.
+	VaultServicePutControlGroupProcedure = "/vault.v1.VaultService/PutControlGroup"
+	// VaultServiceApproveAccessProcedure is the fully-qualified name of the VaultService's
+	// ApproveAccess RPC.
+	VaultServiceApproveAccessProcedure = "/vault.v1.VaultService/ApproveAccess"
+	// VaultServiceGetAccessRequestProcedure is the fully-qualified name of the VaultService's
+	// GetAccessRequest RPC.
+	VaultServiceGetAccessRequestProcedure = "/vault.v1.VaultService/GetAccessRequest"
+	// VaultServiceBreakGlassProcedure is the fully-qualified name of the VaultService's BreakGlass RPC.
+	VaultServiceBreakGlassProcedure = "/vault.v1.VaultService/BreakGlass"
+	// VaultServiceAcknowledgeBreakGlassProcedure is the fully-qualified name of the VaultService's
+	// AcknowledgeBreakGlass RPC.
+	VaultServiceAcknowledgeBreakGlassProcedure = "/vault.v1.VaultService/AcknowledgeBreakGlass"
+	// VaultServiceListBreakGlassProcedure is the fully-qualified name of the VaultService's
+	// ListBreakGlass RPC.
+	VaultServiceListBreakGlassProcedure = "/vault.v1.VaultService/ListBreakGlass"
+	// VaultServiceGetAccessHistoryProcedure is the fully-qualified name of the VaultService's
+	// GetAccessHistory RPC.
+	VaultServiceGetAccessHistoryProcedure = "/vault.v1.VaultService/GetAccessHistory"
+	// VaultServiceGetSecretMetadataProcedure is the fully-qualified name of the VaultService's
+	// GetSecretMetadata RPC.
+	VaultServiceGetSecretMetadataProcedure = "/vault.v1.VaultService/GetSecretMetadata"
+	// VaultServiceUpdateSecretMetadataProcedure is the fully-qualified name of the VaultService's
+	// UpdateSecretMetadata RPC.
+	VaultServiceUpdateSecretMetadataProcedure = "/vault.v1.VaultService/UpdateSecretMetadata"
+	// VaultServicePutRetentionPolicyProcedure is the fully-qualified name of the VaultService's
+	// PutRetentionPolicy RPC.
+	VaultServicePutRetentionPolicyProcedure = "/vault.v1.VaultService/PutRetentionPolicy"
+	// VaultServicePutRotationProcedure is the fully-qualified name of the VaultService's PutRotation
+	// RPC.
+	VaultServicePutRotationProcedure = "/vault.v1.VaultService/PutRotation"
+	// VaultServiceGetRotationProcedure is the fully-qualified name of the VaultService's GetRotation
+	// RPC.
+	VaultServiceGetRotationProcedure = "/vault.v1.VaultService/GetRotation"
+	// VaultServicePutSecretTopicsProcedure is the fully-qualified name of the VaultService's
+	// PutSecretTopics RPC.
+	VaultServicePutSecretTopicsProcedure = "/vault.v1.VaultService/PutSecretTopics"
+	// VaultServicePutWebhookProcedure is the fully-qualified name of the VaultService's PutWebhook RPC.
+	VaultServicePutWebhookProcedure = "/vault.v1.VaultService/PutWebhook"
+	// VaultServiceDeleteWebhookProcedure is the fully-qualified name of the VaultService's
+	// DeleteWebhook RPC.
+	VaultServiceDeleteWebhookProcedure = "/vault.v1.VaultService/DeleteWebhook"
+	// VaultServiceListDeliveriesProcedure is the fully-qualified name of the VaultService's
+	// ListDeliveries RPC.
+	VaultServiceListDeliveriesProcedure = "/vault.v1.VaultService/ListDeliveries"
+	// VaultServicePutDatabaseConfigProcedure is the fully-qualified name of the VaultService's
+	// PutDatabaseConfig RPC.
+	VaultServicePutDatabaseConfigProcedure = "/vault.v1.VaultService/PutDatabaseConfig"
+	// VaultServicePutDatabaseRoleProcedure is the fully-qualified name of the VaultService's
+	// PutDatabaseRole RPC.
+	VaultServicePutDatabaseRoleProcedure = "/vault.v1.VaultService/PutDatabaseRole"
+	// VaultServiceGetDatabaseCredentialsProcedure is the fully-qualified name of the VaultService's
+	// GetDatabaseCredentials RPC.
+	VaultServiceGetDatabaseCredentialsProcedure = "/vault.v1.VaultService/GetDatabaseCredentials"
+	// VaultServiceRenewLeaseProcedure is the fully-qualified name of the VaultService's RenewLease RPC.
+	VaultServiceRenewLeaseProcedure = "/vault.v1.VaultService/RenewLease"
+	// VaultServiceRevokeLeaseProcedure is the fully-qualified name of the VaultService's RevokeLease
+	// RPC.
+	VaultServiceRevokeLeaseProcedure = "/vault.v1.VaultService/RevokeLease"
+	// VaultServiceRevokePrefixProcedure is the fully-qualified name of the VaultService's RevokePrefix
+	// RPC.
+	VaultServiceRevokePrefixProcedure = "/vault.v1.VaultService/RevokePrefix"
+	// VaultServiceReencryptSecretsProcedure is the fully-qualified name of the VaultService's
+	// ReencryptSecrets RPC.
+	VaultServiceReencryptSecretsProcedure = "/vault.v1.VaultService/ReencryptSecrets"
+	// VaultServicePutPasswordPolicyProcedure is the fully-qualified name of the VaultService's
+	// PutPasswordPolicy RPC.
+	VaultServicePutPasswordPolicyProcedure = "/vault.v1.VaultService/PutPasswordPolicy"
+	// VaultServiceGetPasswordPolicyProcedure is the fully-qualified name of the VaultService's
+	// GetPasswordPolicy RPC.
+	VaultServiceGetPasswordPolicyProcedure = "/vault.v1.VaultService/GetPasswordPolicy"
+	// VaultServiceGenerateSecretProcedure is the fully-qualified name of the VaultService's
+	// GenerateSecret RPC.
+	VaultServiceGenerateSecretProcedure = "/vault.v1.VaultService/GenerateSecret"
+	// RotatorServiceRotateProcedure is the fully-qualified name of the RotatorService's Rotate RPC.
+	RotatorServiceRotateProcedure = "/vault.v1.RotatorService/Rotate"
+	// TransitServiceCreateKeyProcedure is the fully-qualified name of the TransitService's CreateKey
+	// RPC.
+	TransitServiceCreateKeyProcedure = "/vault.v1.TransitService/CreateKey"
+	// TransitServiceGetKeyProcedure is the fully-qualified name of the TransitService's GetKey RPC.
+	TransitServiceGetKeyProcedure = "/vault.v1.TransitService/GetKey"
+	// TransitServiceRotateKeyProcedure is the fully-qualified name of the TransitService's RotateKey
+	// RPC.
+	TransitServiceRotateKeyProcedure = "/vault.v1.TransitService/RotateKey"
+	// TransitServiceUpdateKeyConfigProcedure is the fully-qualified name of the TransitService's
+	// UpdateKeyConfig RPC.
+	TransitServiceUpdateKeyConfigProcedure = "/vault.v1.TransitService/UpdateKeyConfig"
+	// TransitServiceEncryptProcedure is the fully-qualified name of the TransitService's Encrypt RPC.
+	TransitServiceEncryptProcedure = "/vault.v1.TransitService/Encrypt"
+	// TransitServiceDecryptProcedure is the fully-qualified name of the TransitService's Decrypt RPC.
+	TransitServiceDecryptProcedure = "/vault.v1.TransitService/Decrypt"
+	// TransitServiceRewrapProcedure is the fully-qualified name of the TransitService's Rewrap RPC.
+	TransitServiceRewrapProcedure = "/vault.v1.TransitService/Rewrap"
+	// TransitServiceSignProcedure is the fully-qualified name of the TransitService's Sign RPC.
+	TransitServiceSignProcedure = "/vault.v1.TransitService/Sign"
+	// TransitServiceVerifyProcedure is the fully-qualified name of the TransitService's Verify RPC.
+	TransitServiceVerifyProcedure = "/vault.v1.TransitService/Verify"
+	// TransitServiceHMACProcedure is the fully-qualified name of the TransitService's HMAC RPC.
+	TransitServiceHMACProcedure = "/vault.v1.TransitService/HMAC"
+	// TransitServiceGetPublicKeyProcedure is the fully-qualified name of the TransitService's
+	// GetPublicKey RPC.
+	TransitServiceGetPublicKeyProcedure = "/vault.v1.TransitService/GetPublicKey"
+	// PKIServiceGenerateRootProcedure is the fully-qualified name of the PKIService's GenerateRoot RPC.
+	PKIServiceGenerateRootProcedure = "/vault.v1.PKIService/GenerateRoot"
+	// PKIServiceGenerateIntermediateProcedure is the fully-qualified name of the PKIService's
+	// GenerateIntermediate RPC.
+	PKIServiceGenerateIntermediateProcedure = "/vault.v1.PKIService/GenerateIntermediate"
+	// PKIServiceGetCAProcedure is the fully-qualified name of the PKIService's GetCA RPC.
+	PKIServiceGetCAProcedure = "/vault.v1.PKIService/GetCA"
+	// PKIServicePutRoleProcedure is the fully-qualified name of the PKIService's PutRole RPC.
+	PKIServicePutRoleProcedure = "/vault.v1.PKIService/PutRole"
+	// PKIServiceGetRoleProcedure is the fully-qualified name of the PKIService's GetRole RPC.
+	PKIServiceGetRoleProcedure = "/vault.v1.PKIService/GetRole"
+	// PKIServiceIssueCertificateProcedure is the fully-qualified name of the PKIService's
+	// IssueCertificate RPC.
+	PKIServiceIssueCertificateProcedure = "/vault.v1.PKIService/IssueCertificate"
+	// PKIServiceSignCertificateProcedure is the fully-qualified name of the PKIService's
+	// SignCertificate RPC.
+	PKIServiceSignCertificateProcedure = "/vault.v1.PKIService/SignCertificate"
+	// PKIServiceRevokeCertificateProcedure is the fully-qualified name of the PKIService's
+	// RevokeCertificate RPC.
+	PKIServiceRevokeCertificateProcedure = "/vault.v1.PKIService/RevokeCertificate"
+	// PKIServiceGetCRLProcedure is the fully-qualified name of the PKIService's GetCRL RPC.
+	PKIServiceGetCRLProcedure = "/vault.v1.PKIService/GetCRL"
+	// SSHServiceGenerateCAProcedure is the fully-qualified name of the SSHService's GenerateCA RPC.
+	SSHServiceGenerateCAProcedure = "/vault.v1.SSHService/GenerateCA"
+	// SSHServiceGetCAPublicKeyProcedure is the fully-qualified name of the SSHService's GetCAPublicKey
+	// RPC.
+	SSHServiceGetCAPublicKeyProcedure = "/vault.v1.SSHService/GetCAPublicKey"
+	// SSHServicePutRoleProcedure is the fully-qualified name of the SSHService's PutRole RPC.
+	SSHServicePutRoleProcedure = "/vault.v1.SSHService/PutRole"
+	// SSHServiceGetRoleProcedure is the fully-qualified name of the SSHService's GetRole RPC.
+	SSHServiceGetRoleProcedure = "/vault.v1.SSHService/GetRole"
+	// SSHServiceSignSSHKeyProcedure is the fully-qualified name of the SSHService's SignSSHKey RPC.
+	SSHServiceSignSSHKeyProcedure = "/vault.v1.SSHService/SignSSHKey"
+	// OIDCServicePutConfigProcedure is the fully-qualified name of the OIDCService's PutConfig RPC.
+	OIDCServicePutConfigProcedure = "/vault.v1.OIDCService/PutConfig"
+	// OIDCServicePutRoleProcedure is the fully-qualified name of the OIDCService's PutRole RPC.
+	OIDCServicePutRoleProcedure = "/vault.v1.OIDCService/PutRole"
+	// OIDCServiceGetRoleProcedure is the fully-qualified name of the OIDCService's GetRole RPC.
+	OIDCServiceGetRoleProcedure = "/vault.v1.OIDCService/GetRole"
+	// OIDCServiceGenerateTokenProcedure is the fully-qualified name of the OIDCService's GenerateToken
+	// RPC.
+	OIDCServiceGenerateTokenProcedure = "/vault.v1.OIDCService/GenerateToken"
+	// OIDCServiceRotateKeyProcedure is the fully-qualified name of the OIDCService's RotateKey RPC.
+	OIDCServiceRotateKeyProcedure = "/vault.v1.OIDCService/RotateKey"
+)
+
+// VaultServiceClient is a client for the vault.v1.VaultService service.
+type VaultServiceClient interface {
+	VaultWrite(context.Context, *connect.Request[vault.VaultWriteRequest]) (*connect.Response[vault.VaultWriteResponse], error)
+	VaultRead(context.Context, *connect.Request[vault.VaultReadRequest]) (*connect.Response[vault.VaultReadResponse], error)
+	GetSecretVersion(context.Context, *connect.Request[vault.GetSecretVersionRequest]) (*connect.Response[vault.VaultReadResponse], error)
+	ListSecretVersions(context.Context, *connect.Request[vault.ListSecretVersionsRequest]) (*connect.Response[vault.ListSecretVersionsResponse], error)
+	ListSecrets(context.Context, *connect.Request[vault.ListSecretsRequest]) (*connect.Response[vault.ListSecretsResponse], error)
+	TestIAMPolicy(context.Context, *connect.Request[vault.TestIAMPolicyRequest]) (*connect.Response[vault.TestIAMPolicyResponse], error)
+	// Token auth method
+	CreateToken(context.Context, *connect.Request[vault.CreateTokenRequest]) (*connect.Response[vault.CreateTokenResponse], error)
+	LookupToken(context.Context, *connect.Request[vault.LookupTokenRequest]) (*connect.Response[vault.TokenInfo], error)
+	RenewToken(context.Context, *connect.Request[vault.RenewTokenRequest]) (*connect.Response[vault.TokenInfo], error)
+	RevokeToken(context.Context, *connect.Request[vault.RevokeTokenRequest]) (*connect.Response[vault.RevokeTokenResponse], error)
+	// ACL policies referenced by tokens
+	PutPolicy(context.Context, *connect.Request[vault.PutPolicyRequest]) (*connect.Response[vault.PutPolicyResponse], error)
+	GetPolicy(context.Context, *connect.Request[vault.GetPolicyRequest]) (*connect.Response[vault.Policy], error)
+	// AppRole machine auth method
+	PutAppRole(context.Context, *connect.Request[vault.PutAppRoleRequest]) (*connect.Response[vault.PutAppRoleResponse], error)
+	GetAppRoleID(context.Context, *connect.Request[vault.GetAppRoleIDRequest]) (*connect.Response[vault.GetAppRoleIDResponse], error)
+	GenerateSecretID(context.Context, *connect.Request[vault.GenerateSecretIDRequest]) (*connect.Response[vault.GenerateSecretIDResponse], error)
+	DestroySecretID(context.Context, *connect.Request[vault.DestroySecretIDRequest]) (*connect.Response[vault.DestroySecretIDResponse], error)
+	Login(context.Context, *connect.Request[vault.LoginRequest]) (*connect.Response[vault.CreateTokenResponse], error)
+	// JWT/OIDC auth method
+	PutJWTConfig(context.Context, *connect.Request[vault.PutJWTConfigRequest]) (*connect.Response[vault.PutJWTConfigResponse], error)
+	PutJWTRole(context.Context, *connect.Request[vault.PutJWTRoleRequest]) (*connect.Response[vault.PutJWTRoleResponse], error)
+	JWTLogin(context.Context, *connect.Request[vault.JWTLoginRequest]) (*connect.Response[vault.CreateTokenResponse], error)
+	// Composite Platform Identity attestation
+	PutCPIConfig(context.Context, *connect.Request[vault.PutCPIConfigRequest]) (*connect.Response[vault.PutCPIConfigResponse], error)
+	// Temporal access windows on secrets
+	PutAccessWindow(context.Context, *connect.Request[vault.PutAccessWindowRequest]) (*connect.Response[vault.PutAccessWindowResponse], error)
+	// Control groups (multi-person approval)
+	PutControlGroup(context.Context, *connect.Request[vault.PutControlGroupRequest]) (*connect.Response[vault.PutControlGroupResponse], error)
+	ApproveAccess(context.Context, *connect.Request[vault.ApproveAccessRequest]) (*connect.Response[vault.AccessRequest], error)
+	GetAccessRequest(context.Context, *connect.Request[vault.GetAccessRequestRequest]) (*connect.Response[vault.AccessRequest], error)
+	// Break-glass emergency access
+	BreakGlass(context.Context, *connect.Request[vault.BreakGlassRequest]) (*connect.Response[vault.BreakGlassResponse], error)
+	AcknowledgeBreakGlass(context.Context, *connect.Request[vault.AcknowledgeBreakGlassRequest]) (*connect.Response[vault.BreakGlassSession], error)
+	ListBreakGlass(context.Context, *connect.Request[vault.ListBreakGlassRequest]) (*connect.Response[vault.ListBreakGlassResponse], error)
+	// Access transparency
+	GetAccessHistory(context.Context, *connect.Request[vault.GetAccessHistoryRequest]) (*connect.Response[vault.GetAccessHistoryResponse], error)
+	// Secret metadata and expiry
+	GetSecretMetadata(context.Context, *connect.Request[vault.GetSecretMetadataRequest]) (*connect.Response[vault.SecretMetadata], error)
+	UpdateSecretMetadata(context.Context, *connect.Request[vault.UpdateSecretMetadataRequest]) (*connect.Response[vault.SecretMetadata], error)
+	// Version retention
+	PutRetentionPolicy(context.Context, *connect.Request[vault.PutRetentionPolicyRequest]) (*connect.Response[vault.PutRetentionPolicyResponse], error)
+	// Scheduled rotation
+	PutRotation(context.Context, *connect.Request[vault.PutRotationRequest]) (*connect.Response[vault.Rotation], error)
+	GetRotation(context.Context, *connect.Request[vault.GetRotationRequest]) (*connect.Response[vault.Rotation], error)
+	// Event notifications
+	PutSecretTopics(context.Context, *connect.Request[vault.PutSecretTopicsRequest]) (*connect.Response[vault.PutSecretTopicsResponse], error)
+	PutWebhook(context.Context, *connect.Request[vault.PutWebhookRequest]) (*connect.Response[vault.PutWebhookResponse], error)
+	DeleteWebhook(context.Context, *connect.Request[vault.DeleteWebhookRequest]) (*connect.Response[vault.DeleteWebhookResponse], error)
+	ListDeliveries(context.Context, *connect.Request[vault.ListDeliveriesRequest]) (*connect.Response[vault.ListDeliveriesResponse], error)
+	// Dynamic database credentials
+	PutDatabaseConfig(context.Context, *connect.Request[vault.PutDatabaseConfigRequest]) (*connect.Response[vault.PutDatabaseConfigResponse], error)
+	PutDatabaseRole(context.Context, *connect.Request[vault.PutDatabaseRoleRequest]) (*connect.Response[vault.PutDatabaseRoleResponse], error)
+	GetDatabaseCredentials(context.Context, *connect.Request[vault.GetDatabaseCredentialsRequest]) (*connect.Response[vault.DatabaseCredentials], error)
+	// Leases on dynamic credentials and tokens
+	RenewLease(context.Context, *connect.Request[vault.RenewLeaseRequest]) (*connect.Response[vault.Lease], error)
+	RevokeLease(context.Context, *connect.Request[vault.RevokeLeaseRequest]) (*connect.Response[vault.RevokeLeaseResponse], error)
+	RevokePrefix(context.Context, *connect.Request[vault.RevokePrefixRequest]) (*connect.Response[vault.RevokePrefixResponse], error)
+	// Customer-managed encryption keys
+	ReencryptSecrets(context.Context, *connect.Request[vault.ReencryptSecretsRequest]) (*connect.Response[vault.ReencryptSecretsResponse], error)
+	// Password policies and secret generation
+	PutPasswordPolicy(context.Context, *connect.Request[vault.PutPasswordPolicyRequest]) (*connect.Response[vault.PutPasswordPolicyResponse], error)
+	GetPasswordPolicy(context.Context, *connect.Request[vault.GetPasswordPolicyRequest]) (*connect.Response[vault.PasswordPolicy], error)
+	GenerateSecret(context.Context, *connect.Request[vault.GenerateSecretRequest]) (*connect.Response[vault.GenerateSecretResponse], error)
+}
+
+// NewVaultServiceClient constructs a client for the vault.v1.VaultService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewVaultServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) VaultServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	vaultServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("VaultService").Methods()
+	return &vaultServiceClient{
+		vaultWrite: connect.NewClient[vault.VaultWriteRequest, vault.VaultWriteResponse](
+			httpClient,
+			baseURL+VaultServiceVaultWriteProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("VaultWrite")),
+			connect.WithClientOptions(opts...),
+		),
+		vaultRead: connect.NewClient[vault.VaultReadRequest, vault.VaultReadResponse](
+			httpClient,
+			baseURL+VaultServiceVaultReadProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("VaultRead")),
+			connect.WithClientOptions(opts...),
+		),
+		getSecretVersion: connect.NewClient[vault.GetSecretVersionRequest, vault.VaultReadResponse](
+			httpClient,
+			baseURL+VaultServiceGetSecretVersionProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("GetSecretVersion")),
+			connect.WithClientOptions(opts...),
+		),
+		listSecretVersions: connect.NewClient[vault.ListSecretVersionsRequest, vault.ListSecretVersionsResponse](
+			httpClient,
+			baseURL+VaultServiceListSecretVersionsProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("ListSecretVersions")),
+			connect.WithClientOptions(opts...),
+		),
+		listSecrets: connect.NewClient[vault.ListSecretsRequest, vault.ListSecretsResponse](
+			httpClient,
+			baseURL+VaultServiceListSecretsProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("ListSecrets")),
+			connect.WithClientOptions(opts...),
+		),
+		testIAMPolicy: connect.NewClient[vault.TestIAMPolicyRequest, vault.TestIAMPolicyResponse](
+			httpClient,
+			baseURL+VaultServiceTestIAMPolicyProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("TestIAMPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		createToken: connect.NewClient[vault.CreateTokenRequest, vault.CreateTokenResponse](
+			httpClient,
+			baseURL+VaultServiceCreateTokenProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("CreateToken")),
+			connect.WithClientOptions(opts...),
+		),
+		lookupToken: connect.NewClient[vault.LookupTokenRequest, vault.TokenInfo](
+			httpClient,
+			baseURL+VaultServiceLookupTokenProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("LookupToken")),
+			connect.WithClientOptions(opts...),
+		),
+		renewToken: connect.NewClient[vault.RenewTokenRequest, vault.TokenInfo](
+			httpClient,
+			baseURL+VaultServiceRenewTokenProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("RenewToken")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeToken: connect.NewClient[vault.RevokeTokenRequest, vault.RevokeTokenResponse](
+			httpClient,
+			baseURL+VaultServiceRevokeTokenProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("RevokeToken")),
+			connect.WithClientOptions(opts...),
+		),
+		putPolicy: connect.NewClient[vault.PutPolicyRequest, vault.PutPolicyResponse](
+			httpClient,
+			baseURL+VaultServicePutPolicyProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		getPolicy: connect.NewClient[vault.GetPolicyRequest, vault.Policy](
+			httpClient,
+			baseURL+VaultServiceGetPolicyProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("GetPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		putAppRole: connect.NewClient[vault.PutAppRoleRequest, vault.PutAppRoleResponse](
+			httpClient,
+			baseURL+VaultServicePutAppRoleProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutAppRole")),
+			connect.WithClientOptions(opts...),
+		),
+		getAppRoleID: connect.NewClient[vault.GetAppRoleIDRequest, vault.GetAppRoleIDResponse](
+			httpClient,
+			baseURL+VaultServiceGetAppRoleIDProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("GetAppRoleID")),
+			connect.WithClientOptions(opts...),
+		),
+		generateSecretID: connect.NewClient[vault.GenerateSecretIDRequest, vault.GenerateSecretIDResponse](
+			httpClient,
+			baseURL+VaultServiceGenerateSecretIDProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("GenerateSecretID")),
+			connect.WithClientOptions(opts...),
+		),
+		destroySecretID: connect.NewClient[vault.DestroySecretIDRequest, vault.DestroySecretIDResponse](
+			httpClient,
+			baseURL+VaultServiceDestroySecretIDProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("DestroySecretID")),
+			connect.WithClientOptions(opts...),
+		),
+		login: connect.NewClient[vault.LoginRequest, vault.CreateTokenResponse](
+			httpClient,
+			baseURL+VaultServiceLoginProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("Login")),
+			connect.WithClientOptions(opts...),
+		),
+		putJWTConfig: connect.NewClient[vault.PutJWTConfigRequest, vault.PutJWTConfigResponse](
+			httpClient,
+			baseURL+VaultServicePutJWTConfigProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutJWTConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		putJWTRole: connect.NewClient[vault.PutJWTRoleRequest, vault.PutJWTRoleResponse](
+			httpClient,
+			baseURL+VaultServicePutJWTRoleProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutJWTRole")),
+			connect.WithClientOptions(opts...),
+		),
+		jWTLogin: connect.NewClient[vault.JWTLoginRequest, vault.CreateTokenResponse](
+			httpClient,
+			baseURL+VaultServiceJWTLoginProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("JWTLogin")),
+			connect.WithClientOptions(opts...),
+		),
+		putCPIConfig: connect.NewClient[vault.PutCPIConfigRequest, vault.PutCPIConfigResponse](
+			httpClient,
+			baseURL+VaultServicePutCPIConfigProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutCPIConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		putAccessWindow: connect.NewClient[vault.PutAccessWindowRequest, vault.PutAccessWindowResponse](
+			httpClient,
+			baseURL+VaultServicePutAccessWindowProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutAccessWindow")),
+			connect.WithClientOptions(opts...),
+		),
+		putControlGroup: connect.NewClient[vault.PutControlGroupRequest, vault.PutControlGroupResponse](
+			httpClient,
+			baseURL+VaultServicePutControlGroupProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutControlGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		approveAccess: connect.NewClient[vault.ApproveAccessRequest, vault.AccessRequest](
+			httpClient,
+			baseURL+VaultServiceApproveAccessProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("ApproveAccess")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccessRequest: connect.NewClient[vault.GetAccessRequestRequest, vault.AccessRequest](
+			httpClient,
+			baseURL+VaultServiceGetAccessRequestProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("GetAccessRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		breakGlass: connect.NewClient[vault.BreakGlassRequest, vault.BreakGlassResponse](
+			httpClient,
+			baseURL+VaultServiceBreakGlassProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("BreakGlass")),
+			connect.WithClientOptions(opts...),
+		),
+		acknowledgeBreakGlass: connect.NewClient[vault.AcknowledgeBreakGlassRequest, vault.BreakGlassSession](
+			httpClient,
+			baseURL+VaultServiceAcknowledgeBreakGlassProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("AcknowledgeBreakGlass")),
+			connect.WithClientOptions(opts...),
+		),
+		listBreakGlass: connect.NewClient[vault.ListBreakGlassRequest, vault.ListBreakGlassResponse](
+			httpClient,
+			baseURL+VaultServiceListBreakGlassProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("ListBreakGlass")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccessHistory: connect.NewClient[vault.GetAccessHistoryRequest, vault.GetAccessHistoryResponse](
+			httpClient,
+			baseURL+VaultServiceGetAccessHistoryProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("GetAccessHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		getSecretMetadata: connect.NewClient[vault.GetSecretMetadataRequest, vault.SecretMetadata](
+			httpClient,
+			baseURL+VaultServiceGetSecretMetadataProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("GetSecretMetadata")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSecretMetadata: connect.NewClient[vault.UpdateSecretMetadataRequest, vault.SecretMetadata](
+			httpClient,
+			baseURL+VaultServiceUpdateSecretMetadataProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("UpdateSecretMetadata")),
+			connect.WithClientOptions(opts...),
+		),
+		putRetentionPolicy: connect.NewClient[vault.PutRetentionPolicyRequest, vault.PutRetentionPolicyResponse](
+			httpClient,
+			baseURL+VaultServicePutRetentionPolicyProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutRetentionPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		putRotation: connect.NewClient[vault.PutRotationRequest, vault.Rotation](
+			httpClient,
+			baseURL+VaultServicePutRotationProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutRotation")),
+			connect.WithClientOptions(opts...),
+		),
+		getRotation: connect.NewClient[vault.GetRotationRequest, vault.Rotation](
+			httpClient,
+			baseURL+VaultServiceGetRotationProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("GetRotation")),
+			connect.WithClientOptions(opts...),
+		),
+		putSecretTopics: connect.NewClient[vault.PutSecretTopicsRequest, vault.PutSecretTopicsResponse](
+			httpClient,
+			baseURL+VaultServicePutSecretTopicsProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutSecretTopics")),
+			connect.WithClientOptions(opts...),
+		),
+		putWebhook: connect.NewClient[vault.PutWebhookRequest, vault.PutWebhookResponse](
+			httpClient,
+			baseURL+VaultServicePutWebhookProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhook: connect.NewClient[vault.DeleteWebhookRequest, vault.DeleteWebhookResponse](
+			httpClient,
+			baseURL+VaultServiceDeleteWebhookProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("DeleteWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeliveries: connect.NewClient[vault.ListDeliveriesRequest, vault.ListDeliveriesResponse](
+			httpClient,
+			baseURL+VaultServiceListDeliveriesProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("ListDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		putDatabaseConfig: connect.NewClient[vault.PutDatabaseConfigRequest, vault.PutDatabaseConfigResponse](
+			httpClient,
+			baseURL+VaultServicePutDatabaseConfigProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutDatabaseConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		putDatabaseRole: connect.NewClient[vault.PutDatabaseRoleRequest, vault.PutDatabaseRoleResponse](
+			httpClient,
+			baseURL+VaultServicePutDatabaseRoleProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutDatabaseRole")),
+			connect.WithClientOptions(opts...),
+		),
+		getDatabaseCredentials: connect.NewClient[vault.GetDatabaseCredentialsRequest, vault.DatabaseCredentials](
+			httpClient,
+			baseURL+VaultServiceGetDatabaseCredentialsProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("GetDatabaseCredentials")),
+			connect.WithClientOptions(opts...),
+		),
+		renewLease: connect.NewClient[vault.RenewLeaseRequest, vault.Lease](
+			httpClient,
+			baseURL+VaultServiceRenewLeaseProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("RenewLease")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeLease: connect.NewClient[vault.RevokeLeaseRequest, vault.RevokeLeaseResponse](
+			httpClient,
+			baseURL+VaultServiceRevokeLeaseProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("RevokeLease")),
+			connect.WithClientOptions(opts...),
+		),
+		revokePrefix: connect.NewClient[vault.RevokePrefixRequest, vault.RevokePrefixResponse](
+			httpClient,
+			baseURL+VaultServiceRevokePrefixProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("RevokePrefix")),
+			connect.WithClientOptions(opts...),
+		),
+		reencryptSecrets: connect.NewClient[vault.ReencryptSecretsRequest, vault.ReencryptSecretsResponse](
+			httpClient,
+			baseURL+VaultServiceReencryptSecretsProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("ReencryptSecrets")),
+			connect.WithClientOptions(opts...),
+		),
+		putPasswordPolicy: connect.NewClient[vault.PutPasswordPolicyRequest, vault.PutPasswordPolicyResponse](
+			httpClient,
+			baseURL+VaultServicePutPasswordPolicyProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("PutPasswordPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		getPasswordPolicy: connect.NewClient[vault.GetPasswordPolicyRequest, vault.PasswordPolicy](
+			httpClient,
+			baseURL+VaultServiceGetPasswordPolicyProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("GetPasswordPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		generateSecret: connect.NewClient[vault.GenerateSecretRequest, vault.GenerateSecretResponse](
+			httpClient,
+			baseURL+VaultServiceGenerateSecretProcedure,
+			connect.WithSchema(vaultServiceMethods.ByName("GenerateSecret")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// vaultServiceClient implements VaultServiceClient.
+type vaultServiceClient struct {
+	vaultWrite             *connect.Client[vault.VaultWriteRequest, vault.VaultWriteResponse]
+	vaultRead              *connect.Client[vault.VaultReadRequest, vault.VaultReadResponse]
+	getSecretVersion       *connect.Client[vault.GetSecretVersionRequest, vault.VaultReadResponse]
+	listSecretVersions     *connect.Client[vault.ListSecretVersionsRequest, vault.ListSecretVersionsResponse]
+	listSecrets            *connect.Client[vault.ListSecretsRequest, vault.ListSecretsResponse]
+	testIAMPolicy          *connect.Client[vault.TestIAMPolicyRequest, vault.TestIAMPolicyResponse]
+	createToken            *connect.Client[vault.CreateTokenRequest, vault.CreateTokenResponse]
+	lookupToken            *connect.Client[vault.LookupTokenRequest, vault.TokenInfo]
+	renewToken             *connect.Client[vault.RenewTokenRequest, vault.TokenInfo]
+	revokeToken            *connect.Client[vault.RevokeTokenRequest, vault.RevokeTokenResponse]
+	putPolicy              *connect.Client[vault.PutPolicyRequest, vault.PutPolicyResponse]
+	getPolicy              *connect.Client[vault.GetPolicyRequest, vault.Policy]
+	putAppRole             *connect.Client[vault.PutAppRoleRequest, vault.PutAppRoleResponse]
+	getAppRoleID           *connect.Client[vault.GetAppRoleIDRequest, vault.GetAppRoleIDResponse]
+	generateSecretID       *connect.Client[vault.GenerateSecretIDRequest, vault.GenerateSecretIDResponse]
+	destroySecretID        *connect.Client[vault.DestroySecretIDRequest, vault.DestroySecretIDResponse]
+	login                  *connect.Client[vault.LoginRequest, vault.CreateTokenResponse]
+	putJWTConfig           *connect.Client[vault.PutJWTConfigRequest, vault.PutJWTConfigResponse]
+	putJWTRole             *connect.Client[vault.PutJWTRoleRequest, vault.PutJWTRoleResponse]
+	jWTLogin               *connect.Client[vault.JWTLoginRequest, vault.CreateTokenResponse]
+	putCPIConfig           *connect.Client[vault.PutCPIConfigRequest, vault.PutCPIConfigResponse]
+	putAccessWindow        *connect.Client[vault.PutAccessWindowRequest, vault.PutAccessWindowResponse]
+	putControlGroup        *connect.Client[vault.PutControlGroupRequest, vault.PutControlGroupResponse]
+	approveAccess          *connect.Client[vault.ApproveAccessRequest, vault.AccessRequest]
+	getAccessRequest       *connect.Client[vault.GetAccessRequestRequest, vault.AccessRequest]
+	breakGlass             *connect.Client[vault.BreakGlassRequest, vault.BreakGlassResponse]
+	acknowledgeBreakGlass  *connect.Client[vault.AcknowledgeBreakGlassRequest, vault.BreakGlassSession]
+	listBreakGlass         *connect.Client[vault.ListBreakGlassRequest, vault.ListBreakGlassResponse]
+	getAccessHistory       *connect.Client[vault.GetAccessHistoryRequest, vault.GetAccessHistoryResponse]
+	getSecretMetadata      *connect.Client[vault.GetSecretMetadataRequest, vault.SecretMetadata]
+	updateSecretMetadata   *connect.Client[vault.UpdateSecretMetadataRequest, vault.SecretMetadata]
+	putRetentionPolicy     *connect.Client[vault.PutRetentionPolicyRequest, vault.PutRetentionPolicyResponse]
+	putRotation            *connect.Client[vault.PutRotationRequest, vault.Rotation]
+	getRotation            *connect.Client[vault.GetRotationRequest, vault.Rotation]
+	putSecretTopics        *connect.Client[vault.PutSecretTopicsRequest, vault.PutSecretTopicsResponse]
+	putWebhook             *connect.Client[vault.PutWebhookRequest, vault.PutWebhookResponse]
+	deleteWebhook          *connect.Client[vault.DeleteWebhookRequest, vault.DeleteWebhookResponse]
+	listDeliveries         *connect.Client[vault.ListDeliveriesRequest, vault.ListDeliveriesResponse]
+	putDatabaseConfig      *connect.Client[vault.PutDatabaseConfigRequest, vault.PutDatabaseConfigResponse]
+	putDatabaseRole        *connect.Client[vault.PutDatabaseRoleRequest, vault.PutDatabaseRoleResponse]
+	getDatabaseCredentials *connect.Client[vault.GetDatabaseCredentialsRequest, vault.DatabaseCredentials]
+	renewLease             *connect.Client[vault.RenewLeaseRequest, vault.Lease]
+	revokeLease            *connect.Client[vault.RevokeLeaseRequest, vault.RevokeLeaseResponse]
+	revokePrefix           *connect.Client[vault.RevokePrefixRequest, vault.RevokePrefixResponse]
+	reencryptSecrets       *connect.Client[vault.ReencryptSecretsRequest, vault.ReencryptSecretsResponse]
+	putPasswordPolicy      *connect.Client[vault.PutPasswordPolicyRequest, vault.PutPasswordPolicyResponse]
+	getPasswordPolicy      *connect.Client[vault.GetPasswordPolicyRequest, vault.PasswordPolicy]
+	generateSecret         *connect.Client[vault.GenerateSecretRequest, vault.GenerateSecretResponse]
+}
+
+// VaultWrite calls vault.v1.VaultService.VaultWrite.
+func (c *vaultServiceClient) VaultWrite(ctx context.Context, req *connect.Request[vault.VaultWriteRequest]) (*connect.Response[vault.VaultWriteResponse], error) {
+	return c.vaultWrite.CallUnary(ctx, req)
+}
+
+// VaultRead calls vault.v1.VaultService.VaultRead.
+func (c *vaultServiceClient) VaultRead(ctx context.Context, req *connect.Request[vault.VaultReadRequest]) (*connect.Response[vault.VaultReadResponse], error) {
+	return c.vaultRead.CallUnary(ctx, req)
+}
+
+// GetSecretVersion calls vault.v1.VaultService.GetSecretVersion.
+func (c *vaultServiceClient) GetSecretVersion(ctx context.Context, req *connect.Request[vault.GetSecretVersionRequest]) (*connect.Response[vault.VaultReadResponse], error) {
+	return c.getSecretVersion.CallUnary(ctx, req)
+}
+
+// ListSecretVersions calls vault.v1.VaultService.ListSecretVersions.
+func (c *vaultServiceClient) ListSecretVersions(ctx context.Context, req *connect.Request[vault.ListSecretVersionsRequest]) (*connect.Response[vault.ListSecretVersionsResponse], error) {
+	return c.listSecretVersions.CallUnary(ctx, req)
+}
+
+// ListSecrets calls vault.v1.VaultService.ListSecrets.
+func (c *vaultServiceClient) ListSecrets(ctx context.Context, req *connect.Request[vault.ListSecretsRequest]) (*connect.Response[vault.ListSecretsResponse], error) {
+	return c.listSecrets.CallUnary(ctx, req)
+}
+
+// TestIAMPolicy calls vault.v1.VaultService.TestIAMPolicy.
+func (c *vaultServiceClient) TestIAMPolicy(ctx context.Context, req *connect.Request[vault.TestIAMPolicyRequest]) (*connect.Response[vault.TestIAMPolicyResponse], error) {
+	return c.testIAMPolicy.CallUnary(ctx, req)
+}
+
+// CreateToken calls vault.v1.VaultService.CreateToken.
+func (c *vaultServiceClient) CreateToken(ctx context.Context, req *connect.Request[vault.CreateTokenRequest]) (*connect.Response[vault.CreateTokenResponse], error) {
+	return c.createToken.CallUnary(ctx, req)
+}
+
+// LookupToken calls vault.v1.VaultService.LookupToken.
+func (c *vaultServiceClient) LookupToken(ctx context.Context, req *connect.Request[vault.LookupTokenRequest]) (*connect.Response[vault.TokenInfo], error) {
+	return c.lookupToken.CallUnary(ctx, req)
+}
+
+// RenewToken calls vault.v1.VaultService.RenewToken.
+func (c *vaultServiceClient) RenewToken(ctx context.Context, req *connect.Request[vault.RenewTokenRequest]) (*connect.Response[vault.TokenInfo], error) {
+	return c.renewToken.CallUnary(ctx, req)
+}
+
+// RevokeToken calls vault.v1.VaultService.RevokeToken.
+func (c *vaultServiceClient) RevokeToken(ctx context.Context, req *connect.Request[vault.RevokeTokenRequest]) (*connect.Response[vault.RevokeTokenResponse], error) {
+	return c.revokeToken.CallUnary(ctx, req)
+}
+
+// PutPolicy calls vault.v1.VaultService.PutPolicy.
+func (c *vaultServiceClient) PutPolicy(ctx context.Context, req *connect.Request[vault.PutPolicyRequest]) (*connect.Response[vault.PutPolicyResponse], error) {
+	return c.putPolicy.CallUnary(ctx, req)
+}
+
+// GetPolicy calls vault.v1.VaultService.GetPolicy.
+func (c *vaultServiceClient) GetPolicy(ctx context.Context, req *connect.Request[vault.GetPolicyRequest]) (*connect.Response[vault.Policy], error) {
+	return c.getPolicy.CallUnary(ctx, req)
+}
+
+// PutAppRole calls vault.v1.VaultService.PutAppRole.
+func (c *vaultServiceClient) PutAppRole(ctx context.Context, req *connect.Request[vault.PutAppRoleRequest]) (*connect.Response[vault.PutAppRoleResponse], error) {
+	return c.putAppRole.CallUnary(ctx, req)
+}
+
+// GetAppRoleID calls vault.v1.VaultService.GetAppRoleID.
+func (c *vaultServiceClient) GetAppRoleID(ctx context.Context, req *connect.Request[vault.GetAppRoleIDRequest]) (*connect.Response[vault.GetAppRoleIDResponse], error) {
+	return c.getAppRoleID.CallUnary(ctx, req)
+}
+
+// GenerateSecretID calls vault.v1.VaultService.GenerateSecretID.
+func (c *vaultServiceClient) GenerateSecretID(ctx context.Context, req *connect.Request[vault.GenerateSecretIDRequest]) (*connect.Response[vault.GenerateSecretIDResponse], error) {
+	return c.generateSecretID.CallUnary(ctx, req)
+}
+
+// DestroySecretID calls vault.v1.VaultService.DestroySecretID.
+func (c *vaultServiceClient) DestroySecretID(ctx context.Context, req *connect.Request[vault.DestroySecretIDRequest]) (*connect.Response[vault.DestroySecretIDResponse], error) {
+	return c.destroySecretID.CallUnary(ctx, req)
+}
+
+// Login calls vault.v1.VaultService.Login.
+func (c *vaultServiceClient) Login(ctx context.Context, req *connect.Request[vault.LoginRequest]) (*connect.Response[vault.CreateTokenResponse], error) {
+	return c.login.CallUnary(ctx, req)
+}
+
+// PutJWTConfig calls vault.v1.VaultService.PutJWTConfig.
+func (c *vaultServiceClient) PutJWTConfig(ctx context.Context, req *connect.Request[vault.PutJWTConfigRequest]) (*connect.Response[vault.PutJWTConfigResponse], error) {
+	return c.putJWTConfig.CallUnary(ctx, req)
+}
+
+// PutJWTRole calls vault.v1.VaultService.PutJWTRole.
+func (c *vaultServiceClient) PutJWTRole(ctx context.Context, req *connect.Request[vault.PutJWTRoleRequest]) (*connect.Response[vault.PutJWTRoleResponse], error) {
+	return c.putJWTRole.CallUnary(ctx, req)
+}
+
+// JWTLogin calls vault.v1.VaultService.JWTLogin.
+func (c *vaultServiceClient) JWTLogin(ctx context.Context, req *connect.Request[vault.JWTLoginRequest]) (*connect.Response[vault.CreateTokenResponse], error) {
+	return c.jWTLogin.CallUnary(ctx, req)
+}
+
+// PutCPIConfig calls vault.v1.VaultService.PutCPIConfig.
+func (c *vaultServiceClient) PutCPIConfig(ctx context.Context, req *connect.Request[vault.PutCPIConfigRequest]) (*connect.Response[vault.PutCPIConfigResponse], error) {
+	return c.putCPIConfig.CallUnary(ctx, req)
+}
+
+// PutAccessWindow calls vault.v1.VaultService.PutAccessWindow.
+func (c *vaultServiceClient) PutAccessWindow(ctx context.Context, req *connect.Request[vault.PutAccessWindowRequest]) (*connect.Response[vault.PutAccessWindowResponse], error) {
+	return c.putAccessWindow.CallUnary(ctx, req)
+}
+
+// PutControlGroup calls vault.v1.VaultService.PutControlGroup.
+func (c *vaultServiceClient) PutControlGroup(ctx context.Context, req *connect.Request[vault.PutControlGroupRequest]) (*connect.Response[vault.PutControlGroupResponse], error) {
+	return c.putControlGroup.CallUnary(ctx, req)
+}
+
+// ApproveAccess calls vault.v1.VaultService.ApproveAccess.
+func (c *vaultServiceClient) ApproveAccess(ctx context.Context, req *connect.Request[vault.ApproveAccessRequest]) (*connect.Response[vault.AccessRequest], error) {
+	return c.approveAccess.CallUnary(ctx, req)
+}
+
+// GetAccessRequest calls vault.v1.VaultService.GetAccessRequest.
+func (c *vaultServiceClient) GetAccessRequest(ctx context.Context, req *connect.Request[vault.GetAccessRequestRequest]) (*connect.Response[vault.AccessRequest], error) {
+	return c.getAccessRequest.CallUnary(ctx, req)
+}
+
+// BreakGlass calls vault.v1.VaultService.BreakGlass.
+func (c *vaultServiceClient) BreakGlass(ctx context.Context, req *connect.Request[vault.BreakGlassRequest]) (*connect.Response[vault.BreakGlassResponse], error) {
+	return c.breakGlass.CallUnary(ctx, req)
+}
+
+// AcknowledgeBreakGlass calls vault.v1.VaultService.AcknowledgeBreakGlass.
+func (c *vaultServiceClient) AcknowledgeBreakGlass(ctx context.Context, req *connect.Request[vault.AcknowledgeBreakGlassRequest]) (*connect.Response[vault.BreakGlassSession], error) {
+	return c.acknowledgeBreakGlass.CallUnary(ctx, req)
+}
+
+// ListBreakGlass calls vault.v1.VaultService.ListBreakGlass.
+func (c *vaultServiceClient) ListBreakGlass(ctx context.Context, req *connect.Request[vault.ListBreakGlassRequest]) (*connect.Response[vault.ListBreakGlassResponse], error) {
+	return c.listBreakGlass.CallUnary(ctx, req)
+}
+
+// GetAccessHistory calls vault.v1.VaultService.GetAccessHistory.
+func (c *vaultServiceClient) GetAccessHistory(ctx context.Context, req *connect.Request[vault.GetAccessHistoryRequest]) (*connect.Response[vault.GetAccessHistoryResponse], error) {
+	return c.getAccessHistory.CallUnary(ctx, req)
+}
+
+// GetSecretMetadata calls vault.v1.VaultService.GetSecretMetadata.
+func (c *vaultServiceClient) GetSecretMetadata(ctx context.Context, req *connect.Request[vault.GetSecretMetadataRequest]) (*connect.Response[vault.SecretMetadata], error) {
+	return c.getSecretMetadata.CallUnary(ctx, req)
+}
+
+// UpdateSecretMetadata calls vault.v1.VaultService.UpdateSecretMetadata.
+func (c *vaultServiceClient) UpdateSecretMetadata(ctx context.Context, req *connect.Request[vault.UpdateSecretMetadataRequest]) (*connect.Response[vault.SecretMetadata], error) {
+	return c.updateSecretMetadata.CallUnary(ctx, req)
+}
+
+// PutRetentionPolicy calls vault.v1.VaultService.PutRetentionPolicy.
+func (c *vaultServiceClient) PutRetentionPolicy(ctx context.Context, req *connect.Request[vault.PutRetentionPolicyRequest]) (*connect.Response[vault.PutRetentionPolicyResponse], error) {
+	return c.putRetentionPolicy.CallUnary(ctx, req)
+}
+
+// PutRotation calls vault.v1.VaultService.PutRotation.
+func (c *vaultServiceClient) PutRotation(ctx context.Context, req *connect.Request[vault.PutRotationRequest]) (*connect.Response[vault.Rotation], error) {
+	return c.putRotation.CallUnary(ctx, req)
+}
+
+// GetRotation calls vault.v1.VaultService.GetRotation.
+func (c *vaultServiceClient) GetRotation(ctx context.Context, req *connect.Request[vault.GetRotationRequest]) (*connect.Response[vault.Rotation], error) {
+	return c.getRotation.CallUnary(ctx, req)
+}
+
+// PutSecretTopics calls vault.v1.VaultService.PutSecretTopics.
+func (c *vaultServiceClient) PutSecretTopics(ctx context.Context, req *connect.Request[vault.PutSecretTopicsRequest]) (*connect.Response[vault.PutSecretTopicsResponse], error) {
+	return c.putSecretTopics.CallUnary(ctx, req)
+}
+
+// PutWebhook calls vault.v1.VaultService.PutWebhook.
+func (c *vaultServiceClient) PutWebhook(ctx context.Context, req *connect.Request[vault.PutWebhookRequest]) (*connect.Response[vault.PutWebhookResponse], error) {
+	return c.putWebhook.CallUnary(ctx, req)
+}
+
+// DeleteWebhook calls vault.v1.VaultService.DeleteWebhook.
+func (c *vaultServiceClient) DeleteWebhook(ctx context.Context, req *connect.Request[vault.DeleteWebhookRequest]) (*connect.Response[vault.DeleteWebhookResponse], error) {
+	return c.deleteWebhook.CallUnary(ctx, req)
+}
+
+// ListDeliveries calls vault.v1.VaultService.ListDeliveries.
+func (c *vaultServiceClient) ListDeliveries(ctx context.Context, req *connect.Request[vault.ListDeliveriesRequest]) (*connect.Response[vault.ListDeliveriesResponse], error) {
+	return c.listDeliveries.CallUnary(ctx, req)
+}
+
+// PutDatabaseConfig calls vault.v1.VaultService.PutDatabaseConfig.
+func (c *vaultServiceClient) PutDatabaseConfig(ctx context.Context, req *connect.Request[vault.PutDatabaseConfigRequest]) (*connect.Response[vault.PutDatabaseConfigResponse], error) {
+	return c.putDatabaseConfig.CallUnary(ctx, req)
+}
+
+// PutDatabaseRole calls vault.v1.VaultService.PutDatabaseRole.
+func (c *vaultServiceClient) PutDatabaseRole(ctx context.Context, req *connect.Request[vault.PutDatabaseRoleRequest]) (*connect.Response[vault.PutDatabaseRoleResponse], error) {
+	return c.putDatabaseRole.CallUnary(ctx, req)
+}
+
+// GetDatabaseCredentials calls vault.v1.VaultService.GetDatabaseCredentials.
+func (c *vaultServiceClient) GetDatabaseCredentials(ctx context.Context, req *connect.Request[vault.GetDatabaseCredentialsRequest]) (*connect.Response[vault.DatabaseCredentials], error) {
+	return c.getDatabaseCredentials.CallUnary(ctx, req)
+}
+
+// RenewLease calls vault.v1.VaultService.RenewLease.
+func (c *vaultServiceClient) RenewLease(ctx context.Context, req *connect.Request[vault.RenewLeaseRequest]) (*connect.Response[vault.Lease], error) {
+	return c.renewLease.CallUnary(ctx, req)
+}
+
+// RevokeLease calls vault.v1.VaultService.RevokeLease.
+func (c *vaultServiceClient) RevokeLease(ctx context.Context, req *connect.Request[vault.RevokeLeaseRequest]) (*connect.Response[vault.RevokeLeaseResponse], error) {
+	return c.revokeLease.CallUnary(ctx, req)
+}
+
+// RevokePrefix calls vault.v1.VaultService.RevokePrefix.
+func (c *vaultServiceClient) RevokePrefix(ctx context.Context, req *connect.Request[vault.RevokePrefixRequest]) (*connect.Response[vault.RevokePrefixResponse], error) {
+	return c.revokePrefix.CallUnary(ctx, req)
+}
+
+// ReencryptSecrets calls vault.v1.VaultService.ReencryptSecrets.
+func (c *vaultServiceClient) ReencryptSecrets(ctx context.Context, req *connect.Request[vault.ReencryptSecretsRequest]) (*connect.Response[vault.ReencryptSecretsResponse], error) {
+	return c.reencryptSecrets.CallUnary(ctx, req)
+}
+
+// PutPasswordPolicy calls vault.v1.VaultService.PutPasswordPolicy.
+func (c *vaultServiceClient) PutPasswordPolicy(ctx context.Context, req *connect.Request[vault.PutPasswordPolicyRequest]) (*connect.Response[vault.PutPasswordPolicyResponse], error) {
+	return c.putPasswordPolicy.CallUnary(ctx, req)
+}
+
+// GetPasswordPolicy calls vault.v1.VaultService.GetPasswordPolicy.
+func (c *vaultServiceClient) GetPasswordPolicy(ctx context.Context, req *connect.Request[vault.GetPasswordPolicyRequest]) (*connect.Response[vault.PasswordPolicy], error) {
+	return c.getPasswordPolicy.CallUnary(ctx, req)
+}
+
+// GenerateSecret calls vault.v1.VaultService.GenerateSecret.
+func (c *vaultServiceClient) GenerateSecret(ctx context.Context, req *connect.Request[vault.GenerateSecretRequest]) (*connect.Response[vault.GenerateSecretResponse], error) {
+	return c.generateSecret.CallUnary(ctx, req)
+}
+
+// VaultServiceHandler is an implementation of the vault.v1.VaultService service.
+type VaultServiceHandler interface {
+	VaultWrite(context.Context, *connect.Request[vault.VaultWriteRequest]) (*connect.Response[vault.VaultWriteResponse], error)
+	VaultRead(context.Context, *connect.Request[vault.VaultReadRequest]) (*connect.Response[vault.VaultReadResponse], error)
+	GetSecretVersion(context.Context, *connect.Request[vault.GetSecretVersionRequest]) (*connect.Response[vault.VaultReadResponse], error)
+	ListSecretVersions(context.Context, *connect.Request[vault.ListSecretVersionsRequest]) (*connect.Response[vault.ListSecretVersionsResponse], error)
+	ListSecrets(context.Context, *connect.Request[vault.ListSecretsRequest]) (*connect.Response[vault.ListSecretsResponse], error)
+	TestIAMPolicy(context.Context, *connect.Request[vault.TestIAMPolicyRequest]) (*connect.Response[vault.TestIAMPolicyResponse], error)
+	// Token auth method
+	CreateToken(context.Context, *connect.Request[vault.CreateTokenRequest]) (*connect.Response[vault.CreateTokenResponse], error)
+	LookupToken(context.Context, *connect.Request[vault.LookupTokenRequest]) (*connect.Response[vault.TokenInfo], error)
+	RenewToken(context.Context, *connect.Request[vault.RenewTokenRequest]) (*connect.Response[vault.TokenInfo], error)
+	RevokeToken(context.Context, *connect.Request[vault.RevokeTokenRequest]) (*connect.Response[vault.RevokeTokenResponse], error)
+	// ACL policies referenced by tokens
+	PutPolicy(context.Context, *connect.Request[vault.PutPolicyRequest]) (*connect.Response[vault.PutPolicyResponse], error)
+	GetPolicy(context.Context, *connect.Request[vault.GetPolicyRequest]) (*connect.Response[vault.Policy], error)
+	// AppRole machine auth method
+	PutAppRole(context.Context, *connect.Request[vault.PutAppRoleRequest]) (*connect.Response[vault.PutAppRoleResponse], error)
+	GetAppRoleID(context.Context, *connect.Request[vault.GetAppRoleIDRequest]) (*connect.Response[vault.GetAppRoleIDResponse], error)
+	GenerateSecretID(context.Context, *connect.Request[vault.GenerateSecretIDRequest]) (*connect.Response[vault.GenerateSecretIDResponse], error)
+	DestroySecretID(context.Context, *connect.Request[vault.DestroySecretIDRequest]) (*connect.Response[vault.DestroySecretIDResponse], error)
+	Login(context.Context, *connect.Request[vault.LoginRequest]) (*connect.Response[vault.CreateTokenResponse], error)
+	// JWT/OIDC auth method
+	PutJWTConfig(context.Context, *connect.Request[vault.PutJWTConfigRequest]) (*connect.Response[vault.PutJWTConfigResponse], error)
+	PutJWTRole(context.Context, *connect.Request[vault.PutJWTRoleRequest]) (*connect.Response[vault.PutJWTRoleResponse], error)
+	JWTLogin(context.Context, *connect.Request[vault.JWTLoginRequest]) (*connect.Response[vault.CreateTokenResponse], error)
+	// Composite Platform Identity attestation
+	PutCPIConfig(context.Context, *connect.Request[vault.PutCPIConfigRequest]) (*connect.Response[vault.PutCPIConfigResponse], error)
+	// Temporal access windows on secrets
+	PutAccessWindow(context.Context, *connect.Request[vault.PutAccessWindowRequest]) (*connect.Response[vault.PutAccessWindowResponse], error)
+	// Control groups (multi-person approval)
+	PutControlGroup(context.Context, *connect.Request[vault.PutControlGroupRequest]) (*connect.Response[vault.PutControlGroupResponse], error)
+	ApproveAccess(context.Context, *connect.Request[vault.ApproveAccessRequest]) (*connect.Response[vault.AccessRequest], error)
+	GetAccessRequest(context.Context, *connect.Request[vault.GetAccessRequestRequest]) (*connect.Response[vault.AccessRequest], error)
+	// Break-glass emergency access
+	BreakGlass(context.Context, *connect.Request[vault.BreakGlassRequest]) (*connect.Response[vault.BreakGlassResponse], error)
+	AcknowledgeBreakGlass(context.Context, *connect.Request[vault.AcknowledgeBreakGlassRequest]) (*connect.Response[vault.BreakGlassSession], error)
+	ListBreakGlass(context.Context, *connect.Request[vault.ListBreakGlassRequest]) (*connect.Response[vault.ListBreakGlassResponse], error)
+	// Access transparency
+	GetAccessHistory(context.Context, *connect.Request[vault.GetAccessHistoryRequest]) (*connect.Response[vault.GetAccessHistoryResponse], error)
+	// Secret metadata and expiry
+	GetSecretMetadata(context.Context, *connect.Request[vault.GetSecretMetadataRequest]) (*connect.Response[vault.SecretMetadata], error)
+	UpdateSecretMetadata(context.Context, *connect.Request[vault.UpdateSecretMetadataRequest]) (*connect.Response[vault.SecretMetadata], error)
+	// Version retention
+	PutRetentionPolicy(context.Context, *connect.Request[vault.PutRetentionPolicyRequest]) (*connect.Response[vault.PutRetentionPolicyResponse], error)
+	// Scheduled rotation
+	PutRotation(context.Context, *connect.Request[vault.PutRotationRequest]) (*connect.Response[vault.Rotation], error)
+	GetRotation(context.Context, *connect.Request[vault.GetRotationRequest]) (*connect.Response[vault.Rotation], error)
+	// Event notifications
+	PutSecretTopics(context.Context, *connect.Request[vault.PutSecretTopicsRequest]) (*connect.Response[vault.PutSecretTopicsResponse], error)
+	PutWebhook(context.Context, *connect.Request[vault.PutWebhookRequest]) (*connect.Response[vault.PutWebhookResponse], error)
+	DeleteWebhook(context.Context, *connect.Request[vault.DeleteWebhookRequest]) (*connect.Response[vault.DeleteWebhookResponse], error)
+	ListDeliveries(context.Context, *connect.Request[vault.ListDeliveriesRequest]) (*connect.Response[vault.ListDeliveriesResponse], error)
+	// Dynamic database credentials
+	PutDatabaseConfig(context.Context, *connect.Request[vault.PutDatabaseConfigRequest]) (*connect.Response[vault.PutDatabaseConfigResponse], error)
+	PutDatabaseRole(context.Context, *connect.Request[vault.PutDatabaseRoleRequest]) (*connect.Response[vault.PutDatabaseRoleResponse], error)
+	GetDatabaseCredentials(context.Context, *connect.Request[vault.GetDatabaseCredentialsRequest]) (*connect.Response[vault.DatabaseCredentials], error)
+	// Leases on dynamic credentials and tokens
+	RenewLease(context.Context, *connect.Request[vault.RenewLeaseRequest]) (*connect.Response[vault.Lease], error)
+	RevokeLease(context.Context, *connect.Request[vault.RevokeLeaseRequest]) (*connect.Response[vault.RevokeLeaseResponse], error)
+	RevokePrefix(context.Context, *connect.Request[vault.RevokePrefixRequest]) (*connect.Response[vault.RevokePrefixResponse], error)
+	// Customer-managed encryption keys
+	ReencryptSecrets(context.Context, *connect.Request[vault.ReencryptSecretsRequest]) (*connect.Response[vault.ReencryptSecretsResponse], error)
+	// Password policies and secret generation
+	PutPasswordPolicy(context.Context, *connect.Request[vault.PutPasswordPolicyRequest]) (*connect.Response[vault.PutPasswordPolicyResponse], error)
+	GetPasswordPolicy(context.Context, *connect.Request[vault.GetPasswordPolicyRequest]) (*connect.Response[vault.PasswordPolicy], error)
+	GenerateSecret(context.Context, *connect.Request[vault.GenerateSecretRequest]) (*connect.Response[vault.GenerateSecretResponse], error)
+}
+
+// NewVaultServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewVaultServiceHandler(svc VaultServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	vaultServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("VaultService").Methods()
+	vaultServiceVaultWriteHandler := connect.NewUnaryHandler(
+		VaultServiceVaultWriteProcedure,
+		svc.VaultWrite,
+		connect.WithSchema(vaultServiceMethods.ByName("VaultWrite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceVaultReadHandler := connect.NewUnaryHandler(
+		VaultServiceVaultReadProcedure,
+		svc.VaultRead,
+		connect.WithSchema(vaultServiceMethods.ByName("VaultRead")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceGetSecretVersionHandler := connect.NewUnaryHandler(
+		VaultServiceGetSecretVersionProcedure,
+		svc.GetSecretVersion,
+		connect.WithSchema(vaultServiceMethods.ByName("GetSecretVersion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceListSecretVersionsHandler := connect.NewUnaryHandler(
+		VaultServiceListSecretVersionsProcedure,
+		svc.ListSecretVersions,
+		connect.WithSchema(vaultServiceMethods.ByName("ListSecretVersions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceListSecretsHandler := connect.NewUnaryHandler(
+		VaultServiceListSecretsProcedure,
+		svc.ListSecrets,
+		connect.WithSchema(vaultServiceMethods.ByName("ListSecrets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceTestIAMPolicyHandler := connect.NewUnaryHandler(
+		VaultServiceTestIAMPolicyProcedure,
+		svc.TestIAMPolicy,
+		connect.WithSchema(vaultServiceMethods.ByName("TestIAMPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceCreateTokenHandler := connect.NewUnaryHandler(
+		VaultServiceCreateTokenProcedure,
+		svc.CreateToken,
+		connect.WithSchema(vaultServiceMethods.ByName("CreateToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceLookupTokenHandler := connect.NewUnaryHandler(
+		VaultServiceLookupTokenProcedure,
+		svc.LookupToken,
+		connect.WithSchema(vaultServiceMethods.ByName("LookupToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceRenewTokenHandler := connect.NewUnaryHandler(
+		VaultServiceRenewTokenProcedure,
+		svc.RenewToken,
+		connect.WithSchema(vaultServiceMethods.ByName("RenewToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceRevokeTokenHandler := connect.NewUnaryHandler(
+		VaultServiceRevokeTokenProcedure,
+		svc.RevokeToken,
+		connect.WithSchema(vaultServiceMethods.ByName("RevokeToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutPolicyHandler := connect.NewUnaryHandler(
+		VaultServicePutPolicyProcedure,
+		svc.PutPolicy,
+		connect.WithSchema(vaultServiceMethods.ByName("PutPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceGetPolicyHandler := connect.NewUnaryHandler(
+		VaultServiceGetPolicyProcedure,
+		svc.GetPolicy,
+		connect.WithSchema(vaultServiceMethods.ByName("GetPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutAppRoleHandler := connect.NewUnaryHandler(
+		VaultServicePutAppRoleProcedure,
+		svc.PutAppRole,
+		connect.WithSchema(vaultServiceMethods.ByName("PutAppRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceGetAppRoleIDHandler := connect.NewUnaryHandler(
+		VaultServiceGetAppRoleIDProcedure,
+		svc.GetAppRoleID,
+		connect.WithSchema(vaultServiceMethods.ByName("GetAppRoleID")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceGenerateSecretIDHandler := connect.NewUnaryHandler(
+		VaultServiceGenerateSecretIDProcedure,
+		svc.GenerateSecretID,
+		connect.WithSchema(vaultServiceMethods.ByName("GenerateSecretID")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceDestroySecretIDHandler := connect.NewUnaryHandler(
+		VaultServiceDestroySecretIDProcedure,
+		svc.DestroySecretID,
+		connect.WithSchema(vaultServiceMethods.ByName("DestroySecretID")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceLoginHandler := connect.NewUnaryHandler(
+		VaultServiceLoginProcedure,
+		svc.Login,
+		connect.WithSchema(vaultServiceMethods.ByName("Login")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutJWTConfigHandler := connect.NewUnaryHandler(
+		VaultServicePutJWTConfigProcedure,
+		svc.PutJWTConfig,
+		connect.WithSchema(vaultServiceMethods.ByName("PutJWTConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutJWTRoleHandler := connect.NewUnaryHandler(
+		VaultServicePutJWTRoleProcedure,
+		svc.PutJWTRole,
+		connect.WithSchema(vaultServiceMethods.ByName("PutJWTRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceJWTLoginHandler := connect.NewUnaryHandler(
+		VaultServiceJWTLoginProcedure,
+		svc.JWTLogin,
+		connect.WithSchema(vaultServiceMethods.ByName("JWTLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutCPIConfigHandler := connect.NewUnaryHandler(
+		VaultServicePutCPIConfigProcedure,
+		svc.PutCPIConfig,
+		connect.WithSchema(vaultServiceMethods.ByName("PutCPIConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutAccessWindowHandler := connect.NewUnaryHandler(
+		VaultServicePutAccessWindowProcedure,
+		svc.PutAccessWindow,
+		connect.WithSchema(vaultServiceMethods.ByName("PutAccessWindow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutControlGroupHandler := connect.NewUnaryHandler(
+		VaultServicePutControlGroupProcedure,
+		svc.PutControlGroup,
+		connect.WithSchema(vaultServiceMethods.ByName("PutControlGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceApproveAccessHandler := connect.NewUnaryHandler(
+		VaultServiceApproveAccessProcedure,
+		svc.ApproveAccess,
+		connect.WithSchema(vaultServiceMethods.ByName("ApproveAccess")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceGetAccessRequestHandler := connect.NewUnaryHandler(
+		VaultServiceGetAccessRequestProcedure,
+		svc.GetAccessRequest,
+		connect.WithSchema(vaultServiceMethods.ByName("GetAccessRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceBreakGlassHandler := connect.NewUnaryHandler(
+		VaultServiceBreakGlassProcedure,
+		svc.BreakGlass,
+		connect.WithSchema(vaultServiceMethods.ByName("BreakGlass")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceAcknowledgeBreakGlassHandler := connect.NewUnaryHandler(
+		VaultServiceAcknowledgeBreakGlassProcedure,
+		svc.AcknowledgeBreakGlass,
+		connect.WithSchema(vaultServiceMethods.ByName("AcknowledgeBreakGlass")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceListBreakGlassHandler := connect.NewUnaryHandler(
+		VaultServiceListBreakGlassProcedure,
+		svc.ListBreakGlass,
+		connect.WithSchema(vaultServiceMethods.ByName("ListBreakGlass")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceGetAccessHistoryHandler := connect.NewUnaryHandler(
+		VaultServiceGetAccessHistoryProcedure,
+		svc.GetAccessHistory,
+		connect.WithSchema(vaultServiceMethods.ByName("GetAccessHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceGetSecretMetadataHandler := connect.NewUnaryHandler(
+		VaultServiceGetSecretMetadataProcedure,
+		svc.GetSecretMetadata,
+		connect.WithSchema(vaultServiceMethods.ByName("GetSecretMetadata")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceUpdateSecretMetadataHandler := connect.NewUnaryHandler(
+		VaultServiceUpdateSecretMetadataProcedure,
+		svc.UpdateSecretMetadata,
+		connect.WithSchema(vaultServiceMethods.ByName("UpdateSecretMetadata")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutRetentionPolicyHandler := connect.NewUnaryHandler(
+		VaultServicePutRetentionPolicyProcedure,
+		svc.PutRetentionPolicy,
+		connect.WithSchema(vaultServiceMethods.ByName("PutRetentionPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutRotationHandler := connect.NewUnaryHandler(
+		VaultServicePutRotationProcedure,
+		svc.PutRotation,
+		connect.WithSchema(vaultServiceMethods.ByName("PutRotation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceGetRotationHandler := connect.NewUnaryHandler(
+		VaultServiceGetRotationProcedure,
+		svc.GetRotation,
+		connect.WithSchema(vaultServiceMethods.ByName("GetRotation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutSecretTopicsHandler := connect.NewUnaryHandler(
+		VaultServicePutSecretTopicsProcedure,
+		svc.PutSecretTopics,
+		connect.WithSchema(vaultServiceMethods.ByName("PutSecretTopics")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutWebhookHandler := connect.NewUnaryHandler(
+		VaultServicePutWebhookProcedure,
+		svc.PutWebhook,
+		connect.WithSchema(vaultServiceMethods.ByName("PutWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceDeleteWebhookHandler := connect.NewUnaryHandler(
+		VaultServiceDeleteWebhookProcedure,
+		svc.DeleteWebhook,
+		connect.WithSchema(vaultServiceMethods.ByName("DeleteWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceListDeliveriesHandler := connect.NewUnaryHandler(
+		VaultServiceListDeliveriesProcedure,
+		svc.ListDeliveries,
+		connect.WithSchema(vaultServiceMethods.ByName("ListDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutDatabaseConfigHandler := connect.NewUnaryHandler(
+		VaultServicePutDatabaseConfigProcedure,
+		svc.PutDatabaseConfig,
+		connect.WithSchema(vaultServiceMethods.ByName("PutDatabaseConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutDatabaseRoleHandler := connect.NewUnaryHandler(
+		VaultServicePutDatabaseRoleProcedure,
+		svc.PutDatabaseRole,
+		connect.WithSchema(vaultServiceMethods.ByName("PutDatabaseRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceGetDatabaseCredentialsHandler := connect.NewUnaryHandler(
+		VaultServiceGetDatabaseCredentialsProcedure,
+		svc.GetDatabaseCredentials,
+		connect.WithSchema(vaultServiceMethods.ByName("GetDatabaseCredentials")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceRenewLeaseHandler := connect.NewUnaryHandler(
+		VaultServiceRenewLeaseProcedure,
+		svc.RenewLease,
+		connect.WithSchema(vaultServiceMethods.ByName("RenewLease")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceRevokeLeaseHandler := connect.NewUnaryHandler(
+		VaultServiceRevokeLeaseProcedure,
+		svc.RevokeLease,
+		connect.WithSchema(vaultServiceMethods.ByName("RevokeLease")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceRevokePrefixHandler := connect.NewUnaryHandler(
+		VaultServiceRevokePrefixProcedure,
+		svc.RevokePrefix,
+		connect.WithSchema(vaultServiceMethods.ByName("RevokePrefix")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceReencryptSecretsHandler := connect.NewUnaryHandler(
+		VaultServiceReencryptSecretsProcedure,
+		svc.ReencryptSecrets,
+		connect.WithSchema(vaultServiceMethods.ByName("ReencryptSecrets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServicePutPasswordPolicyHandler := connect.NewUnaryHandler(
+		VaultServicePutPasswordPolicyProcedure,
+		svc.PutPasswordPolicy,
+		connect.WithSchema(vaultServiceMethods.ByName("PutPasswordPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceGetPasswordPolicyHandler := connect.NewUnaryHandler(
+		VaultServiceGetPasswordPolicyProcedure,
+		svc.GetPasswordPolicy,
+		connect.WithSchema(vaultServiceMethods.ByName("GetPasswordPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vaultServiceGenerateSecretHandler := connect.NewUnaryHandler(
+		VaultServiceGenerateSecretProcedure,
+		svc.GenerateSecret,
+		connect.WithSchema(vaultServiceMethods.ByName("GenerateSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vault.v1.VaultService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case VaultServiceVaultWriteProcedure:
+			vaultServiceVaultWriteHandler.ServeHTTP(w, r)
+		case VaultServiceVaultReadProcedure:
+			vaultServiceVaultReadHandler.ServeHTTP(w, r)
+		case VaultServiceGetSecretVersionProcedure:
+			vaultServiceGetSecretVersionHandler.ServeHTTP(w, r)
+		case VaultServiceListSecretVersionsProcedure:
+			vaultServiceListSecretVersionsHandler.ServeHTTP(w, r)
+		case VaultServiceListSecretsProcedure:
+			vaultServiceListSecretsHandler.ServeHTTP(w, r)
+		case VaultServiceTestIAMPolicyProcedure:
+			vaultServiceTestIAMPolicyHandler.ServeHTTP(w, r)
+		case VaultServiceCreateTokenProcedure:
+			vaultServiceCreateTokenHandler.ServeHTTP(w, r)
+		case VaultServiceLookupTokenProcedure:
+			vaultServiceLookupTokenHandler.ServeHTTP(w, r)
+		case VaultServiceRenewTokenProcedure:
+			vaultServiceRenewTokenHandler.ServeHTTP(w, r)
+		case VaultServiceRevokeTokenProcedure:
+			vaultServiceRevokeTokenHandler.ServeHTTP(w, r)
+		case VaultServicePutPolicyProcedure:
+			vaultServicePutPolicyHandler.ServeHTTP(w, r)
+		case VaultServiceGetPolicyProcedure:
+			vaultServiceGetPolicyHandler.ServeHTTP(w, r)
+		case VaultServicePutAppRoleProcedure:
+			vaultServicePutAppRoleHandler.ServeHTTP(w, r)
+		case VaultServiceGetAppRoleIDProcedure:
+			vaultServiceGetAppRoleIDHandler.ServeHTTP(w, r)
+		case VaultServiceGenerateSecretIDProcedure:
+			vaultServiceGenerateSecretIDHandler.ServeHTTP(w, r)
+		case VaultServiceDestroySecretIDProcedure:
+			vaultServiceDestroySecretIDHandler.ServeHTTP(w, r)
+		case VaultServiceLoginProcedure:
+			vaultServiceLoginHandler.ServeHTTP(w, r)
+		case VaultServicePutJWTConfigProcedure:
+			vaultServicePutJWTConfigHandler.ServeHTTP(w, r)
+		case VaultServicePutJWTRoleProcedure:
+			vaultServicePutJWTRoleHandler.ServeHTTP(w, r)
+		case VaultServiceJWTLoginProcedure:
+			vaultServiceJWTLoginHandler.ServeHTTP(w, r)
+		case VaultServicePutCPIConfigProcedure:
+			vaultServicePutCPIConfigHandler.ServeHTTP(w, r)
+		case VaultServicePutAccessWindowProcedure:
+			vaultServicePutAccessWindowHandler.ServeHTTP(w, r)
+		case VaultServicePutControlGroupProcedure:
+			vaultServicePutControlGroupHandler.ServeHTTP(w, r)
+		case VaultServiceApproveAccessProcedure:
+			vaultServiceApproveAccessHandler.ServeHTTP(w, r)
+		case VaultServiceGetAccessRequestProcedure:
+			vaultServiceGetAccessRequestHandler.ServeHTTP(w, r)
+		case VaultServiceBreakGlassProcedure:
+			vaultServiceBreakGlassHandler.ServeHTTP(w, r)
+		case VaultServiceAcknowledgeBreakGlassProcedure:
+			vaultServiceAcknowledgeBreakGlassHandler.ServeHTTP(w, r)
+		case VaultServiceListBreakGlassProcedure:
+			vaultServiceListBreakGlassHandler.ServeHTTP(w, r)
+		case VaultServiceGetAccessHistoryProcedure:
+			vaultServiceGetAccessHistoryHandler.ServeHTTP(w, r)
+		case VaultServiceGetSecretMetadataProcedure:
+			vaultServiceGetSecretMetadataHandler.ServeHTTP(w, r)
+		case VaultServiceUpdateSecretMetadataProcedure:
+			vaultServiceUpdateSecretMetadataHandler.ServeHTTP(w, r)
+		case VaultServicePutRetentionPolicyProcedure:
+			vaultServicePutRetentionPolicyHandler.ServeHTTP(w, r)
+		case VaultServicePutRotationProcedure:
+			vaultServicePutRotationHandler.ServeHTTP(w, r)
+		case VaultServiceGetRotationProcedure:
+			vaultServiceGetRotationHandler.ServeHTTP(w, r)
+		case VaultServicePutSecretTopicsProcedure:
+			vaultServicePutSecretTopicsHandler.ServeHTTP(w, r)
+		case VaultServicePutWebhookProcedure:
+			vaultServicePutWebhookHandler.ServeHTTP(w, r)
+		case VaultServiceDeleteWebhookProcedure:
+			vaultServiceDeleteWebhookHandler.ServeHTTP(w, r)
+		case VaultServiceListDeliveriesProcedure:
+			vaultServiceListDeliveriesHandler.ServeHTTP(w, r)
+		case VaultServicePutDatabaseConfigProcedure:
+			vaultServicePutDatabaseConfigHandler.ServeHTTP(w, r)
+		case VaultServicePutDatabaseRoleProcedure:
+			vaultServicePutDatabaseRoleHandler.ServeHTTP(w, r)
+		case VaultServiceGetDatabaseCredentialsProcedure:
+			vaultServiceGetDatabaseCredentialsHandler.ServeHTTP(w, r)
+		case VaultServiceRenewLeaseProcedure:
+			vaultServiceRenewLeaseHandler.ServeHTTP(w, r)
+		case VaultServiceRevokeLeaseProcedure:
+			vaultServiceRevokeLeaseHandler.ServeHTTP(w, r)
+		case VaultServiceRevokePrefixProcedure:
+			vaultServiceRevokePrefixHandler.ServeHTTP(w, r)
+		case VaultServiceReencryptSecretsProcedure:
+			vaultServiceReencryptSecretsHandler.ServeHTTP(w, r)
+		case VaultServicePutPasswordPolicyProcedure:
+			vaultServicePutPasswordPolicyHandler.ServeHTTP(w, r)
+		case VaultServiceGetPasswordPolicyProcedure:
+			vaultServiceGetPasswordPolicyHandler.ServeHTTP(w, r)
+		case VaultServiceGenerateSecretProcedure:
+			vaultServiceGenerateSecretHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedVaultServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedVaultServiceHandler struct{}
+
+func (UnimplementedVaultServiceHandler) VaultWrite(context.Context, *connect.Request[vault.VaultWriteRequest]) (*connect.Response[vault.VaultWriteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.VaultWrite is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) VaultRead(context.Context, *connect.Request[vault.VaultReadRequest]) (*connect.Response[vault.VaultReadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.VaultRead is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) GetSecretVersion(context.Context, *connect.Request[vault.GetSecretVersionRequest]) (*connect.Response[vault.VaultReadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.GetSecretVersion is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) ListSecretVersions(context.Context, *connect.Request[vault.ListSecretVersionsRequest]) (*connect.Response[vault.ListSecretVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.ListSecretVersions is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) ListSecrets(context.Context, *connect.Request[vault.ListSecretsRequest]) (*connect.Response[vault.ListSecretsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.ListSecrets is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) TestIAMPolicy(context.Context, *connect.Request[vault.TestIAMPolicyRequest]) (*connect.Response[vault.TestIAMPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.TestIAMPolicy is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) CreateToken(context.Context, *connect.Request[vault.CreateTokenRequest]) (*connect.Response[vault.CreateTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.CreateToken is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) LookupToken(context.Context, *connect.Request[vault.LookupTokenRequest]) (*connect.Response[vault.TokenInfo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.LookupToken is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) RenewToken(context.Context, *connect.Request[vault.RenewTokenRequest]) (*connect.Response[vault.TokenInfo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.RenewToken is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) RevokeToken(context.Context, *connect.Request[vault.RevokeTokenRequest]) (*connect.Response[vault.RevokeTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.RevokeToken is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutPolicy(context.Context, *connect.Request[vault.PutPolicyRequest]) (*connect.Response[vault.PutPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutPolicy is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) GetPolicy(context.Context, *connect.Request[vault.GetPolicyRequest]) (*connect.Response[vault.Policy], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.GetPolicy is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutAppRole(context.Context, *connect.Request[vault.PutAppRoleRequest]) (*connect.Response[vault.PutAppRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutAppRole is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) GetAppRoleID(context.Context, *connect.Request[vault.GetAppRoleIDRequest]) (*connect.Response[vault.GetAppRoleIDResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.GetAppRoleID is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) GenerateSecretID(context.Context, *connect.Request[vault.GenerateSecretIDRequest]) (*connect.Response[vault.GenerateSecretIDResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.GenerateSecretID is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) DestroySecretID(context.Context, *connect.Request[vault.DestroySecretIDRequest]) (*connect.Response[vault.DestroySecretIDResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.DestroySecretID is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) Login(context.Context, *connect.Request[vault.LoginRequest]) (*connect.Response[vault.CreateTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.Login is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutJWTConfig(context.Context, *connect.Request[vault.PutJWTConfigRequest]) (*connect.Response[vault.PutJWTConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutJWTConfig is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutJWTRole(context.Context, *connect.Request[vault.PutJWTRoleRequest]) (*connect.Response[vault.PutJWTRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutJWTRole is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) JWTLogin(context.Context, *connect.Request[vault.JWTLoginRequest]) (*connect.Response[vault.CreateTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.JWTLogin is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutCPIConfig(context.Context, *connect.Request[vault.PutCPIConfigRequest]) (*connect.Response[vault.PutCPIConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutCPIConfig is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutAccessWindow(context.Context, *connect.Request[vault.PutAccessWindowRequest]) (*connect.Response[vault.PutAccessWindowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutAccessWindow is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutControlGroup(context.Context, *connect.Request[vault.PutControlGroupRequest]) (*connect.Response[vault.PutControlGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutControlGroup is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) ApproveAccess(context.Context, *connect.Request[vault.ApproveAccessRequest]) (*connect.Response[vault.AccessRequest], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.ApproveAccess is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) GetAccessRequest(context.Context, *connect.Request[vault.GetAccessRequestRequest]) (*connect.Response[vault.AccessRequest], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.GetAccessRequest is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) BreakGlass(context.Context, *connect.Request[vault.BreakGlassRequest]) (*connect.Response[vault.BreakGlassResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.BreakGlass is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) AcknowledgeBreakGlass(context.Context, *connect.Request[vault.AcknowledgeBreakGlassRequest]) (*connect.Response[vault.BreakGlassSession], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.AcknowledgeBreakGlass is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) ListBreakGlass(context.Context, *connect.Request[vault.ListBreakGlassRequest]) (*connect.Response[vault.ListBreakGlassResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.ListBreakGlass is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) GetAccessHistory(context.Context, *connect.Request[vault.GetAccessHistoryRequest]) (*connect.Response[vault.GetAccessHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.GetAccessHistory is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) GetSecretMetadata(context.Context, *connect.Request[vault.GetSecretMetadataRequest]) (*connect.Response[vault.SecretMetadata], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.GetSecretMetadata is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) UpdateSecretMetadata(context.Context, *connect.Request[vault.UpdateSecretMetadataRequest]) (*connect.Response[vault.SecretMetadata], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.UpdateSecretMetadata is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutRetentionPolicy(context.Context, *connect.Request[vault.PutRetentionPolicyRequest]) (*connect.Response[vault.PutRetentionPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutRetentionPolicy is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutRotation(context.Context, *connect.Request[vault.PutRotationRequest]) (*connect.Response[vault.Rotation], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutRotation is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) GetRotation(context.Context, *connect.Request[vault.GetRotationRequest]) (*connect.Response[vault.Rotation], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.GetRotation is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutSecretTopics(context.Context, *connect.Request[vault.PutSecretTopicsRequest]) (*connect.Response[vault.PutSecretTopicsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutSecretTopics is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutWebhook(context.Context, *connect.Request[vault.PutWebhookRequest]) (*connect.Response[vault.PutWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutWebhook is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) DeleteWebhook(context.Context, *connect.Request[vault.DeleteWebhookRequest]) (*connect.Response[vault.DeleteWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.DeleteWebhook is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) ListDeliveries(context.Context, *connect.Request[vault.ListDeliveriesRequest]) (*connect.Response[vault.ListDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.ListDeliveries is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutDatabaseConfig(context.Context, *connect.Request[vault.PutDatabaseConfigRequest]) (*connect.Response[vault.PutDatabaseConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutDatabaseConfig is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutDatabaseRole(context.Context, *connect.Request[vault.PutDatabaseRoleRequest]) (*connect.Response[vault.PutDatabaseRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutDatabaseRole is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) GetDatabaseCredentials(context.Context, *connect.Request[vault.GetDatabaseCredentialsRequest]) (*connect.Response[vault.DatabaseCredentials], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.GetDatabaseCredentials is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) RenewLease(context.Context, *connect.Request[vault.RenewLeaseRequest]) (*connect.Response[vault.Lease], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.RenewLease is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) RevokeLease(context.Context, *connect.Request[vault.RevokeLeaseRequest]) (*connect.Response[vault.RevokeLeaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.RevokeLease is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) RevokePrefix(context.Context, *connect.Request[vault.RevokePrefixRequest]) (*connect.Response[vault.RevokePrefixResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.RevokePrefix is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) ReencryptSecrets(context.Context, *connect.Request[vault.ReencryptSecretsRequest]) (*connect.Response[vault.ReencryptSecretsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.ReencryptSecrets is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) PutPasswordPolicy(context.Context, *connect.Request[vault.PutPasswordPolicyRequest]) (*connect.Response[vault.PutPasswordPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.PutPasswordPolicy is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) GetPasswordPolicy(context.Context, *connect.Request[vault.GetPasswordPolicyRequest]) (*connect.Response[vault.PasswordPolicy], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.GetPasswordPolicy is not implemented"))
+}
+
+func (UnimplementedVaultServiceHandler) GenerateSecret(context.Context, *connect.Request[vault.GenerateSecretRequest]) (*connect.Response[vault.GenerateSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.VaultService.GenerateSecret is not implemented"))
+}
+
+// RotatorServiceClient is a client for the vault.v1.RotatorService service.
+type RotatorServiceClient interface {
+	Rotate(context.Context, *connect.Request[vault.RotateRequest]) (*connect.Response[vault.RotateResponse], error)
+}
+
+// NewRotatorServiceClient constructs a client for the vault.v1.RotatorService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRotatorServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RotatorServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	rotatorServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("RotatorService").Methods()
+	return &rotatorServiceClient{
+		rotate: connect.NewClient[vault.RotateRequest, vault.RotateResponse](
+			httpClient,
+			baseURL+RotatorServiceRotateProcedure,
+			connect.WithSchema(rotatorServiceMethods.ByName("Rotate")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// rotatorServiceClient implements RotatorServiceClient.
+type rotatorServiceClient struct {
+	rotate *connect.Client[vault.RotateRequest, vault.RotateResponse]
+}
+
+// Rotate calls vault.v1.RotatorService.Rotate.
+func (c *rotatorServiceClient) Rotate(ctx context.Context, req *connect.Request[vault.RotateRequest]) (*connect.Response[vault.RotateResponse], error) {
+	return c.rotate.CallUnary(ctx, req)
+}
+
+// RotatorServiceHandler is an implementation of the vault.v1.RotatorService service.
+type RotatorServiceHandler interface {
+	Rotate(context.Context, *connect.Request[vault.RotateRequest]) (*connect.Response[vault.RotateResponse], error)
+}
+
+// NewRotatorServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRotatorServiceHandler(svc RotatorServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	rotatorServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("RotatorService").Methods()
+	rotatorServiceRotateHandler := connect.NewUnaryHandler(
+		RotatorServiceRotateProcedure,
+		svc.Rotate,
+		connect.WithSchema(rotatorServiceMethods.ByName("Rotate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vault.v1.RotatorService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RotatorServiceRotateProcedure:
+			rotatorServiceRotateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRotatorServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRotatorServiceHandler struct{}
+
+func (UnimplementedRotatorServiceHandler) Rotate(context.Context, *connect.Request[vault.RotateRequest]) (*connect.Response[vault.RotateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.RotatorService.Rotate is not implemented"))
+}
+
+// TransitServiceClient is a client for the vault.v1.TransitService service.
+type TransitServiceClient interface {
+	CreateKey(context.Context, *connect.Request[vault.CreateTransitKeyRequest]) (*connect.Response[vault.TransitKey], error)
+	GetKey(context.Context, *connect.Request[vault.GetTransitKeyRequest]) (*connect.Response[vault.TransitKey], error)
+	RotateKey(context.Context, *connect.Request[vault.RotateTransitKeyRequest]) (*connect.Response[vault.TransitKey], error)
+	UpdateKeyConfig(context.Context, *connect.Request[vault.UpdateTransitKeyConfigRequest]) (*connect.Response[vault.TransitKey], error)
+	Encrypt(context.Context, *connect.Request[vault.TransitEncryptRequest]) (*connect.Response[vault.TransitEncryptResponse], error)
+	Decrypt(context.Context, *connect.Request[vault.TransitDecryptRequest]) (*connect.Response[vault.TransitDecryptResponse], error)
+	Rewrap(context.Context, *connect.Request[vault.TransitRewrapRequest]) (*connect.Response[vault.TransitEncryptResponse], error)
+	Sign(context.Context, *connect.Request[vault.TransitSignRequest]) (*connect.Response[vault.TransitSignResponse], error)
+	Verify(context.Context, *connect.Request[vault.TransitVerifyRequest]) (*connect.Response[vault.TransitVerifyResponse], error)
+	HMAC(context.Context, *connect.Request[vault.TransitHMACRequest]) (*connect.Response[vault.TransitHMACResponse], error)
+	GetPublicKey(context.Context, *connect.Request[vault.GetTransitPublicKeyRequest]) (*connect.Response[vault.TransitPublicKey], error)
+}
+
+// NewTransitServiceClient constructs a client for the vault.v1.TransitService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTransitServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TransitServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	transitServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("TransitService").Methods()
+	return &transitServiceClient{
+		createKey: connect.NewClient[vault.CreateTransitKeyRequest, vault.TransitKey](
+			httpClient,
+			baseURL+TransitServiceCreateKeyProcedure,
+			connect.WithSchema(transitServiceMethods.ByName("CreateKey")),
+			connect.WithClientOptions(opts...),
+		),
+		getKey: connect.NewClient[vault.GetTransitKeyRequest, vault.TransitKey](
+			httpClient,
+			baseURL+TransitServiceGetKeyProcedure,
+			connect.WithSchema(transitServiceMethods.ByName("GetKey")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateKey: connect.NewClient[vault.RotateTransitKeyRequest, vault.TransitKey](
+			httpClient,
+			baseURL+TransitServiceRotateKeyProcedure,
+			connect.WithSchema(transitServiceMethods.ByName("RotateKey")),
+			connect.WithClientOptions(opts...),
+		),
+		updateKeyConfig: connect.NewClient[vault.UpdateTransitKeyConfigRequest, vault.TransitKey](
+			httpClient,
+			baseURL+TransitServiceUpdateKeyConfigProcedure,
+			connect.WithSchema(transitServiceMethods.ByName("UpdateKeyConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		encrypt: connect.NewClient[vault.TransitEncryptRequest, vault.TransitEncryptResponse](
+			httpClient,
+			baseURL+TransitServiceEncryptProcedure,
+			connect.WithSchema(transitServiceMethods.ByName("Encrypt")),
+			connect.WithClientOptions(opts...),
+		),
+		decrypt: connect.NewClient[vault.TransitDecryptRequest, vault.TransitDecryptResponse](
+			httpClient,
+			baseURL+TransitServiceDecryptProcedure,
+			connect.WithSchema(transitServiceMethods.ByName("Decrypt")),
+			connect.WithClientOptions(opts...),
+		),
+		rewrap: connect.NewClient[vault.TransitRewrapRequest, vault.TransitEncryptResponse](
+			httpClient,
+			baseURL+TransitServiceRewrapProcedure,
+			connect.WithSchema(transitServiceMethods.ByName("Rewrap")),
+			connect.WithClientOptions(opts...),
+		),
+		sign: connect.NewClient[vault.TransitSignRequest, vault.TransitSignResponse](
+			httpClient,
+			baseURL+TransitServiceSignProcedure,
+			connect.WithSchema(transitServiceMethods.ByName("Sign")),
+			connect.WithClientOptions(opts...),
+		),
+		verify: connect.NewClient[vault.TransitVerifyRequest, vault.TransitVerifyResponse](
+			httpClient,
+			baseURL+TransitServiceVerifyProcedure,
+			connect.WithSchema(transitServiceMethods.ByName("Verify")),
+			connect.WithClientOptions(opts...),
+		),
+		hMAC: connect.NewClient[vault.TransitHMACRequest, vault.TransitHMACResponse](
+			httpClient,
+			baseURL+TransitServiceHMACProcedure,
+			connect.WithSchema(transitServiceMethods.ByName("HMAC")),
+			connect.WithClientOptions(opts...),
+		),
+		getPublicKey: connect.NewClient[vault.GetTransitPublicKeyRequest, vault.TransitPublicKey](
+			httpClient,
+			baseURL+TransitServiceGetPublicKeyProcedure,
+			connect.WithSchema(transitServiceMethods.ByName("GetPublicKey")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// transitServiceClient implements TransitServiceClient.
+type transitServiceClient struct {
+	createKey       *connect.Client[vault.CreateTransitKeyRequest, vault.TransitKey]
+	getKey          *connect.Client[vault.GetTransitKeyRequest, vault.TransitKey]
+	rotateKey       *connect.Client[vault.RotateTransitKeyRequest, vault.TransitKey]
+	updateKeyConfig *connect.Client[vault.UpdateTransitKeyConfigRequest, vault.TransitKey]
+	encrypt         *connect.Client[vault.TransitEncryptRequest, vault.TransitEncryptResponse]
+	decrypt         *connect.Client[vault.TransitDecryptRequest, vault.TransitDecryptResponse]
+	rewrap          *connect.Client[vault.TransitRewrapRequest, vault.TransitEncryptResponse]
+	sign            *connect.Client[vault.TransitSignRequest, vault.TransitSignResponse]
+	verify          *connect.Client[vault.TransitVerifyRequest, vault.TransitVerifyResponse]
+	hMAC            *connect.Client[vault.TransitHMACRequest, vault.TransitHMACResponse]
+	getPublicKey    *connect.Client[vault.GetTransitPublicKeyRequest, vault.TransitPublicKey]
+}
+
+// CreateKey calls vault.v1.TransitService.CreateKey.
+func (c *transitServiceClient) CreateKey(ctx context.Context, req *connect.Request[vault.CreateTransitKeyRequest]) (*connect.Response[vault.TransitKey], error) {
+	return c.createKey.CallUnary(ctx, req)
+}
+
+// GetKey calls vault.v1.TransitService.GetKey.
+func (c *transitServiceClient) GetKey(ctx context.Context, req *connect.Request[vault.GetTransitKeyRequest]) (*connect.Response[vault.TransitKey], error) {
+	return c.getKey.CallUnary(ctx, req)
+}
+
+// RotateKey calls vault.v1.TransitService.RotateKey.
+func (c *transitServiceClient) RotateKey(ctx context.Context, req *connect.Request[vault.RotateTransitKeyRequest]) (*connect.Response[vault.TransitKey], error) {
+	return c.rotateKey.CallUnary(ctx, req)
+}
+
+// UpdateKeyConfig calls vault.v1.TransitService.UpdateKeyConfig.
+func (c *transitServiceClient) UpdateKeyConfig(ctx context.Context, req *connect.Request[vault.UpdateTransitKeyConfigRequest]) (*connect.Response[vault.TransitKey], error) {
+	return c.updateKeyConfig.CallUnary(ctx, req)
+}
+
+// Encrypt calls vault.v1.TransitService.Encrypt.
+func (c *transitServiceClient) Encrypt(ctx context.Context, req *connect.Request[vault.TransitEncryptRequest]) (*connect.Response[vault.TransitEncryptResponse], error) {
+	return c.encrypt.CallUnary(ctx, req)
+}
+
+// Decrypt calls vault.v1.TransitService.Decrypt.
+func (c *transitServiceClient) Decrypt(ctx context.Context, req *connect.Request[vault.TransitDecryptRequest]) (*connect.Response[vault.TransitDecryptResponse], error) {
+	return c.decrypt.CallUnary(ctx, req)
+}
+
+// Rewrap calls vault.v1.TransitService.Rewrap.
+func (c *transitServiceClient) Rewrap(ctx context.Context, req *connect.Request[vault.TransitRewrapRequest]) (*connect.Response[vault.TransitEncryptResponse], error) {
+	return c.rewrap.CallUnary(ctx, req)
+}
+
+// Sign calls vault.v1.TransitService.Sign.
+func (c *transitServiceClient) Sign(ctx context.Context, req *connect.Request[vault.TransitSignRequest]) (*connect.Response[vault.TransitSignResponse], error) {
+	return c.sign.CallUnary(ctx, req)
+}
+
+// Verify calls vault.v1.TransitService.Verify.
+func (c *transitServiceClient) Verify(ctx context.Context, req *connect.Request[vault.TransitVerifyRequest]) (*connect.Response[vault.TransitVerifyResponse], error) {
+	return c.verify.CallUnary(ctx, req)
+}
+
+// HMAC calls vault.v1.TransitService.HMAC.
+func (c *transitServiceClient) HMAC(ctx context.Context, req *connect.Request[vault.TransitHMACRequest]) (*connect.Response[vault.TransitHMACResponse], error) {
+	return c.hMAC.CallUnary(ctx, req)
+}
+
+// GetPublicKey calls vault.v1.TransitService.GetPublicKey.
+func (c *transitServiceClient) GetPublicKey(ctx context.Context, req *connect.Request[vault.GetTransitPublicKeyRequest]) (*connect.Response[vault.TransitPublicKey], error) {
+	return c.getPublicKey.CallUnary(ctx, req)
+}
+
+// TransitServiceHandler is an implementation of the vault.v1.TransitService service.
+type TransitServiceHandler interface {
+	CreateKey(context.Context, *connect.Request[vault.CreateTransitKeyRequest]) (*connect.Response[vault.TransitKey], error)
+	GetKey(context.Context, *connect.Request[vault.GetTransitKeyRequest]) (*connect.Response[vault.TransitKey], error)
+	RotateKey(context.Context, *connect.Request[vault.RotateTransitKeyRequest]) (*connect.Response[vault.TransitKey], error)
+	UpdateKeyConfig(context.Context, *connect.Request[vault.UpdateTransitKeyConfigRequest]) (*connect.Response[vault.TransitKey], error)
+	Encrypt(context.Context, *connect.Request[vault.TransitEncryptRequest]) (*connect.Response[vault.TransitEncryptResponse], error)
+	Decrypt(context.Context, *connect.Request[vault.TransitDecryptRequest]) (*connect.Response[vault.TransitDecryptResponse], error)
+	Rewrap(context.Context, *connect.Request[vault.TransitRewrapRequest]) (*connect.Response[vault.TransitEncryptResponse], error)
+	Sign(context.Context, *connect.Request[vault.TransitSignRequest]) (*connect.Response[vault.TransitSignResponse], error)
+	Verify(context.Context, *connect.Request[vault.TransitVerifyRequest]) (*connect.Response[vault.TransitVerifyResponse], error)
+	HMAC(context.Context, *connect.Request[vault.TransitHMACRequest]) (*connect.Response[vault.TransitHMACResponse], error)
+	GetPublicKey(context.Context, *connect.Request[vault.GetTransitPublicKeyRequest]) (*connect.Response[vault.TransitPublicKey], error)
+}
+
+// NewTransitServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTransitServiceHandler(svc TransitServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	transitServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("TransitService").Methods()
+	transitServiceCreateKeyHandler := connect.NewUnaryHandler(
+		TransitServiceCreateKeyProcedure,
+		svc.CreateKey,
+		connect.WithSchema(transitServiceMethods.ByName("CreateKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transitServiceGetKeyHandler := connect.NewUnaryHandler(
+		TransitServiceGetKeyProcedure,
+		svc.GetKey,
+		connect.WithSchema(transitServiceMethods.ByName("GetKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transitServiceRotateKeyHandler := connect.NewUnaryHandler(
+		TransitServiceRotateKeyProcedure,
+		svc.RotateKey,
+		connect.WithSchema(transitServiceMethods.ByName("RotateKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transitServiceUpdateKeyConfigHandler := connect.NewUnaryHandler(
+		TransitServiceUpdateKeyConfigProcedure,
+		svc.UpdateKeyConfig,
+		connect.WithSchema(transitServiceMethods.ByName("UpdateKeyConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transitServiceEncryptHandler := connect.NewUnaryHandler(
+		TransitServiceEncryptProcedure,
+		svc.Encrypt,
+		connect.WithSchema(transitServiceMethods.ByName("Encrypt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transitServiceDecryptHandler := connect.NewUnaryHandler(
+		TransitServiceDecryptProcedure,
+		svc.Decrypt,
+		connect.WithSchema(transitServiceMethods.ByName("Decrypt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transitServiceRewrapHandler := connect.NewUnaryHandler(
+		TransitServiceRewrapProcedure,
+		svc.Rewrap,
+		connect.WithSchema(transitServiceMethods.ByName("Rewrap")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transitServiceSignHandler := connect.NewUnaryHandler(
+		TransitServiceSignProcedure,
+		svc.Sign,
+		connect.WithSchema(transitServiceMethods.ByName("Sign")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transitServiceVerifyHandler := connect.NewUnaryHandler(
+		TransitServiceVerifyProcedure,
+		svc.Verify,
+		connect.WithSchema(transitServiceMethods.ByName("Verify")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transitServiceHMACHandler := connect.NewUnaryHandler(
+		TransitServiceHMACProcedure,
+		svc.HMAC,
+		connect.WithSchema(transitServiceMethods.ByName("HMAC")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transitServiceGetPublicKeyHandler := connect.NewUnaryHandler(
+		TransitServiceGetPublicKeyProcedure,
+		svc.GetPublicKey,
+		connect.WithSchema(transitServiceMethods.ByName("GetPublicKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vault.v1.TransitService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TransitServiceCreateKeyProcedure:
+			transitServiceCreateKeyHandler.ServeHTTP(w, r)
+		case TransitServiceGetKeyProcedure:
+			transitServiceGetKeyHandler.ServeHTTP(w, r)
+		case TransitServiceRotateKeyProcedure:
+			transitServiceRotateKeyHandler.ServeHTTP(w, r)
+		case TransitServiceUpdateKeyConfigProcedure:
+			transitServiceUpdateKeyConfigHandler.ServeHTTP(w, r)
+		case TransitServiceEncryptProcedure:
+			transitServiceEncryptHandler.ServeHTTP(w, r)
+		case TransitServiceDecryptProcedure:
+			transitServiceDecryptHandler.ServeHTTP(w, r)
+		case TransitServiceRewrapProcedure:
+			transitServiceRewrapHandler.ServeHTTP(w, r)
+		case TransitServiceSignProcedure:
+			transitServiceSignHandler.ServeHTTP(w, r)
+		case TransitServiceVerifyProcedure:
+			transitServiceVerifyHandler.ServeHTTP(w, r)
+		case TransitServiceHMACProcedure:
+			transitServiceHMACHandler.ServeHTTP(w, r)
+		case TransitServiceGetPublicKeyProcedure:
+			transitServiceGetPublicKeyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTransitServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTransitServiceHandler struct{}
+
+func (UnimplementedTransitServiceHandler) CreateKey(context.Context, *connect.Request[vault.CreateTransitKeyRequest]) (*connect.Response[vault.TransitKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.TransitService.CreateKey is not implemented"))
+}
+
+func (UnimplementedTransitServiceHandler) GetKey(context.Context, *connect.Request[vault.GetTransitKeyRequest]) (*connect.Response[vault.TransitKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.TransitService.GetKey is not implemented"))
+}
+
+func (UnimplementedTransitServiceHandler) RotateKey(context.Context, *connect.Request[vault.RotateTransitKeyRequest]) (*connect.Response[vault.TransitKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.TransitService.RotateKey is not implemented"))
+}
+
+func (UnimplementedTransitServiceHandler) UpdateKeyConfig(context.Context, *connect.Request[vault.UpdateTransitKeyConfigRequest]) (*connect.Response[vault.TransitKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.TransitService.UpdateKeyConfig is not implemented"))
+}
+
+func (UnimplementedTransitServiceHandler) Encrypt(context.Context, *connect.Request[vault.TransitEncryptRequest]) (*connect.Response[vault.TransitEncryptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.TransitService.Encrypt is not implemented"))
+}
+
+func (UnimplementedTransitServiceHandler) Decrypt(context.Context, *connect.Request[vault.TransitDecryptRequest]) (*connect.Response[vault.TransitDecryptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.TransitService.Decrypt is not implemented"))
+}
+
+func (UnimplementedTransitServiceHandler) Rewrap(context.Context, *connect.Request[vault.TransitRewrapRequest]) (*connect.Response[vault.TransitEncryptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.TransitService.Rewrap is not implemented"))
+}
+
+func (UnimplementedTransitServiceHandler) Sign(context.Context, *connect.Request[vault.TransitSignRequest]) (*connect.Response[vault.TransitSignResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.TransitService.Sign is not implemented"))
+}
+
+func (UnimplementedTransitServiceHandler) Verify(context.Context, *connect.Request[vault.TransitVerifyRequest]) (*connect.Response[vault.TransitVerifyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.TransitService.Verify is not implemented"))
+}
+
+func (UnimplementedTransitServiceHandler) HMAC(context.Context, *connect.Request[vault.TransitHMACRequest]) (*connect.Response[vault.TransitHMACResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.TransitService.HMAC is not implemented"))
+}
+
+func (UnimplementedTransitServiceHandler) GetPublicKey(context.Context, *connect.Request[vault.GetTransitPublicKeyRequest]) (*connect.Response[vault.TransitPublicKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.TransitService.GetPublicKey is not implemented"))
+}
+
+// PKIServiceClient is a client for the vault.v1.PKIService service.
+type PKIServiceClient interface {
+	GenerateRoot(context.Context, *connect.Request[vault.GenerateRootRequest]) (*connect.Response[vault.CACertificate], error)
+	GenerateIntermediate(context.Context, *connect.Request[vault.GenerateIntermediateRequest]) (*connect.Response[vault.CACertificate], error)
+	GetCA(context.Context, *connect.Request[vault.GetCARequest]) (*connect.Response[vault.CACertificate], error)
+	PutRole(context.Context, *connect.Request[vault.PKIRole]) (*connect.Response[vault.PutPKIRoleResponse], error)
+	GetRole(context.Context, *connect.Request[vault.GetPKIRoleRequest]) (*connect.Response[vault.PKIRole], error)
+	IssueCertificate(context.Context, *connect.Request[vault.IssueCertificateRequest]) (*connect.Response[vault.IssuedCertificate], error)
+	SignCertificate(context.Context, *connect.Request[vault.SignCertificateRequest]) (*connect.Response[vault.IssuedCertificate], error)
+	RevokeCertificate(context.Context, *connect.Request[vault.RevokeCertificateRequest]) (*connect.Response[vault.RevokeCertificateResponse], error)
+	GetCRL(context.Context, *connect.Request[vault.GetCRLRequest]) (*connect.Response[vault.CRL], error)
+}
+
+// NewPKIServiceClient constructs a client for the vault.v1.PKIService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPKIServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PKIServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	pKIServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("PKIService").Methods()
+	return &pKIServiceClient{
+		generateRoot: connect.NewClient[vault.GenerateRootRequest, vault.CACertificate](
+			httpClient,
+			baseURL+PKIServiceGenerateRootProcedure,
+			connect.WithSchema(pKIServiceMethods.ByName("GenerateRoot")),
+			connect.WithClientOptions(opts...),
+		),
+		generateIntermediate: connect.NewClient[vault.GenerateIntermediateRequest, vault.CACertificate](
+			httpClient,
+			baseURL+PKIServiceGenerateIntermediateProcedure,
+			connect.WithSchema(pKIServiceMethods.ByName("GenerateIntermediate")),
+			connect.WithClientOptions(opts...),
+		),
+		getCA: connect.NewClient[vault.GetCARequest, vault.CACertificate](
+			httpClient,
+			baseURL+PKIServiceGetCAProcedure,
+			connect.WithSchema(pKIServiceMethods.ByName("GetCA")),
+			connect.WithClientOptions(opts...),
+		),
+		putRole: connect.NewClient[vault.PKIRole, vault.PutPKIRoleResponse](
+			httpClient,
+			baseURL+PKIServicePutRoleProcedure,
+			connect.WithSchema(pKIServiceMethods.ByName("PutRole")),
+			connect.WithClientOptions(opts...),
+		),
+		getRole: connect.NewClient[vault.GetPKIRoleRequest, vault.PKIRole](
+			httpClient,
+			baseURL+PKIServiceGetRoleProcedure,
+			connect.WithSchema(pKIServiceMethods.ByName("GetRole")),
+			connect.WithClientOptions(opts...),
+		),
+		issueCertificate: connect.NewClient[vault.IssueCertificateRequest, vault.IssuedCertificate](
+			httpClient,
+			baseURL+PKIServiceIssueCertificateProcedure,
+			connect.WithSchema(pKIServiceMethods.ByName("IssueCertificate")),
+			connect.WithClientOptions(opts...),
+		),
+		signCertificate: connect.NewClient[vault.SignCertificateRequest, vault.IssuedCertificate](
+			httpClient,
+			baseURL+PKIServiceSignCertificateProcedure,
+			connect.WithSchema(pKIServiceMethods.ByName("SignCertificate")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeCertificate: connect.NewClient[vault.RevokeCertificateRequest, vault.RevokeCertificateResponse](
+			httpClient,
+			baseURL+PKIServiceRevokeCertificateProcedure,
+			connect.WithSchema(pKIServiceMethods.ByName("RevokeCertificate")),
+			connect.WithClientOptions(opts...),
+		),
+		getCRL: connect.NewClient[vault.GetCRLRequest, vault.CRL](
+			httpClient,
+			baseURL+PKIServiceGetCRLProcedure,
+			connect.WithSchema(pKIServiceMethods.ByName("GetCRL")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// pKIServiceClient implements PKIServiceClient.
+type pKIServiceClient struct {
+	generateRoot         *connect.Client[vault.GenerateRootRequest, vault.CACertificate]
+	generateIntermediate *connect.Client[vault.GenerateIntermediateRequest, vault.CACertificate]
+	getCA                *connect.Client[vault.GetCARequest, vault.CACertificate]
+	putRole              *connect.Client[vault.PKIRole, vault.PutPKIRoleResponse]
+	getRole              *connect.Client[vault.GetPKIRoleRequest, vault.PKIRole]
+	issueCertificate     *connect.Client[vault.IssueCertificateRequest, vault.IssuedCertificate]
+	signCertificate      *connect.Client[vault.SignCertificateRequest, vault.IssuedCertificate]
+	revokeCertificate    *connect.Client[vault.RevokeCertificateRequest, vault.RevokeCertificateResponse]
+	getCRL               *connect.Client[vault.GetCRLRequest, vault.CRL]
+}
+
+// GenerateRoot calls vault.v1.PKIService.GenerateRoot.
+func (c *pKIServiceClient) GenerateRoot(ctx context.Context, req *connect.Request[vault.GenerateRootRequest]) (*connect.Response[vault.CACertificate], error) {
+	return c.generateRoot.CallUnary(ctx, req)
+}
+
+// GenerateIntermediate calls vault.v1.PKIService.GenerateIntermediate.
+func (c *pKIServiceClient) GenerateIntermediate(ctx context.Context, req *connect.Request[vault.GenerateIntermediateRequest]) (*connect.Response[vault.CACertificate], error) {
+	return c.generateIntermediate.CallUnary(ctx, req)
+}
+
+// GetCA calls vault.v1.PKIService.GetCA.
+func (c *pKIServiceClient) GetCA(ctx context.Context, req *connect.Request[vault.GetCARequest]) (*connect.Response[vault.CACertificate], error) {
+	return c.getCA.CallUnary(ctx, req)
+}
+
+// PutRole calls vault.v1.PKIService.PutRole.
+func (c *pKIServiceClient) PutRole(ctx context.Context, req *connect.Request[vault.PKIRole]) (*connect.Response[vault.PutPKIRoleResponse], error) {
+	return c.putRole.CallUnary(ctx, req)
+}
+
+// GetRole calls vault.v1.PKIService.GetRole.
+func (c *pKIServiceClient) GetRole(ctx context.Context, req *connect.Request[vault.GetPKIRoleRequest]) (*connect.Response[vault.PKIRole], error) {
+	return c.getRole.CallUnary(ctx, req)
+}
+
+// IssueCertificate calls vault.v1.PKIService.IssueCertificate.
+func (c *pKIServiceClient) IssueCertificate(ctx context.Context, req *connect.Request[vault.IssueCertificateRequest]) (*connect.Response[vault.IssuedCertificate], error) {
+	return c.issueCertificate.CallUnary(ctx, req)
+}
+
+// SignCertificate calls vault.v1.PKIService.SignCertificate.
+func (c *pKIServiceClient) SignCertificate(ctx context.Context, req *connect.Request[vault.SignCertificateRequest]) (*connect.Response[vault.IssuedCertificate], error) {
+	return c.signCertificate.CallUnary(ctx, req)
+}
+
+// RevokeCertificate calls vault.v1.PKIService.RevokeCertificate.
+func (c *pKIServiceClient) RevokeCertificate(ctx context.Context, req *connect.Request[vault.RevokeCertificateRequest]) (*connect.Response[vault.RevokeCertificateResponse], error) {
+	return c.revokeCertificate.CallUnary(ctx, req)
+}
+
+// GetCRL calls vault.v1.PKIService.GetCRL.
+func (c *pKIServiceClient) GetCRL(ctx context.Context, req *connect.Request[vault.GetCRLRequest]) (*connect.Response[vault.CRL], error) {
+	return c.getCRL.CallUnary(ctx, req)
+}
+
+// PKIServiceHandler is an implementation of the vault.v1.PKIService service.
+type PKIServiceHandler interface {
+	GenerateRoot(context.Context, *connect.Request[vault.GenerateRootRequest]) (*connect.Response[vault.CACertificate], error)
+	GenerateIntermediate(context.Context, *connect.Request[vault.GenerateIntermediateRequest]) (*connect.Response[vault.CACertificate], error)
+	GetCA(context.Context, *connect.Request[vault.GetCARequest]) (*connect.Response[vault.CACertificate], error)
+	PutRole(context.Context, *connect.Request[vault.PKIRole]) (*connect.Response[vault.PutPKIRoleResponse], error)
+	GetRole(context.Context, *connect.Request[vault.GetPKIRoleRequest]) (*connect.Response[vault.PKIRole], error)
+	IssueCertificate(context.Context, *connect.Request[vault.IssueCertificateRequest]) (*connect.Response[vault.IssuedCertificate], error)
+	SignCertificate(context.Context, *connect.Request[vault.SignCertificateRequest]) (*connect.Response[vault.IssuedCertificate], error)
+	RevokeCertificate(context.Context, *connect.Request[vault.RevokeCertificateRequest]) (*connect.Response[vault.RevokeCertificateResponse], error)
+	GetCRL(context.Context, *connect.Request[vault.GetCRLRequest]) (*connect.Response[vault.CRL], error)
+}
+
+// NewPKIServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPKIServiceHandler(svc PKIServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	pKIServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("PKIService").Methods()
+	pKIServiceGenerateRootHandler := connect.NewUnaryHandler(
+		PKIServiceGenerateRootProcedure,
+		svc.GenerateRoot,
+		connect.WithSchema(pKIServiceMethods.ByName("GenerateRoot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pKIServiceGenerateIntermediateHandler := connect.NewUnaryHandler(
+		PKIServiceGenerateIntermediateProcedure,
+		svc.GenerateIntermediate,
+		connect.WithSchema(pKIServiceMethods.ByName("GenerateIntermediate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pKIServiceGetCAHandler := connect.NewUnaryHandler(
+		PKIServiceGetCAProcedure,
+		svc.GetCA,
+		connect.WithSchema(pKIServiceMethods.ByName("GetCA")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pKIServicePutRoleHandler := connect.NewUnaryHandler(
+		PKIServicePutRoleProcedure,
+		svc.PutRole,
+		connect.WithSchema(pKIServiceMethods.ByName("PutRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pKIServiceGetRoleHandler := connect.NewUnaryHandler(
+		PKIServiceGetRoleProcedure,
+		svc.GetRole,
+		connect.WithSchema(pKIServiceMethods.ByName("GetRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pKIServiceIssueCertificateHandler := connect.NewUnaryHandler(
+		PKIServiceIssueCertificateProcedure,
+		svc.IssueCertificate,
+		connect.WithSchema(pKIServiceMethods.ByName("IssueCertificate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pKIServiceSignCertificateHandler := connect.NewUnaryHandler(
+		PKIServiceSignCertificateProcedure,
+		svc.SignCertificate,
+		connect.WithSchema(pKIServiceMethods.ByName("SignCertificate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pKIServiceRevokeCertificateHandler := connect.NewUnaryHandler(
+		PKIServiceRevokeCertificateProcedure,
+		svc.RevokeCertificate,
+		connect.WithSchema(pKIServiceMethods.ByName("RevokeCertificate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	pKIServiceGetCRLHandler := connect.NewUnaryHandler(
+		PKIServiceGetCRLProcedure,
+		svc.GetCRL,
+		connect.WithSchema(pKIServiceMethods.ByName("GetCRL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vault.v1.PKIService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PKIServiceGenerateRootProcedure:
+			pKIServiceGenerateRootHandler.ServeHTTP(w, r)
+		case PKIServiceGenerateIntermediateProcedure:
+			pKIServiceGenerateIntermediateHandler.ServeHTTP(w, r)
+		case PKIServiceGetCAProcedure:
+			pKIServiceGetCAHandler.ServeHTTP(w, r)
+		case PKIServicePutRoleProcedure:
+			pKIServicePutRoleHandler.ServeHTTP(w, r)
+		case PKIServiceGetRoleProcedure:
+			pKIServiceGetRoleHandler.ServeHTTP(w, r)
+		case PKIServiceIssueCertificateProcedure:
+			pKIServiceIssueCertificateHandler.ServeHTTP(w, r)
+		case PKIServiceSignCertificateProcedure:
+			pKIServiceSignCertificateHandler.ServeHTTP(w, r)
+		case PKIServiceRevokeCertificateProcedure:
+			pKIServiceRevokeCertificateHandler.ServeHTTP(w, r)
+		case PKIServiceGetCRLProcedure:
+			pKIServiceGetCRLHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPKIServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPKIServiceHandler struct{}
+
+func (UnimplementedPKIServiceHandler) GenerateRoot(context.Context, *connect.Request[vault.GenerateRootRequest]) (*connect.Response[vault.CACertificate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.PKIService.GenerateRoot is not implemented"))
+}
+
+func (UnimplementedPKIServiceHandler) GenerateIntermediate(context.Context, *connect.Request[vault.GenerateIntermediateRequest]) (*connect.Response[vault.CACertificate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.PKIService.GenerateIntermediate is not implemented"))
+}
+
+func (UnimplementedPKIServiceHandler) GetCA(context.Context, *connect.Request[vault.GetCARequest]) (*connect.Response[vault.CACertificate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.PKIService.GetCA is not implemented"))
+}
+
+func (UnimplementedPKIServiceHandler) PutRole(context.Context, *connect.Request[vault.PKIRole]) (*connect.Response[vault.PutPKIRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.PKIService.PutRole is not implemented"))
+}
+
+func (UnimplementedPKIServiceHandler) GetRole(context.Context, *connect.Request[vault.GetPKIRoleRequest]) (*connect.Response[vault.PKIRole], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.PKIService.GetRole is not implemented"))
+}
+
+func (UnimplementedPKIServiceHandler) IssueCertificate(context.Context, *connect.Request[vault.IssueCertificateRequest]) (*connect.Response[vault.IssuedCertificate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.PKIService.IssueCertificate is not implemented"))
+}
+
+func (UnimplementedPKIServiceHandler) SignCertificate(context.Context, *connect.Request[vault.SignCertificateRequest]) (*connect.Response[vault.IssuedCertificate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.PKIService.SignCertificate is not implemented"))
+}
+
+func (UnimplementedPKIServiceHandler) RevokeCertificate(context.Context, *connect.Request[vault.RevokeCertificateRequest]) (*connect.Response[vault.RevokeCertificateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.PKIService.RevokeCertificate is not implemented"))
+}
+
+func (UnimplementedPKIServiceHandler) GetCRL(context.Context, *connect.Request[vault.GetCRLRequest]) (*connect.Response[vault.CRL], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.PKIService.GetCRL is not implemented"))
+}
+
+// SSHServiceClient is a client for the vault.v1.SSHService service.
+type SSHServiceClient interface {
+	GenerateCA(context.Context, *connect.Request[vault.GenerateSSHCARequest]) (*connect.Response[vault.SSHCAPublicKey], error)
+	GetCAPublicKey(context.Context, *connect.Request[vault.GetSSHCAPublicKeyRequest]) (*connect.Response[vault.SSHCAPublicKey], error)
+	PutRole(context.Context, *connect.Request[vault.SSHRole]) (*connect.Response[vault.PutSSHRoleResponse], error)
+	GetRole(context.Context, *connect.Request[vault.GetSSHRoleRequest]) (*connect.Response[vault.SSHRole], error)
+	SignSSHKey(context.Context, *connect.Request[vault.SignSSHKeyRequest]) (*connect.Response[vault.SignedSSHKey], error)
+}
+
+// NewSSHServiceClient constructs a client for the vault.v1.SSHService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSSHServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SSHServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	sSHServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("SSHService").Methods()
+	return &sSHServiceClient{
+		generateCA: connect.NewClient[vault.GenerateSSHCARequest, vault.SSHCAPublicKey](
+			httpClient,
+			baseURL+SSHServiceGenerateCAProcedure,
+			connect.WithSchema(sSHServiceMethods.ByName("GenerateCA")),
+			connect.WithClientOptions(opts...),
+		),
+		getCAPublicKey: connect.NewClient[vault.GetSSHCAPublicKeyRequest, vault.SSHCAPublicKey](
+			httpClient,
+			baseURL+SSHServiceGetCAPublicKeyProcedure,
+			connect.WithSchema(sSHServiceMethods.ByName("GetCAPublicKey")),
+			connect.WithClientOptions(opts...),
+		),
+		putRole: connect.NewClient[vault.SSHRole, vault.PutSSHRoleResponse](
+			httpClient,
+			baseURL+SSHServicePutRoleProcedure,
+			connect.WithSchema(sSHServiceMethods.ByName("PutRole")),
+			connect.WithClientOptions(opts...),
+		),
+		getRole: connect.NewClient[vault.GetSSHRoleRequest, vault.SSHRole](
+			httpClient,
+			baseURL+SSHServiceGetRoleProcedure,
+			connect.WithSchema(sSHServiceMethods.ByName("GetRole")),
+			connect.WithClientOptions(opts...),
+		),
+		signSSHKey: connect.NewClient[vault.SignSSHKeyRequest, vault.SignedSSHKey](
+			httpClient,
+			baseURL+SSHServiceSignSSHKeyProcedure,
+			connect.WithSchema(sSHServiceMethods.ByName("SignSSHKey")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// sSHServiceClient implements SSHServiceClient.
+type sSHServiceClient struct {
+	generateCA     *connect.Client[vault.GenerateSSHCARequest, vault.SSHCAPublicKey]
+	getCAPublicKey *connect.Client[vault.GetSSHCAPublicKeyRequest, vault.SSHCAPublicKey]
+	putRole        *connect.Client[vault.SSHRole, vault.PutSSHRoleResponse]
+	getRole        *connect.Client[vault.GetSSHRoleRequest, vault.SSHRole]
+	signSSHKey     *connect.Client[vault.SignSSHKeyRequest, vault.SignedSSHKey]
+}
+
+// GenerateCA calls vault.v1.SSHService.GenerateCA.
+func (c *sSHServiceClient) GenerateCA(ctx context.Context, req *connect.Request[vault.GenerateSSHCARequest]) (*connect.Response[vault.SSHCAPublicKey], error) {
+	return c.generateCA.CallUnary(ctx, req)
+}
+
+// GetCAPublicKey calls vault.v1.SSHService.GetCAPublicKey.
+func (c *sSHServiceClient) GetCAPublicKey(ctx context.Context, req *connect.Request[vault.GetSSHCAPublicKeyRequest]) (*connect.Response[vault.SSHCAPublicKey], error) {
+	return c.getCAPublicKey.CallUnary(ctx, req)
+}
+
+// PutRole calls vault.v1.SSHService.PutRole.
+func (c *sSHServiceClient) PutRole(ctx context.Context, req *connect.Request[vault.SSHRole]) (*connect.Response[vault.PutSSHRoleResponse], error) {
+	return c.putRole.CallUnary(ctx, req)
+}
+
+// GetRole calls vault.v1.SSHService.GetRole.
+func (c *sSHServiceClient) GetRole(ctx context.Context, req *connect.Request[vault.GetSSHRoleRequest]) (*connect.Response[vault.SSHRole], error) {
+	return c.getRole.CallUnary(ctx, req)
+}
+
+// SignSSHKey calls vault.v1.SSHService.SignSSHKey.
+func (c *sSHServiceClient) SignSSHKey(ctx context.Context, req *connect.Request[vault.SignSSHKeyRequest]) (*connect.Response[vault.SignedSSHKey], error) {
+	return c.signSSHKey.CallUnary(ctx, req)
+}
+
+// SSHServiceHandler is an implementation of the vault.v1.SSHService service.
+type SSHServiceHandler interface {
+	GenerateCA(context.Context, *connect.Request[vault.GenerateSSHCARequest]) (*connect.Response[vault.SSHCAPublicKey], error)
+	GetCAPublicKey(context.Context, *connect.Request[vault.GetSSHCAPublicKeyRequest]) (*connect.Response[vault.SSHCAPublicKey], error)
+	PutRole(context.Context, *connect.Request[vault.SSHRole]) (*connect.Response[vault.PutSSHRoleResponse], error)
+	GetRole(context.Context, *connect.Request[vault.GetSSHRoleRequest]) (*connect.Response[vault.SSHRole], error)
+	SignSSHKey(context.Context, *connect.Request[vault.SignSSHKeyRequest]) (*connect.Response[vault.SignedSSHKey], error)
+}
+
+// NewSSHServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSSHServiceHandler(svc SSHServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	sSHServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("SSHService").Methods()
+	sSHServiceGenerateCAHandler := connect.NewUnaryHandler(
+		SSHServiceGenerateCAProcedure,
+		svc.GenerateCA,
+		connect.WithSchema(sSHServiceMethods.ByName("GenerateCA")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sSHServiceGetCAPublicKeyHandler := connect.NewUnaryHandler(
+		SSHServiceGetCAPublicKeyProcedure,
+		svc.GetCAPublicKey,
+		connect.WithSchema(sSHServiceMethods.ByName("GetCAPublicKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sSHServicePutRoleHandler := connect.NewUnaryHandler(
+		SSHServicePutRoleProcedure,
+		svc.PutRole,
+		connect.WithSchema(sSHServiceMethods.ByName("PutRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sSHServiceGetRoleHandler := connect.NewUnaryHandler(
+		SSHServiceGetRoleProcedure,
+		svc.GetRole,
+		connect.WithSchema(sSHServiceMethods.ByName("GetRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sSHServiceSignSSHKeyHandler := connect.NewUnaryHandler(
+		SSHServiceSignSSHKeyProcedure,
+		svc.SignSSHKey,
+		connect.WithSchema(sSHServiceMethods.ByName("SignSSHKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vault.v1.SSHService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SSHServiceGenerateCAProcedure:
+			sSHServiceGenerateCAHandler.ServeHTTP(w, r)
+		case SSHServiceGetCAPublicKeyProcedure:
+			sSHServiceGetCAPublicKeyHandler.ServeHTTP(w, r)
+		case SSHServicePutRoleProcedure:
+			sSHServicePutRoleHandler.ServeHTTP(w, r)
+		case SSHServiceGetRoleProcedure:
+			sSHServiceGetRoleHandler.ServeHTTP(w, r)
+		case SSHServiceSignSSHKeyProcedure:
+			sSHServiceSignSSHKeyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSSHServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSSHServiceHandler struct{}
+
+func (UnimplementedSSHServiceHandler) GenerateCA(context.Context, *connect.Request[vault.GenerateSSHCARequest]) (*connect.Response[vault.SSHCAPublicKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.SSHService.GenerateCA is not implemented"))
+}
+
+func (UnimplementedSSHServiceHandler) GetCAPublicKey(context.Context, *connect.Request[vault.GetSSHCAPublicKeyRequest]) (*connect.Response[vault.SSHCAPublicKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.SSHService.GetCAPublicKey is not implemented"))
+}
+
+func (UnimplementedSSHServiceHandler) PutRole(context.Context, *connect.Request[vault.SSHRole]) (*connect.Response[vault.PutSSHRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.SSHService.PutRole is not implemented"))
+}
+
+func (UnimplementedSSHServiceHandler) GetRole(context.Context, *connect.Request[vault.GetSSHRoleRequest]) (*connect.Response[vault.SSHRole], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.SSHService.GetRole is not implemented"))
+}
+
+func (UnimplementedSSHServiceHandler) SignSSHKey(context.Context, *connect.Request[vault.SignSSHKeyRequest]) (*connect.Response[vault.SignedSSHKey], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.SSHService.SignSSHKey is not implemented"))
+}
+
+// OIDCServiceClient is a client for the vault.v1.OIDCService service.
+type OIDCServiceClient interface {
+	PutConfig(context.Context, *connect.Request[vault.OIDCConfig]) (*connect.Response[vault.PutOIDCConfigResponse], error)
+	PutRole(context.Context, *connect.Request[vault.OIDCRole]) (*connect.Response[vault.PutOIDCRoleResponse], error)
+	GetRole(context.Context, *connect.Request[vault.GetOIDCRoleRequest]) (*connect.Response[vault.OIDCRole], error)
+	GenerateToken(context.Context, *connect.Request[vault.GenerateIdentityTokenRequest]) (*connect.Response[vault.IdentityToken], error)
+	RotateKey(context.Context, *connect.Request[vault.RotateOIDCKeyRequest]) (*connect.Response[vault.RotateOIDCKeyResponse], error)
+}
+
+// NewOIDCServiceClient constructs a client for the vault.v1.OIDCService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOIDCServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OIDCServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	oIDCServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("OIDCService").Methods()
+	return &oIDCServiceClient{
+		putConfig: connect.NewClient[vault.OIDCConfig, vault.PutOIDCConfigResponse](
+			httpClient,
+			baseURL+OIDCServicePutConfigProcedure,
+			connect.WithSchema(oIDCServiceMethods.ByName("PutConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		putRole: connect.NewClient[vault.OIDCRole, vault.PutOIDCRoleResponse](
+			httpClient,
+			baseURL+OIDCServicePutRoleProcedure,
+			connect.WithSchema(oIDCServiceMethods.ByName("PutRole")),
+			connect.WithClientOptions(opts...),
+		),
+		getRole: connect.NewClient[vault.GetOIDCRoleRequest, vault.OIDCRole](
+			httpClient,
+			baseURL+OIDCServiceGetRoleProcedure,
+			connect.WithSchema(oIDCServiceMethods.ByName("GetRole")),
+			connect.WithClientOptions(opts...),
+		),
+		generateToken: connect.NewClient[vault.GenerateIdentityTokenRequest, vault.IdentityToken](
+			httpClient,
+			baseURL+OIDCServiceGenerateTokenProcedure,
+			connect.WithSchema(oIDCServiceMethods.ByName("GenerateToken")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateKey: connect.NewClient[vault.RotateOIDCKeyRequest, vault.RotateOIDCKeyResponse](
+			httpClient,
+			baseURL+OIDCServiceRotateKeyProcedure,
+			connect.WithSchema(oIDCServiceMethods.ByName("RotateKey")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// oIDCServiceClient implements OIDCServiceClient.
+type oIDCServiceClient struct {
+	putConfig     *connect.Client[vault.OIDCConfig, vault.PutOIDCConfigResponse]
+	putRole       *connect.Client[vault.OIDCRole, vault.PutOIDCRoleResponse]
+	getRole       *connect.Client[vault.GetOIDCRoleRequest, vault.OIDCRole]
+	generateToken *connect.Client[vault.GenerateIdentityTokenRequest, vault.IdentityToken]
+	rotateKey     *connect.Client[vault.RotateOIDCKeyRequest, vault.RotateOIDCKeyResponse]
+}
+
+// PutConfig calls vault.v1.OIDCService.PutConfig.
+func (c *oIDCServiceClient) PutConfig(ctx context.Context, req *connect.Request[vault.OIDCConfig]) (*connect.Response[vault.PutOIDCConfigResponse], error) {
+	return c.putConfig.CallUnary(ctx, req)
+}
+
+// PutRole calls vault.v1.OIDCService.PutRole.
+func (c *oIDCServiceClient) PutRole(ctx context.Context, req *connect.Request[vault.OIDCRole]) (*connect.Response[vault.PutOIDCRoleResponse], error) {
+	return c.putRole.CallUnary(ctx, req)
+}
+
+// GetRole calls vault.v1.OIDCService.GetRole.
+func (c *oIDCServiceClient) GetRole(ctx context.Context, req *connect.Request[vault.GetOIDCRoleRequest]) (*connect.Response[vault.OIDCRole], error) {
+	return c.getRole.CallUnary(ctx, req)
+}
+
+// GenerateToken calls vault.v1.OIDCService.GenerateToken.
+func (c *oIDCServiceClient) GenerateToken(ctx context.Context, req *connect.Request[vault.GenerateIdentityTokenRequest]) (*connect.Response[vault.IdentityToken], error) {
+	return c.generateToken.CallUnary(ctx, req)
+}
+
+// RotateKey calls vault.v1.OIDCService.RotateKey.
+func (c *oIDCServiceClient) RotateKey(ctx context.Context, req *connect.Request[vault.RotateOIDCKeyRequest]) (*connect.Response[vault.RotateOIDCKeyResponse], error) {
+	return c.rotateKey.CallUnary(ctx, req)
+}
+
+// OIDCServiceHandler is an implementation of the vault.v1.OIDCService service.
+type OIDCServiceHandler interface {
+	PutConfig(context.Context, *connect.Request[vault.OIDCConfig]) (*connect.Response[vault.PutOIDCConfigResponse], error)
+	PutRole(context.Context, *connect.Request[vault.OIDCRole]) (*connect.Response[vault.PutOIDCRoleResponse], error)
+	GetRole(context.Context, *connect.Request[vault.GetOIDCRoleRequest]) (*connect.Response[vault.OIDCRole], error)
+	GenerateToken(context.Context, *connect.Request[vault.GenerateIdentityTokenRequest]) (*connect.Response[vault.IdentityToken], error)
+	RotateKey(context.Context, *connect.Request[vault.RotateOIDCKeyRequest]) (*connect.Response[vault.RotateOIDCKeyResponse], error)
+}
+
+// NewOIDCServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOIDCServiceHandler(svc OIDCServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	oIDCServiceMethods := vault.File_v1_vault_vault_proto.Services().ByName("OIDCService").Methods()
+	oIDCServicePutConfigHandler := connect.NewUnaryHandler(
+		OIDCServicePutConfigProcedure,
+		svc.PutConfig,
+		connect.WithSchema(oIDCServiceMethods.ByName("PutConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oIDCServicePutRoleHandler := connect.NewUnaryHandler(
+		OIDCServicePutRoleProcedure,
+		svc.PutRole,
+		connect.WithSchema(oIDCServiceMethods.ByName("PutRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oIDCServiceGetRoleHandler := connect.NewUnaryHandler(
+		OIDCServiceGetRoleProcedure,
+		svc.GetRole,
+		connect.WithSchema(oIDCServiceMethods.ByName("GetRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oIDCServiceGenerateTokenHandler := connect.NewUnaryHandler(
+		OIDCServiceGenerateTokenProcedure,
+		svc.GenerateToken,
+		connect.WithSchema(oIDCServiceMethods.ByName("GenerateToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oIDCServiceRotateKeyHandler := connect.NewUnaryHandler(
+		OIDCServiceRotateKeyProcedure,
+		svc.RotateKey,
+		connect.WithSchema(oIDCServiceMethods.ByName("RotateKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vault.v1.OIDCService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OIDCServicePutConfigProcedure:
+			oIDCServicePutConfigHandler.ServeHTTP(w, r)
+		case OIDCServicePutRoleProcedure:
+			oIDCServicePutRoleHandler.ServeHTTP(w, r)
+		case OIDCServiceGetRoleProcedure:
+			oIDCServiceGetRoleHandler.ServeHTTP(w, r)
+		case OIDCServiceGenerateTokenProcedure:
+			oIDCServiceGenerateTokenHandler.ServeHTTP(w, r)
+		case OIDCServiceRotateKeyProcedure:
+			oIDCServiceRotateKeyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOIDCServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOIDCServiceHandler struct{}
+
+func (UnimplementedOIDCServiceHandler) PutConfig(context.Context, *connect.Request[vault.OIDCConfig]) (*connect.Response[vault.PutOIDCConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.OIDCService.PutConfig is not implemented"))
+}
+
+func (UnimplementedOIDCServiceHandler) PutRole(context.Context, *connect.Request[vault.OIDCRole]) (*connect.Response[vault.PutOIDCRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.OIDCService.PutRole is not implemented"))
+}
+
+func (UnimplementedOIDCServiceHandler) GetRole(context.Context, *connect.Request[vault.GetOIDCRoleRequest]) (*connect.Response[vault.OIDCRole], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.OIDCService.GetRole is not implemented"))
+}
+
+func (UnimplementedOIDCServiceHandler) GenerateToken(context.Context, *connect.Request[vault.GenerateIdentityTokenRequest]) (*connect.Response[vault.IdentityToken], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.OIDCService.GenerateToken is not implemented"))
+}
+
+func (UnimplementedOIDCServiceHandler) RotateKey(context.Context, *connect.Request[vault.RotateOIDCKeyRequest]) (*connect.Response[vault.RotateOIDCKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vault.v1.OIDCService.RotateKey is not implemented"))
+}
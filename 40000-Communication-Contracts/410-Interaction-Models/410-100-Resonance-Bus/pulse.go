@@ -0,0 +1,104 @@
+// Package resonance carries VaultManager's Semantic Pulses onto the
+// Resonance Bus. The wire format is the vault_pulse grammar in
+// VAULT_PULSE.jebnf.
+package resonance
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kind names the vault event a pulse announces.
+type Kind string
+
+const (
+	SecretChanged  Kind = "SECRET-CHANGED"
+	PolicyReloaded Kind = "POLICY-RELOADED"
+	Sealed         Kind = "SEALED"
+	Unsealed       Kind = "UNSEALED"
+	ReplicationLag Kind = "REPLICATION-LAG"
+)
+
+var kinds = []Kind{SecretChanged, PolicyReloaded, Sealed, Unsealed, ReplicationLag}
+
+// Pulse is one vault_pulse packet.
+type Pulse struct {
+	Source  string
+	Seq     uint64
+	Kind    Kind
+	Time    time.Time
+	Subject string
+	Detail  string
+}
+
+// IntentHash identifies what the pulse announces, independent of which
+// node sent it and when.
+func (p Pulse) IntentHash() string {
+	sum := sha256.Sum256([]byte(string(p.Kind) + "\x00" + p.Subject + "\x00" + p.Detail))
+	return hex.EncodeToString(sum[:])
+}
+
+// String renders the pulse as a single jeBNF line.
+func (p Pulse) String() string {
+	return fmt.Sprintf("VAULT-%s SEQ-%d %s %s %s %s %s ;",
+		p.Source, p.Seq, p.Kind, p.Time.UTC().Format(time.RFC3339Nano),
+		p.IntentHash(), strconv.Quote(p.Subject), strconv.Quote(p.Detail))
+}
+
+// Parse reads a line produced by Pulse.String, verifying its intent hash.
+func Parse(line string) (Pulse, error) {
+	var p Pulse
+	rest := strings.TrimSpace(line)
+	next := func() string {
+		var f string
+		f, rest, _ = strings.Cut(rest, " ")
+		return f
+	}
+
+	source, ok := strings.CutPrefix(next(), "VAULT-")
+	if !ok || source == "" {
+		return p, errors.New("pulse: missing VAULT- source id")
+	}
+	p.Source = source
+
+	seq, ok := strings.CutPrefix(next(), "SEQ-")
+	if !ok {
+		return p, errors.New("pulse: missing SEQ- sequence")
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return p, fmt.Errorf("pulse: bad sequence: %w", err)
+	}
+	p.Seq = n
+
+	p.Kind = Kind(next())
+	if !slices.Contains(kinds, p.Kind) {
+		return p, fmt.Errorf("pulse: unknown kind %q", p.Kind)
+	}
+	if p.Time, err = time.Parse(time.RFC3339Nano, next()); err != nil {
+		return p, fmt.Errorf("pulse: bad timestamp: %w", err)
+	}
+	hash := next()
+
+	for _, field := range []*string{&p.Subject, &p.Detail} {
+		q, err := strconv.QuotedPrefix(rest)
+		if err != nil {
+			return p, fmt.Errorf("pulse: bad quoted field: %w", err)
+		}
+		*field, _ = strconv.Unquote(q)
+		rest = strings.TrimPrefix(rest[len(q):], " ")
+	}
+	if rest != ";" {
+		return p, errors.New("pulse: missing terminal ;")
+	}
+	if hash != p.IntentHash() {
+		return p, errors.New("pulse: intent hash mismatch")
+	}
+	return p, nil
+}
@@ -0,0 +1,88 @@
+package resonance
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPulseRoundTrip(t *testing.T) {
+	p := Pulse{
+		Source:  "vault-01",
+		Seq:     42,
+		Kind:    SecretChanged,
+		Time:    time.Date(2026, 3, 3, 9, 0, 4, 0, time.UTC),
+		Subject: `prod/db "root"`,
+		Detail:  "version=4",
+	}
+	line := p.String()
+	if !strings.HasPrefix(line, "VAULT-vault-01 SEQ-42 SECRET-CHANGED 2026-03-03T09:00:04Z ") || !strings.HasSuffix(line, ` "version=4" ;`) {
+		t.Fatalf("unexpected pulse line: %s", line)
+	}
+	got, err := Parse(line)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if got != p {
+		t.Errorf("round trip = %+v, want %+v", got, p)
+	}
+
+	tampered := strings.Replace(line, "version=4", "version=5", 1)
+	if _, err := Parse(tampered); err == nil {
+		t.Error("expected intent hash mismatch for tampered pulse")
+	}
+	if _, err := Parse(strings.Replace(line, "SECRET-CHANGED", "SECRET-READ", 1)); err == nil {
+		t.Error("expected unknown kind to be rejected")
+	}
+}
+
+func TestBusLoopback(t *testing.T) {
+	lb := NewLoopback()
+	sub := lb.Subscribe(4)
+	bus := NewBus("vault 01", lb)
+
+	bus.Emit(Unsealed, "data", "")
+	bus.Emit(PolicyReloaded, "ops", "acl")
+	if err := bus.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	bus.Emit(Sealed, "data", "")
+
+	var got []Pulse
+	for p := range sub {
+		got = append(got, p)
+	}
+	if len(got) != 2 || got[0].Kind != Unsealed || got[1].Kind != PolicyReloaded {
+		t.Fatalf("loopback received %+v", got)
+	}
+	if got[0].Source != "vault-01" || got[0].Seq != 1 || got[1].Seq != 2 {
+		t.Errorf("pulses not stamped: %+v", got)
+	}
+
+	var nilBus *Bus
+	nilBus.Emit(Sealed, "data", "")
+}
+
+func TestHTTPTransport(t *testing.T) {
+	lines := make(chan string, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		lines <- string(body)
+	}))
+	defer ts.Close()
+
+	bus := NewBus("vault-01", NewHTTPTransport(ts.URL))
+	bus.Emit(ReplicationLag, "outbox", "lag=5s pending=2")
+	bus.Close()
+
+	p, err := Parse(<-lines)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if p.Kind != ReplicationLag || p.Detail != "lag=5s pending=2" {
+		t.Errorf("unexpected pulse %+v", p)
+	}
+}
@@ -0,0 +1,179 @@
+package resonance
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Transport carries pulses to the mesh.
+type Transport interface {
+	Publish(ctx context.Context, p Pulse) error
+	Close() error
+}
+
+// Loopback is an in-process Transport that fans pulses out to local
+// subscribers. Slow subscribers miss pulses rather than stall the bus.
+type Loopback struct {
+	mu     sync.Mutex
+	subs   []chan Pulse
+	closed bool
+}
+
+func NewLoopback() *Loopback {
+	return &Loopback{}
+}
+
+// Subscribe returns a channel receiving every pulse published after the
+// call. It is closed when the loopback is.
+func (l *Loopback) Subscribe(buffer int) <-chan Pulse {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ch := make(chan Pulse, buffer)
+	if l.closed {
+		close(ch)
+		return ch
+	}
+	l.subs = append(l.subs, ch)
+	return ch
+}
+
+func (l *Loopback) Publish(ctx context.Context, p Pulse) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return fmt.Errorf("loopback closed")
+	}
+	for _, ch := range l.subs {
+		select {
+		case ch <- p:
+		default:
+		}
+	}
+	return nil
+}
+
+func (l *Loopback) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.closed {
+		l.closed = true
+		for _, ch := range l.subs {
+			close(ch)
+		}
+	}
+	return nil
+}
+
+// HTTPTransport POSTs each pulse line to a bus endpoint.
+type HTTPTransport struct {
+	URL    string
+	Client *http.Client
+}
+
+func NewHTTPTransport(url string) *HTTPTransport {
+	return &HTTPTransport{URL: url, Client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (h *HTTPTransport) Publish(ctx context.Context, p Pulse) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, strings.NewReader(p.String()+"\n"))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	resp, err := h.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("resonance bus returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (h *HTTPTransport) Close() error {
+	h.Client.CloseIdleConnections()
+	return nil
+}
+
+// queueSize bounds the pulses waiting for the transport.
+const queueSize = 256
+
+// Bus stamps pulses with this node's source id and sequence number and
+// hands them to a Transport off the caller's goroutine. Pulses are
+// best-effort: when the queue is full they are dropped. A nil *Bus
+// discards everything, so callers need no nil checks.
+type Bus struct {
+	source string
+	t      Transport
+	seq    atomic.Uint64
+
+	mu     sync.RWMutex
+	closed bool
+	queue  chan Pulse
+	done   chan struct{}
+}
+
+// NewBus starts a bus publishing as VAULT-<source>.
+func NewBus(source string, t Transport) *Bus {
+	b := &Bus{
+		source: strings.ReplaceAll(source, " ", "-"),
+		t:      t,
+		queue:  make(chan Pulse, queueSize),
+		done:   make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+func (b *Bus) run() {
+	defer close(b.done)
+	for p := range b.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err := b.t.Publish(ctx, p); err != nil {
+			slog.Warn("Resonance pulse not delivered", "kind", p.Kind, "subject", p.Subject, "error", err)
+		}
+		cancel()
+	}
+}
+
+// Emit queues a pulse.
+func (b *Bus) Emit(kind Kind, subject, detail string) {
+	if b == nil {
+		return
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return
+	}
+	p := Pulse{Source: b.source, Seq: b.seq.Add(1), Kind: kind, Time: time.Now(), Subject: subject, Detail: detail}
+	select {
+	case b.queue <- p:
+	default:
+		slog.Warn("Resonance bus queue full, dropping pulse", "kind", kind, "subject", subject)
+	}
+}
+
+// Close drains queued pulses and closes the transport.
+func (b *Bus) Close() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	close(b.queue)
+	b.mu.Unlock()
+	<-b.done
+	return b.t.Close()
+}